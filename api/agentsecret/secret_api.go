@@ -3,6 +3,7 @@
 package agentsecret
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ingrammicro/cio/utils"
@@ -31,9 +32,15 @@ func NewSecretService(concertoService utils.ConcertoService, apiEndpoint string)
 
 // RetrieveSecretVersion returns script characterizations list for a given UUID
 func (ss *SecretService) RetrieveSecretVersion(svID, filePath string) (int, error) {
+	return ss.RetrieveSecretVersionContext(context.Background(), svID, filePath)
+}
+
+// RetrieveSecretVersionContext is like RetrieveSecretVersion, but the request is cancelled as soon as ctx is done
+func (ss *SecretService) RetrieveSecretVersionContext(ctx context.Context, svID, filePath string) (int, error) {
 	log.Debug("RetrieveSecretVersion")
 
-	_, status, err := ss.concertoService.GetFile(
+	_, status, err := ss.concertoService.GetFileContext(
+		ctx,
 		fmt.Sprintf("%s"+APIPathSecretVersionContent, ss.apiEndpoint, svID), filePath, false)
 	if err != nil {
 		return status, err
@@ -3,7 +3,7 @@
 package audit
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 
 	"github.com/ingrammicro/cio/api/types"
@@ -32,40 +32,88 @@ func NewEventService(concertoService utils.ConcertoService) (*EventService, erro
 
 // ListEvents returns the list of events as an array of Event
 func (es *EventService) ListEvents() (events []*types.Event, err error) {
+	return es.ListEventsContext(context.Background())
+}
+
+// ListEventsContext is like ListEvents, but the request is cancelled as soon as ctx is done
+func (es *EventService) ListEventsContext(ctx context.Context) (events []*types.Event, err error) {
 	log.Debug("ListEvents")
 
-	data, status, err := es.concertoService.Get(APIPathAuditEvents)
+	events = []*types.Event{}
+	err = es.ListEventsPagesContext(ctx, func(page []*types.Event) bool {
+		events = append(events, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return events, nil
+}
 
-	if err = json.Unmarshal(data, &events); err != nil {
-		return nil, err
-	}
+// ListEventsPages is like ListEvents, but the list is streamed to fn one page at a time, until fn returns false
+func (es *EventService) ListEventsPages(fn func(events []*types.Event) bool) error {
+	return es.ListEventsPagesContext(context.Background(), fn)
+}
 
-	return events, nil
+// ListEventsPagesContext is like ListEventsPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (es *EventService) ListEventsPagesContext(ctx context.Context, fn func(events []*types.Event) bool) error {
+	log.Debug("ListEventsPages")
+
+	pager := utils.NewPager(ctx, es.concertoService, APIPathAuditEvents)
+	for pager.Next() {
+		var events []*types.Event
+		if err := pager.Decode(&events); err != nil {
+			return err
+		}
+		if !fn(events) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // ListSysEvents returns the list of events as an array of Event
 func (es *EventService) ListSysEvents() (events []*types.Event, err error) {
+	return es.ListSysEventsContext(context.Background())
+}
+
+// ListSysEventsContext is like ListSysEvents, but the request is cancelled as soon as ctx is done
+func (es *EventService) ListSysEventsContext(ctx context.Context) (events []*types.Event, err error) {
 	log.Debug("ListSysEvents")
 
-	data, status, err := es.concertoService.Get(APIPathAuditSystemEvents)
+	events = []*types.Event{}
+	err = es.ListSysEventsPagesContext(ctx, func(page []*types.Event) bool {
+		events = append(events, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return events, nil
+}
 
-	if err = json.Unmarshal(data, &events); err != nil {
-		return nil, err
-	}
+// ListSysEventsPages is like ListSysEvents, but the list is streamed to fn one page at a time, until fn returns false
+func (es *EventService) ListSysEventsPages(fn func(events []*types.Event) bool) error {
+	return es.ListSysEventsPagesContext(context.Background(), fn)
+}
 
-	return events, nil
+// ListSysEventsPagesContext is like ListSysEventsPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (es *EventService) ListSysEventsPagesContext(ctx context.Context, fn func(events []*types.Event) bool) error {
+	log.Debug("ListSysEventsPages")
+
+	pager := utils.NewPager(ctx, es.concertoService, APIPathAuditSystemEvents)
+	for pager.Next() {
+		var events []*types.Event
+		if err := pager.Decode(&events); err != nil {
+			return err
+		}
+		if !fn(events) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
@@ -30,7 +30,7 @@ func ListEventsMocked(t *testing.T, eventsIn []*types.Event) []*types.Event {
 	assert.Nil(err, "Event test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathAuditEvents).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathAuditEvents, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	eventsOut, err := ds.ListEvents()
 	assert.Nil(err, "Error getting event list")
 	assert.Equal(eventsIn, eventsOut, "ListEvents returned different events")
@@ -54,7 +54,8 @@ func ListEventsFailErrMocked(t *testing.T, eventsIn []*types.Event) []*types.Eve
 	assert.Nil(err, "Event test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathAuditEvents).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathAuditEvents, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	eventsOut, err := ds.ListEvents()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -80,7 +81,7 @@ func ListEventsFailStatusMocked(t *testing.T, eventsIn []*types.Event) []*types.
 	assert.Nil(err, "Event test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathAuditEvents).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathAuditEvents, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	eventsOut, err := ds.ListEvents()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -105,7 +106,7 @@ func ListEventsFailJSONMocked(t *testing.T, eventsIn []*types.Event) []*types.Ev
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathAuditEvents).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathAuditEvents, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	eventsOut, err := ds.ListEvents()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -131,7 +132,7 @@ func ListSysEventsMocked(t *testing.T, eventsIn []*types.Event) []*types.Event {
 	assert.Nil(err, "Event test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathAuditSystemEvents).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathAuditSystemEvents, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	eventsOut, err := ds.ListSysEvents()
 	assert.Nil(err, "Error getting event list")
 	assert.Equal(eventsIn, eventsOut, "ListSysEvents returned different events")
@@ -155,7 +156,8 @@ func ListSysEventsFailErrMocked(t *testing.T, eventsIn []*types.Event) []*types.
 	assert.Nil(err, "Event test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathAuditSystemEvents).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathAuditSystemEvents, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	eventsOut, err := ds.ListSysEvents()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -181,7 +183,7 @@ func ListSysEventsFailStatusMocked(t *testing.T, eventsIn []*types.Event) []*typ
 	assert.Nil(err, "Event test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathAuditSystemEvents).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathAuditSystemEvents, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	eventsOut, err := ds.ListSysEvents()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -206,7 +208,7 @@ func ListSysEventsFailJSONMocked(t *testing.T, eventsIn []*types.Event) []*types
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathAuditSystemEvents).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathAuditSystemEvents, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	eventsOut, err := ds.ListSysEvents()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -3,6 +3,7 @@
 package blueprint
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -31,9 +32,17 @@ func NewAttachmentService(concertoService utils.ConcertoService) (*AttachmentSer
 
 // GetAttachment returns a attachment by its ID
 func (as *AttachmentService) GetAttachment(attachmentID string) (attachment *types.Attachment, err error) {
+	return as.GetAttachmentContext(context.Background(), attachmentID)
+}
+
+// GetAttachmentContext is like GetAttachment, but the request is cancelled as soon as ctx is done
+func (as *AttachmentService) GetAttachmentContext(
+	ctx context.Context,
+	attachmentID string,
+) (attachment *types.Attachment, err error) {
 	log.Debug("GetAttachment")
 
-	data, status, err := as.concertoService.Get(fmt.Sprintf(APIPathBlueprintAttachment, attachmentID))
+	data, status, err := as.concertoService.GetContext(ctx, fmt.Sprintf(APIPathBlueprintAttachment, attachmentID))
 	if err != nil {
 		return nil, err
 	}
@@ -53,10 +62,19 @@ func (as *AttachmentService) GetAttachment(attachmentID string) (attachment *typ
 func (as *AttachmentService) DownloadAttachment(
 	url string,
 	filePath string,
+) (realFileName string, status int, err error) {
+	return as.DownloadAttachmentContext(context.Background(), url, filePath)
+}
+
+// DownloadAttachmentContext is like DownloadAttachment, but the request is cancelled as soon as ctx is done
+func (as *AttachmentService) DownloadAttachmentContext(
+	ctx context.Context,
+	url string,
+	filePath string,
 ) (realFileName string, status int, err error) {
 	log.Debug("DownloadAttachment")
 
-	realFileName, status, err = as.concertoService.GetFile(url, filePath, false)
+	realFileName, status, err = as.concertoService.GetFileContext(ctx, url, filePath, false)
 	if err != nil {
 		return realFileName, status, err
 	}
@@ -66,9 +84,14 @@ func (as *AttachmentService) DownloadAttachment(
 
 // DeleteAttachment deletes a attachment by its ID
 func (as *AttachmentService) DeleteAttachment(attachmentID string) (err error) {
+	return as.DeleteAttachmentContext(context.Background(), attachmentID)
+}
+
+// DeleteAttachmentContext is like DeleteAttachment, but the request is cancelled as soon as ctx is done
+func (as *AttachmentService) DeleteAttachmentContext(ctx context.Context, attachmentID string) (err error) {
 	log.Debug("DeleteAttachment")
 
-	data, status, err := as.concertoService.Delete(fmt.Sprintf(APIPathBlueprintAttachment, attachmentID))
+	data, status, err := as.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathBlueprintAttachment, attachmentID))
 	if err != nil {
 		return err
 	}
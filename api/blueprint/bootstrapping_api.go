@@ -3,6 +3,7 @@
 package blueprint
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -36,10 +37,20 @@ func NewBootstrappingService(concertoService utils.ConcertoService) (*Bootstrapp
 // changes
 func (bs *BootstrappingService) GetBootstrappingConfiguration() (
 	bootstrappingConfigurations *types.BootstrappingConfiguration, status int, err error,
+) {
+	return bs.GetBootstrappingConfigurationContext(context.Background())
+}
+
+// GetBootstrappingConfigurationContext is like GetBootstrappingConfiguration, but the request is cancelled as soon as
+// ctx is done
+func (bs *BootstrappingService) GetBootstrappingConfigurationContext(
+	ctx context.Context,
+) (
+	bootstrappingConfigurations *types.BootstrappingConfiguration, status int, err error,
 ) {
 	log.Debug("GetBootstrappingConfiguration")
 
-	data, status, err := bs.concertoService.Get(APIPathBlueprintConfiguration)
+	data, status, err := bs.concertoService.GetContext(ctx, APIPathBlueprintConfiguration)
 	if err != nil {
 		return nil, status, err
 	}
@@ -58,10 +69,22 @@ func (bs *BootstrappingService) GetBootstrappingConfiguration() (
 // ReportBootstrappingAppliedConfiguration informs the platform of applied changes
 func (bs *BootstrappingService) ReportBootstrappingAppliedConfiguration(
 	bootstrappingAppliedConfigurationParams *map[string]interface{},
+) (err error) {
+	return bs.ReportBootstrappingAppliedConfigurationContext(
+		context.Background(),
+		bootstrappingAppliedConfigurationParams,
+	)
+}
+
+// ReportBootstrappingAppliedConfigurationContext is like ReportBootstrappingAppliedConfiguration, but the request is
+// cancelled as soon as ctx is done
+func (bs *BootstrappingService) ReportBootstrappingAppliedConfigurationContext(
+	ctx context.Context,
+	bootstrappingAppliedConfigurationParams *map[string]interface{},
 ) (err error) {
 	log.Debug("ReportBootstrappingAppliedConfiguration")
 
-	data, status, err := bs.concertoService.Put(APIPathBlueprintAppliedConfiguration,
+	data, status, err := bs.concertoService.PutContext(ctx, APIPathBlueprintAppliedConfiguration,
 		bootstrappingAppliedConfigurationParams)
 
 	if err != nil {
@@ -78,10 +101,22 @@ func (bs *BootstrappingService) ReportBootstrappingAppliedConfiguration(
 // ReportBootstrappingLog reports a policy files application result
 func (bs *BootstrappingService) ReportBootstrappingLog(
 	bootstrappingContinuousReportParams *map[string]interface{},
+) (command *types.BootstrappingContinuousReport, status int, err error) {
+	return bs.ReportBootstrappingLogContext(context.Background(), bootstrappingContinuousReportParams)
+}
+
+// ReportBootstrappingLogContext is like ReportBootstrappingLog, but the request is cancelled as soon as ctx is done
+func (bs *BootstrappingService) ReportBootstrappingLogContext(
+	ctx context.Context,
+	bootstrappingContinuousReportParams *map[string]interface{},
 ) (command *types.BootstrappingContinuousReport, status int, err error) {
 	log.Debug("ReportBootstrappingLog")
 
-	data, status, err := bs.concertoService.Post(APIPathBlueprintBootstrapLogs, bootstrappingContinuousReportParams)
+	data, status, err := bs.concertoService.PostContext(
+		ctx,
+		APIPathBlueprintBootstrapLogs,
+		bootstrappingContinuousReportParams,
+	)
 
 	if err != nil {
 		return nil, status, err
@@ -98,10 +133,19 @@ func (bs *BootstrappingService) ReportBootstrappingLog(
 func (bs *BootstrappingService) DownloadPolicyfile(
 	url string,
 	filePath string,
+) (realFileName string, status int, err error) {
+	return bs.DownloadPolicyfileContext(context.Background(), url, filePath)
+}
+
+// DownloadPolicyfileContext is like DownloadPolicyfile, but the request is cancelled as soon as ctx is done
+func (bs *BootstrappingService) DownloadPolicyfileContext(
+	ctx context.Context,
+	url string,
+	filePath string,
 ) (realFileName string, status int, err error) {
 	log.Debug("DownloadPolicyfile")
 
-	realFileName, status, err = bs.concertoService.GetFile(url, filePath, false)
+	realFileName, status, err = bs.concertoService.GetFileContext(ctx, url, filePath, false)
 	if err != nil {
 		return realFileName, status, err
 	}
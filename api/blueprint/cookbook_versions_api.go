@@ -3,6 +3,7 @@
 package blueprint
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -33,31 +34,74 @@ func NewCookbookVersionService(concertoService utils.ConcertoService) (*Cookbook
 
 // ListCookbookVersions returns the list of cookbook versions as an array of CookbookVersion
 func (cvs *CookbookVersionService) ListCookbookVersions() (cookbookVersions []*types.CookbookVersion, err error) {
+	return cvs.ListCookbookVersionsContext(context.Background())
+}
+
+// ListCookbookVersionsContext is like ListCookbookVersions, but the request is cancelled as soon as ctx is done
+func (cvs *CookbookVersionService) ListCookbookVersionsContext(
+	ctx context.Context,
+) (cookbookVersions []*types.CookbookVersion, err error) {
 	log.Debug("ListCookbookVersions")
 
-	data, status, err := cvs.concertoService.Get(APIPathBlueprintCookbookVersions)
+	cookbookVersions = []*types.CookbookVersion{}
+	err = cvs.ListCookbookVersionsPagesContext(ctx, func(page []*types.CookbookVersion) bool {
+		cookbookVersions = append(cookbookVersions, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return cookbookVersions, nil
+}
 
-	if err = json.Unmarshal(data, &cookbookVersions); err != nil {
-		return nil, err
-	}
+// ListCookbookVersionsPages is like ListCookbookVersions, but the list is streamed to fn one page at a time, until fn
+// returns false
+func (cvs *CookbookVersionService) ListCookbookVersionsPages(
+	fn func(cookbookVersions []*types.CookbookVersion) bool,
+) error {
+	return cvs.ListCookbookVersionsPagesContext(context.Background(), fn)
+}
 
-	return cookbookVersions, nil
+// ListCookbookVersionsPagesContext is like ListCookbookVersionsPages, but the requests are cancelled as soon as ctx is
+// done. The page options carried by ctx are applied
+func (cvs *CookbookVersionService) ListCookbookVersionsPagesContext(
+	ctx context.Context,
+	fn func(cookbookVersions []*types.CookbookVersion) bool,
+) error {
+	log.Debug("ListCookbookVersionsPages")
+
+	pager := utils.NewPager(ctx, cvs.concertoService, APIPathBlueprintCookbookVersions)
+	for pager.Next() {
+		var cookbookVersions []*types.CookbookVersion
+		if err := pager.Decode(&cookbookVersions); err != nil {
+			return err
+		}
+		if !fn(cookbookVersions) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetCookbookVersion returns a cookbook version by its ID
 func (cvs *CookbookVersionService) GetCookbookVersion(
 	cookbookVersionID string,
+) (cookbookVersion *types.CookbookVersion, err error) {
+	return cvs.GetCookbookVersionContext(context.Background(), cookbookVersionID)
+}
+
+// GetCookbookVersionContext is like GetCookbookVersion, but the request is cancelled as soon as ctx is done
+func (cvs *CookbookVersionService) GetCookbookVersionContext(
+	ctx context.Context,
+	cookbookVersionID string,
 ) (cookbookVersion *types.CookbookVersion, err error) {
 	log.Debug("GetCookbookVersion")
 
-	data, status, err := cvs.concertoService.Get(fmt.Sprintf(APIPathBlueprintCookbookVersion, cookbookVersionID))
+	data, status, err := cvs.concertoService.GetContext(
+		ctx,
+		fmt.Sprintf(APIPathBlueprintCookbookVersion, cookbookVersionID),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -76,10 +120,18 @@ func (cvs *CookbookVersionService) GetCookbookVersion(
 // CreateCookbookVersion creates a new cookbook version
 func (cvs *CookbookVersionService) CreateCookbookVersion(
 	cookbookVersionParams *map[string]interface{},
+) (cookbookVersion *types.CookbookVersion, err error) {
+	return cvs.CreateCookbookVersionContext(context.Background(), cookbookVersionParams)
+}
+
+// CreateCookbookVersionContext is like CreateCookbookVersion, but the request is cancelled as soon as ctx is done
+func (cvs *CookbookVersionService) CreateCookbookVersionContext(
+	ctx context.Context,
+	cookbookVersionParams *map[string]interface{},
 ) (cookbookVersion *types.CookbookVersion, err error) {
 	log.Debug("CreateCookbookVersion")
 
-	data, status, err := cvs.concertoService.Post(APIPathBlueprintCookbookVersions, cookbookVersionParams)
+	data, status, err := cvs.concertoService.PostContext(ctx, APIPathBlueprintCookbookVersions, cookbookVersionParams)
 
 	if err != nil {
 		return nil, err
@@ -98,9 +150,18 @@ func (cvs *CookbookVersionService) CreateCookbookVersion(
 
 // UploadCookbookVersion uploads a cookbook version file
 func (cvs *CookbookVersionService) UploadCookbookVersion(sourceFilePath string, targetURL string) error {
+	return cvs.UploadCookbookVersionContext(context.Background(), sourceFilePath, targetURL)
+}
+
+// UploadCookbookVersionContext is like UploadCookbookVersion, but the request is cancelled as soon as ctx is done
+func (cvs *CookbookVersionService) UploadCookbookVersionContext(
+	ctx context.Context,
+	sourceFilePath string,
+	targetURL string,
+) error {
 	log.Debug("UploadCookbookVersion")
 
-	data, status, err := cvs.concertoService.PutFile(sourceFilePath, targetURL)
+	data, status, err := cvs.concertoService.PutFileContext(ctx, sourceFilePath, targetURL)
 	if err != nil {
 		return err
 	}
@@ -116,10 +177,20 @@ func (cvs *CookbookVersionService) UploadCookbookVersion(sourceFilePath string,
 func (cvs *CookbookVersionService) ProcessCookbookVersion(
 	cookbookVersionID string,
 	cookbookVersionParams *map[string]interface{},
+) (cookbookVersion *types.CookbookVersion, err error) {
+	return cvs.ProcessCookbookVersionContext(context.Background(), cookbookVersionID, cookbookVersionParams)
+}
+
+// ProcessCookbookVersionContext is like ProcessCookbookVersion, but the request is cancelled as soon as ctx is done
+func (cvs *CookbookVersionService) ProcessCookbookVersionContext(
+	ctx context.Context,
+	cookbookVersionID string,
+	cookbookVersionParams *map[string]interface{},
 ) (cookbookVersion *types.CookbookVersion, err error) {
 	log.Debug("ProcessCookbookVersion")
 
-	data, status, err := cvs.concertoService.Post(
+	data, status, err := cvs.concertoService.PostContext(
+		ctx,
 		fmt.Sprintf(APIPathBlueprintCookbookVersionProcess, cookbookVersionID),
 		cookbookVersionParams,
 	)
@@ -141,9 +212,20 @@ func (cvs *CookbookVersionService) ProcessCookbookVersion(
 
 // DeleteCookbookVersion deletes a cookbook version by its ID
 func (cvs *CookbookVersionService) DeleteCookbookVersion(cookbookVersionID string) (err error) {
+	return cvs.DeleteCookbookVersionContext(context.Background(), cookbookVersionID)
+}
+
+// DeleteCookbookVersionContext is like DeleteCookbookVersion, but the request is cancelled as soon as ctx is done
+func (cvs *CookbookVersionService) DeleteCookbookVersionContext(
+	ctx context.Context,
+	cookbookVersionID string,
+) (err error) {
 	log.Debug("DeleteCookbookVersion")
 
-	data, status, err := cvs.concertoService.Delete(fmt.Sprintf(APIPathBlueprintCookbookVersion, cookbookVersionID))
+	data, status, err := cvs.concertoService.DeleteContext(
+		ctx,
+		fmt.Sprintf(APIPathBlueprintCookbookVersion, cookbookVersionID),
+	)
 	if err != nil {
 		return err
 	}
@@ -30,7 +30,7 @@ func ListCookbookVersionsMocked(t *testing.T, cbsIn []*types.CookbookVersion) []
 	assert.Nil(err, "CookbookVersion test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathBlueprintCookbookVersions).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathBlueprintCookbookVersions, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	cbsOut, err := ds.ListCookbookVersions()
 	assert.Nil(err, "Error getting cookbook version list")
 	assert.Equal(cbsIn, cbsOut, "ListCookbookVersions returned different cookbook versions")
@@ -54,7 +54,8 @@ func ListCookbookVersionsFailErrMocked(t *testing.T, cbsIn []*types.CookbookVers
 	assert.Nil(err, "CookbookVersion test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathBlueprintCookbookVersions).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathBlueprintCookbookVersions, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	cbsOut, err := ds.ListCookbookVersions()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -80,7 +81,7 @@ func ListCookbookVersionsFailStatusMocked(t *testing.T, cbsIn []*types.CookbookV
 	assert.Nil(err, "CookbookVersion test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathBlueprintCookbookVersions).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathBlueprintCookbookVersions, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	cbsOut, err := ds.ListCookbookVersions()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -105,7 +106,7 @@ func ListCookbookVersionsFailJSONMocked(t *testing.T, cbsIn []*types.CookbookVer
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathBlueprintCookbookVersions).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathBlueprintCookbookVersions, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	cbsOut, err := ds.ListCookbookVersions()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
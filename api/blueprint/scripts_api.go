@@ -3,6 +3,7 @@
 package blueprint
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -34,29 +35,58 @@ func NewScriptService(concertoService utils.ConcertoService) (*ScriptService, er
 
 // ListScripts returns the list of scripts as an array of Scripts
 func (sc *ScriptService) ListScripts() (scripts []*types.Script, err error) {
+	return sc.ListScriptsContext(context.Background())
+}
+
+// ListScriptsContext is like ListScripts, but the request is cancelled as soon as ctx is done
+func (sc *ScriptService) ListScriptsContext(ctx context.Context) (scripts []*types.Script, err error) {
 	log.Debug("ListScripts")
 
-	data, status, err := sc.concertoService.Get(APIPathBlueprintScripts)
+	scripts = []*types.Script{}
+	err = sc.ListScriptsPagesContext(ctx, func(page []*types.Script) bool {
+		scripts = append(scripts, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return scripts, nil
+}
 
-	if err = json.Unmarshal(data, &scripts); err != nil {
-		return nil, err
-	}
+// ListScriptsPages is like ListScripts, but the list is streamed to fn one page at a time, until fn returns false
+func (sc *ScriptService) ListScriptsPages(fn func(scripts []*types.Script) bool) error {
+	return sc.ListScriptsPagesContext(context.Background(), fn)
+}
 
-	return scripts, nil
+// ListScriptsPagesContext is like ListScriptsPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (sc *ScriptService) ListScriptsPagesContext(ctx context.Context, fn func(scripts []*types.Script) bool) error {
+	log.Debug("ListScriptsPages")
+
+	pager := utils.NewPager(ctx, sc.concertoService, APIPathBlueprintScripts)
+	for pager.Next() {
+		var scripts []*types.Script
+		if err := pager.Decode(&scripts); err != nil {
+			return err
+		}
+		if !fn(scripts) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetScript returns a script by its ID
 func (sc *ScriptService) GetScript(scriptID string) (script *types.Script, err error) {
+	return sc.GetScriptContext(context.Background(), scriptID)
+}
+
+// GetScriptContext is like GetScript, but the request is cancelled as soon as ctx is done
+func (sc *ScriptService) GetScriptContext(ctx context.Context, scriptID string) (script *types.Script, err error) {
 	log.Debug("GetScript")
 
-	data, status, err := sc.concertoService.Get(fmt.Sprintf(APIPathBlueprintScript, scriptID))
+	data, status, err := sc.concertoService.GetContext(ctx, fmt.Sprintf(APIPathBlueprintScript, scriptID))
 	if err != nil {
 		return nil, err
 	}
@@ -74,9 +104,17 @@ func (sc *ScriptService) GetScript(scriptID string) (script *types.Script, err e
 
 // CreateScript creates a script
 func (sc *ScriptService) CreateScript(scriptParams *map[string]interface{}) (script *types.Script, err error) {
+	return sc.CreateScriptContext(context.Background(), scriptParams)
+}
+
+// CreateScriptContext is like CreateScript, but the request is cancelled as soon as ctx is done
+func (sc *ScriptService) CreateScriptContext(
+	ctx context.Context,
+	scriptParams *map[string]interface{},
+) (script *types.Script, err error) {
 	log.Debug("CreateScript")
 
-	data, status, err := sc.concertoService.Post(APIPathBlueprintScripts, scriptParams)
+	data, status, err := sc.concertoService.PostContext(ctx, APIPathBlueprintScripts, scriptParams)
 	if err != nil {
 		return nil, err
 	}
@@ -92,14 +130,40 @@ func (sc *ScriptService) CreateScript(scriptParams *map[string]interface{}) (scr
 	return script, nil
 }
 
+// CreateScriptWithInput is like CreateScript, but takes a typed input, which is validated before being sent
+func (sc *ScriptService) CreateScriptWithInput(input *types.ScriptCreateInput) (script *types.Script, err error) {
+	return sc.CreateScriptWithInputContext(context.Background(), input)
+}
+
+// CreateScriptWithInputContext is like CreateScriptWithInput, but the request is cancelled as soon as ctx is done
+func (sc *ScriptService) CreateScriptWithInputContext(
+	ctx context.Context,
+	input *types.ScriptCreateInput,
+) (script *types.Script, err error) {
+	scriptParams, err := types.InputParams(input)
+	if err != nil {
+		return nil, err
+	}
+	return sc.CreateScriptContext(ctx, scriptParams)
+}
+
 // UpdateScript updates a script by its ID
 func (sc *ScriptService) UpdateScript(
 	scriptID string,
 	scriptParams *map[string]interface{},
+) (script *types.Script, err error) {
+	return sc.UpdateScriptContext(context.Background(), scriptID, scriptParams)
+}
+
+// UpdateScriptContext is like UpdateScript, but the request is cancelled as soon as ctx is done
+func (sc *ScriptService) UpdateScriptContext(
+	ctx context.Context,
+	scriptID string,
+	scriptParams *map[string]interface{},
 ) (script *types.Script, err error) {
 	log.Debug("UpdateScript")
 
-	data, status, err := sc.concertoService.Put(fmt.Sprintf(APIPathBlueprintScript, scriptID), scriptParams)
+	data, status, err := sc.concertoService.PutContext(ctx, fmt.Sprintf(APIPathBlueprintScript, scriptID), scriptParams)
 	if err != nil {
 		return nil, err
 	}
@@ -115,11 +179,37 @@ func (sc *ScriptService) UpdateScript(
 	return script, nil
 }
 
+// UpdateScriptWithInput is like UpdateScript, but takes a typed input, which is validated before being sent
+func (sc *ScriptService) UpdateScriptWithInput(
+	scriptID string,
+	input *types.ScriptUpdateInput,
+) (script *types.Script, err error) {
+	return sc.UpdateScriptWithInputContext(context.Background(), scriptID, input)
+}
+
+// UpdateScriptWithInputContext is like UpdateScriptWithInput, but the request is cancelled as soon as ctx is done
+func (sc *ScriptService) UpdateScriptWithInputContext(
+	ctx context.Context,
+	scriptID string,
+	input *types.ScriptUpdateInput,
+) (script *types.Script, err error) {
+	scriptParams, err := types.InputParams(input)
+	if err != nil {
+		return nil, err
+	}
+	return sc.UpdateScriptContext(ctx, scriptID, scriptParams)
+}
+
 // DeleteScript deletes a script by its ID
 func (sc *ScriptService) DeleteScript(scriptID string) (err error) {
+	return sc.DeleteScriptContext(context.Background(), scriptID)
+}
+
+// DeleteScriptContext is like DeleteScript, but the request is cancelled as soon as ctx is done
+func (sc *ScriptService) DeleteScriptContext(ctx context.Context, scriptID string) (err error) {
 	log.Debug("DeleteScript")
 
-	data, status, err := sc.concertoService.Delete(fmt.Sprintf(APIPathBlueprintScript, scriptID))
+	data, status, err := sc.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathBlueprintScript, scriptID))
 	if err != nil {
 		return err
 	}
@@ -135,10 +225,23 @@ func (sc *ScriptService) DeleteScript(scriptID string) (err error) {
 func (sc *ScriptService) AddScriptAttachment(
 	scriptID string,
 	attachmentIn *map[string]interface{},
+) (script *types.Attachment, err error) {
+	return sc.AddScriptAttachmentContext(context.Background(), scriptID, attachmentIn)
+}
+
+// AddScriptAttachmentContext is like AddScriptAttachment, but the request is cancelled as soon as ctx is done
+func (sc *ScriptService) AddScriptAttachmentContext(
+	ctx context.Context,
+	scriptID string,
+	attachmentIn *map[string]interface{},
 ) (script *types.Attachment, err error) {
 	log.Debug("AddScriptAttachment")
 
-	data, status, err := sc.concertoService.Post(fmt.Sprintf(APIPathBlueprintScriptAttachments, scriptID), attachmentIn)
+	data, status, err := sc.concertoService.PostContext(
+		ctx,
+		fmt.Sprintf(APIPathBlueprintScriptAttachments, scriptID),
+		attachmentIn,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -156,9 +259,18 @@ func (sc *ScriptService) AddScriptAttachment(
 
 // UploadScriptAttachment uploads an attachment file
 func (sc *ScriptService) UploadScriptAttachment(sourceFilePath string, targetURL string) error {
+	return sc.UploadScriptAttachmentContext(context.Background(), sourceFilePath, targetURL)
+}
+
+// UploadScriptAttachmentContext is like UploadScriptAttachment, but the request is cancelled as soon as ctx is done
+func (sc *ScriptService) UploadScriptAttachmentContext(
+	ctx context.Context,
+	sourceFilePath string,
+	targetURL string,
+) error {
 	log.Debug("UploadScriptAttachment")
 
-	data, status, err := sc.concertoService.PutFile(sourceFilePath, targetURL)
+	data, status, err := sc.concertoService.PutFileContext(ctx, sourceFilePath, targetURL)
 	if err != nil {
 		return err
 	}
@@ -174,10 +286,20 @@ func (sc *ScriptService) UploadScriptAttachment(sourceFilePath string, targetURL
 func (sc *ScriptService) UploadedScriptAttachment(
 	attachmentID string,
 	attachmentParams *map[string]interface{},
+) (attachment *types.Attachment, err error) {
+	return sc.UploadedScriptAttachmentContext(context.Background(), attachmentID, attachmentParams)
+}
+
+// UploadedScriptAttachmentContext is like UploadedScriptAttachment, but the request is cancelled as soon as ctx is done
+func (sc *ScriptService) UploadedScriptAttachmentContext(
+	ctx context.Context,
+	attachmentID string,
+	attachmentParams *map[string]interface{},
 ) (attachment *types.Attachment, err error) {
 	log.Debug("UploadedScriptAttachment")
 
-	data, status, err := sc.concertoService.Put(
+	data, status, err := sc.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathBlueprintScriptAttachmentUploaded, attachmentID),
 		attachmentParams,
 	)
@@ -198,20 +320,55 @@ func (sc *ScriptService) UploadedScriptAttachment(
 
 // ListScriptAttachments returns the list of Attachments for a given script ID
 func (sc *ScriptService) ListScriptAttachments(scriptID string) (attachments []*types.Attachment, err error) {
+	return sc.ListScriptAttachmentsContext(context.Background(), scriptID)
+}
+
+// ListScriptAttachmentsContext is like ListScriptAttachments, but the request is cancelled as soon as ctx is done
+func (sc *ScriptService) ListScriptAttachmentsContext(
+	ctx context.Context,
+	scriptID string,
+) (attachments []*types.Attachment, err error) {
 	log.Debug("ListScriptAttachments")
 
-	data, status, err := sc.concertoService.Get(fmt.Sprintf(APIPathBlueprintScriptAttachments, scriptID))
+	attachments = []*types.Attachment{}
+	err = sc.ListScriptAttachmentsPagesContext(ctx, scriptID, func(page []*types.Attachment) bool {
+		attachments = append(attachments, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return attachments, nil
+}
 
-	if err = json.Unmarshal(data, &attachments); err != nil {
-		return nil, err
-	}
+// ListScriptAttachmentsPages is like ListScriptAttachments, but the list is streamed to fn one page at a time, until fn
+// returns false
+func (sc *ScriptService) ListScriptAttachmentsPages(
+	scriptID string,
+	fn func(attachments []*types.Attachment) bool,
+) error {
+	return sc.ListScriptAttachmentsPagesContext(context.Background(), scriptID, fn)
+}
 
-	return attachments, nil
+// ListScriptAttachmentsPagesContext is like ListScriptAttachmentsPages, but the requests are cancelled as soon as ctx
+// is done. The page options carried by ctx are applied
+func (sc *ScriptService) ListScriptAttachmentsPagesContext(
+	ctx context.Context,
+	scriptID string,
+	fn func(attachments []*types.Attachment) bool,
+) error {
+	log.Debug("ListScriptAttachmentsPages")
+
+	pager := utils.NewPager(ctx, sc.concertoService, fmt.Sprintf(APIPathBlueprintScriptAttachments, scriptID))
+	for pager.Next() {
+		var attachments []*types.Attachment
+		if err := pager.Decode(&attachments); err != nil {
+			return err
+		}
+		if !fn(attachments) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
@@ -30,7 +30,7 @@ func ListScriptsMocked(t *testing.T, scriptsIn []*types.Script) []*types.Script
 	assert.Nil(err, "Script test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathBlueprintScripts).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathBlueprintScripts, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	scriptsOut, err := ds.ListScripts()
 	assert.Nil(err, "Error getting script list")
 	assert.Equal(scriptsIn, scriptsOut, "ListScripts returned different scripts")
@@ -54,7 +54,8 @@ func ListScriptsFailErrMocked(t *testing.T, scriptsIn []*types.Script) []*types.
 	assert.Nil(err, "Script test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathBlueprintScripts).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathBlueprintScripts, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	scriptsOut, err := ds.ListScripts()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -80,7 +81,7 @@ func ListScriptsFailStatusMocked(t *testing.T, scriptsIn []*types.Script) []*typ
 	assert.Nil(err, "Script test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathBlueprintScripts).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathBlueprintScripts, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	scriptsOut, err := ds.ListScripts()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -105,7 +106,7 @@ func ListScriptsFailJSONMocked(t *testing.T, scriptsIn []*types.Script) []*types
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathBlueprintScripts).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathBlueprintScripts, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	scriptsOut, err := ds.ListScripts()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -837,7 +838,8 @@ func ListScriptAttachmentsMocked(t *testing.T, attachmentsIn []*types.Attachment
 	assert.Nil(err, "Script test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathBlueprintScriptAttachments, scriptID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathBlueprintScriptAttachments, scriptID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	attachmentsOut, err := ds.ListScriptAttachments(scriptID)
 	assert.Nil(err, "Error getting script attachments list")
 	assert.Equal(attachmentsIn, attachmentsOut, "ListScriptAttachments returned different attachments")
@@ -865,7 +867,7 @@ func ListScriptAttachmentsFailErrMocked(
 	assert.Nil(err, "Script test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathBlueprintScriptAttachments, scriptID)).
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathBlueprintScriptAttachments, scriptID), 1, utils.DefaultPageSize)).
 		Return(dIn, 200, fmt.Errorf("mocked error"))
 	attachmentsOut, err := ds.ListScriptAttachments(scriptID)
 
@@ -896,7 +898,8 @@ func ListScriptAttachmentsFailStatusMocked(
 	assert.Nil(err, "Script test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathBlueprintScriptAttachments, scriptID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathBlueprintScriptAttachments, scriptID), 1, utils.DefaultPageSize)).
+		Return(dIn, 499, nil)
 	attachmentsOut, err := ds.ListScriptAttachments(scriptID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -925,7 +928,8 @@ func ListScriptAttachmentsFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathBlueprintScriptAttachments, scriptID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathBlueprintScriptAttachments, scriptID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	attachmentsOut, err := ds.ListScriptAttachments(scriptID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -3,6 +3,7 @@
 package blueprint
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -38,29 +39,64 @@ func NewTemplateService(concertoService utils.ConcertoService) (*TemplateService
 
 // ListTemplates returns the list of templates as an array of Template
 func (ts *TemplateService) ListTemplates() (templates []*types.Template, err error) {
+	return ts.ListTemplatesContext(context.Background())
+}
+
+// ListTemplatesContext is like ListTemplates, but the request is cancelled as soon as ctx is done
+func (ts *TemplateService) ListTemplatesContext(ctx context.Context) (templates []*types.Template, err error) {
 	log.Debug("ListTemplates")
 
-	data, status, err := ts.concertoService.Get(APIPathBlueprintTemplates)
+	templates = []*types.Template{}
+	err = ts.ListTemplatesPagesContext(ctx, func(page []*types.Template) bool {
+		templates = append(templates, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return templates, nil
+}
 
-	if err = json.Unmarshal(data, &templates); err != nil {
-		return nil, err
-	}
+// ListTemplatesPages is like ListTemplates, but the list is streamed to fn one page at a time, until fn returns false
+func (ts *TemplateService) ListTemplatesPages(fn func(templates []*types.Template) bool) error {
+	return ts.ListTemplatesPagesContext(context.Background(), fn)
+}
 
-	return templates, nil
+// ListTemplatesPagesContext is like ListTemplatesPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (ts *TemplateService) ListTemplatesPagesContext(
+	ctx context.Context,
+	fn func(templates []*types.Template) bool,
+) error {
+	log.Debug("ListTemplatesPages")
+
+	pager := utils.NewPager(ctx, ts.concertoService, APIPathBlueprintTemplates)
+	for pager.Next() {
+		var templates []*types.Template
+		if err := pager.Decode(&templates); err != nil {
+			return err
+		}
+		if !fn(templates) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetTemplate returns a template by its ID
 func (ts *TemplateService) GetTemplate(templateID string) (template *types.Template, err error) {
+	return ts.GetTemplateContext(context.Background(), templateID)
+}
+
+// GetTemplateContext is like GetTemplate, but the request is cancelled as soon as ctx is done
+func (ts *TemplateService) GetTemplateContext(
+	ctx context.Context,
+	templateID string,
+) (template *types.Template, err error) {
 	log.Debug("GetTemplate")
 
-	data, status, err := ts.concertoService.Get(fmt.Sprintf(APIPathBlueprintTemplate, templateID))
+	data, status, err := ts.concertoService.GetContext(ctx, fmt.Sprintf(APIPathBlueprintTemplate, templateID))
 	if err != nil {
 		return nil, err
 	}
@@ -79,10 +115,18 @@ func (ts *TemplateService) GetTemplate(templateID string) (template *types.Templ
 // CreateTemplate creates a template
 func (ts *TemplateService) CreateTemplate(
 	templateParams *map[string]interface{},
+) (template *types.Template, err error) {
+	return ts.CreateTemplateContext(context.Background(), templateParams)
+}
+
+// CreateTemplateContext is like CreateTemplate, but the request is cancelled as soon as ctx is done
+func (ts *TemplateService) CreateTemplateContext(
+	ctx context.Context,
+	templateParams *map[string]interface{},
 ) (template *types.Template, err error) {
 	log.Debug("CreateTemplate")
 
-	data, status, err := ts.concertoService.Post(APIPathBlueprintTemplates, templateParams)
+	data, status, err := ts.concertoService.PostContext(ctx, APIPathBlueprintTemplates, templateParams)
 	if err != nil {
 		return nil, err
 	}
@@ -102,10 +146,23 @@ func (ts *TemplateService) CreateTemplate(
 func (ts *TemplateService) UpdateTemplate(
 	templateID string,
 	templateParams *map[string]interface{},
+) (template *types.Template, err error) {
+	return ts.UpdateTemplateContext(context.Background(), templateID, templateParams)
+}
+
+// UpdateTemplateContext is like UpdateTemplate, but the request is cancelled as soon as ctx is done
+func (ts *TemplateService) UpdateTemplateContext(
+	ctx context.Context,
+	templateID string,
+	templateParams *map[string]interface{},
 ) (template *types.Template, err error) {
 	log.Debug("UpdateTemplate")
 
-	data, status, err := ts.concertoService.Put(fmt.Sprintf(APIPathBlueprintTemplate, templateID), templateParams)
+	data, status, err := ts.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathBlueprintTemplate, templateID),
+		templateParams,
+	)
 
 	if err != nil {
 		return nil, err
@@ -126,10 +183,23 @@ func (ts *TemplateService) UpdateTemplate(
 func (ts *TemplateService) CompileTemplate(
 	templateID string,
 	payload *map[string]interface{},
+) (template *types.Template, err error) {
+	return ts.CompileTemplateContext(context.Background(), templateID, payload)
+}
+
+// CompileTemplateContext is like CompileTemplate, but the request is cancelled as soon as ctx is done
+func (ts *TemplateService) CompileTemplateContext(
+	ctx context.Context,
+	templateID string,
+	payload *map[string]interface{},
 ) (template *types.Template, err error) {
 	log.Debug("CompileTemplate")
 
-	data, status, err := ts.concertoService.Put(fmt.Sprintf(APIPathBlueprintTemplateCompile, templateID), payload)
+	data, status, err := ts.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathBlueprintTemplateCompile, templateID),
+		payload,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -147,9 +217,14 @@ func (ts *TemplateService) CompileTemplate(
 
 // DeleteTemplate deletes a template by its ID
 func (ts *TemplateService) DeleteTemplate(templateID string) (err error) {
+	return ts.DeleteTemplateContext(context.Background(), templateID)
+}
+
+// DeleteTemplateContext is like DeleteTemplate, but the request is cancelled as soon as ctx is done
+func (ts *TemplateService) DeleteTemplateContext(ctx context.Context, templateID string) (err error) {
 	log.Debug("DeleteTemplate")
 
-	data, status, err := ts.concertoService.Delete(fmt.Sprintf(APIPathBlueprintTemplate, templateID))
+	data, status, err := ts.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathBlueprintTemplate, templateID))
 	if err != nil {
 		return err
 	}
@@ -167,35 +242,85 @@ func (ts *TemplateService) DeleteTemplate(templateID string) (err error) {
 func (ts *TemplateService) ListTemplateScripts(
 	templateID string,
 	scriptType string,
+) (templateScript []*types.TemplateScript, err error) {
+	return ts.ListTemplateScriptsContext(context.Background(), templateID, scriptType)
+}
+
+// ListTemplateScriptsContext is like ListTemplateScripts, but the request is cancelled as soon as ctx is done
+func (ts *TemplateService) ListTemplateScriptsContext(
+	ctx context.Context,
+	templateID string,
+	scriptType string,
 ) (templateScript []*types.TemplateScript, err error) {
 	log.Debug("ListTemplateScripts")
 
-	data, status, err := ts.concertoService.Get(
-		fmt.Sprintf(APIPathBlueprintTemplateScriptsByType, templateID, scriptType),
-	)
+	templateScript = []*types.TemplateScript{}
+	err = ts.ListTemplateScriptsPagesContext(ctx, templateID, scriptType, func(page []*types.TemplateScript) bool {
+		templateScript = append(templateScript, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return templateScript, nil
+}
 
-	if err = json.Unmarshal(data, &templateScript); err != nil {
-		return nil, err
-	}
+// ListTemplateScriptsPages is like ListTemplateScripts, but the list is streamed to fn one page at a time, until fn
+// returns false
+func (ts *TemplateService) ListTemplateScriptsPages(
+	templateID string,
+	scriptType string,
+	fn func(templateScript []*types.TemplateScript) bool,
+) error {
+	return ts.ListTemplateScriptsPagesContext(context.Background(), templateID, scriptType, fn)
+}
 
-	return templateScript, nil
+// ListTemplateScriptsPagesContext is like ListTemplateScriptsPages, but the requests are cancelled as soon as ctx is
+// done. The page options carried by ctx are applied
+func (ts *TemplateService) ListTemplateScriptsPagesContext(
+	ctx context.Context,
+	templateID string,
+	scriptType string,
+	fn func(templateScript []*types.TemplateScript) bool,
+) error {
+	log.Debug("ListTemplateScriptsPages")
+
+	pager := utils.NewPager(
+		ctx,
+		ts.concertoService,
+		fmt.Sprintf(APIPathBlueprintTemplateScriptsByType, templateID, scriptType),
+	)
+	for pager.Next() {
+		var templateScript []*types.TemplateScript
+		if err := pager.Decode(&templateScript); err != nil {
+			return err
+		}
+		if !fn(templateScript) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetTemplateScript returns a templateScript
 func (ts *TemplateService) GetTemplateScript(
 	templateID string,
 	templateScriptID string,
+) (templateScript *types.TemplateScript, err error) {
+	return ts.GetTemplateScriptContext(context.Background(), templateID, templateScriptID)
+}
+
+// GetTemplateScriptContext is like GetTemplateScript, but the request is cancelled as soon as ctx is done
+func (ts *TemplateService) GetTemplateScriptContext(
+	ctx context.Context,
+	templateID string,
+	templateScriptID string,
 ) (templateScript *types.TemplateScript, err error) {
 	log.Debug("GetTemplateScript")
 
-	data, status, err := ts.concertoService.Get(
+	data, status, err := ts.concertoService.GetContext(
+		ctx,
 		fmt.Sprintf(APIPathBlueprintTemplateScript, templateID, templateScriptID),
 	)
 	if err != nil {
@@ -217,10 +342,20 @@ func (ts *TemplateService) GetTemplateScript(
 func (ts *TemplateService) CreateTemplateScript(
 	templateID string,
 	templateScriptParams *map[string]interface{},
+) (templateScript *types.TemplateScript, err error) {
+	return ts.CreateTemplateScriptContext(context.Background(), templateID, templateScriptParams)
+}
+
+// CreateTemplateScriptContext is like CreateTemplateScript, but the request is cancelled as soon as ctx is done
+func (ts *TemplateService) CreateTemplateScriptContext(
+	ctx context.Context,
+	templateID string,
+	templateScriptParams *map[string]interface{},
 ) (templateScript *types.TemplateScript, err error) {
 	log.Debug("CreateTemplateScript")
 
-	data, status, err := ts.concertoService.Post(
+	data, status, err := ts.concertoService.PostContext(
+		ctx,
 		fmt.Sprintf(APIPathBlueprintTemplateScripts, templateID),
 		templateScriptParams,
 	)
@@ -244,10 +379,21 @@ func (ts *TemplateService) UpdateTemplateScript(
 	templateID string,
 	templateScriptID string,
 	templateScriptParams *map[string]interface{},
+) (templateScript *types.TemplateScript, err error) {
+	return ts.UpdateTemplateScriptContext(context.Background(), templateID, templateScriptID, templateScriptParams)
+}
+
+// UpdateTemplateScriptContext is like UpdateTemplateScript, but the request is cancelled as soon as ctx is done
+func (ts *TemplateService) UpdateTemplateScriptContext(
+	ctx context.Context,
+	templateID string,
+	templateScriptID string,
+	templateScriptParams *map[string]interface{},
 ) (templateScript *types.TemplateScript, err error) {
 	log.Debug("UpdateTemplateScript")
 
-	data, status, err := ts.concertoService.Put(
+	data, status, err := ts.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathBlueprintTemplateScript, templateID, templateScriptID),
 		templateScriptParams,
 	)
@@ -269,9 +415,19 @@ func (ts *TemplateService) UpdateTemplateScript(
 
 // DeleteTemplateScript deletes a template record
 func (ts *TemplateService) DeleteTemplateScript(templateID string, templateScriptID string) (err error) {
+	return ts.DeleteTemplateScriptContext(context.Background(), templateID, templateScriptID)
+}
+
+// DeleteTemplateScriptContext is like DeleteTemplateScript, but the request is cancelled as soon as ctx is done
+func (ts *TemplateService) DeleteTemplateScriptContext(
+	ctx context.Context,
+	templateID string,
+	templateScriptID string,
+) (err error) {
 	log.Debug("DeleteTemplateScript")
 
-	data, status, err := ts.concertoService.Delete(
+	data, status, err := ts.concertoService.DeleteContext(
+		ctx,
 		fmt.Sprintf(APIPathBlueprintTemplateScript, templateID, templateScriptID),
 	)
 	if err != nil {
@@ -289,10 +445,20 @@ func (ts *TemplateService) DeleteTemplateScript(templateID string, templateScrip
 func (ts *TemplateService) ReorderTemplateScript(
 	templateID string,
 	templateScriptParams *map[string]interface{},
+) (templateScript []*types.TemplateScript, err error) {
+	return ts.ReorderTemplateScriptContext(context.Background(), templateID, templateScriptParams)
+}
+
+// ReorderTemplateScriptContext is like ReorderTemplateScript, but the request is cancelled as soon as ctx is done
+func (ts *TemplateService) ReorderTemplateScriptContext(
+	ctx context.Context,
+	templateID string,
+	templateScriptParams *map[string]interface{},
 ) (templateScript []*types.TemplateScript, err error) {
 	log.Debug("ReorderTemplateScript")
 
-	data, status, err := ts.concertoService.Put(
+	data, status, err := ts.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathBlueprintTemplateScriptsReorder, templateID),
 		templateScriptParams,
 	)
@@ -315,20 +481,55 @@ func (ts *TemplateService) ReorderTemplateScript(
 
 // ListTemplateServers returns a list of templateServers by template ID
 func (ts *TemplateService) ListTemplateServers(templateID string) (templateServer []*types.TemplateServer, err error) {
+	return ts.ListTemplateServersContext(context.Background(), templateID)
+}
+
+// ListTemplateServersContext is like ListTemplateServers, but the request is cancelled as soon as ctx is done
+func (ts *TemplateService) ListTemplateServersContext(
+	ctx context.Context,
+	templateID string,
+) (templateServer []*types.TemplateServer, err error) {
 	log.Debug("ListTemplateServers")
 
-	data, status, err := ts.concertoService.Get(fmt.Sprintf(APIPathBlueprintTemplateServers, templateID))
+	templateServer = []*types.TemplateServer{}
+	err = ts.ListTemplateServersPagesContext(ctx, templateID, func(page []*types.TemplateServer) bool {
+		templateServer = append(templateServer, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return templateServer, nil
+}
 
-	if err = json.Unmarshal(data, &templateServer); err != nil {
-		return nil, err
-	}
+// ListTemplateServersPages is like ListTemplateServers, but the list is streamed to fn one page at a time, until fn
+// returns false
+func (ts *TemplateService) ListTemplateServersPages(
+	templateID string,
+	fn func(templateServer []*types.TemplateServer) bool,
+) error {
+	return ts.ListTemplateServersPagesContext(context.Background(), templateID, fn)
+}
 
-	return templateServer, nil
+// ListTemplateServersPagesContext is like ListTemplateServersPages, but the requests are cancelled as soon as ctx is
+// done. The page options carried by ctx are applied
+func (ts *TemplateService) ListTemplateServersPagesContext(
+	ctx context.Context,
+	templateID string,
+	fn func(templateServer []*types.TemplateServer) bool,
+) error {
+	log.Debug("ListTemplateServersPages")
+
+	pager := utils.NewPager(ctx, ts.concertoService, fmt.Sprintf(APIPathBlueprintTemplateServers, templateID))
+	for pager.Next() {
+		var templateServer []*types.TemplateServer
+		if err := pager.Decode(&templateServer); err != nil {
+			return err
+		}
+		if !fn(templateServer) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
@@ -30,7 +30,7 @@ func ListTemplatesMocked(t *testing.T, templatesIn []*types.Template) []*types.T
 	assert.Nil(err, "Template test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathBlueprintTemplates).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathBlueprintTemplates, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	templatesOut, err := ds.ListTemplates()
 	assert.Nil(err, "Error getting template list")
 	assert.Equal(templatesIn, templatesOut, "ListTemplates returned different templates")
@@ -54,7 +54,8 @@ func ListTemplatesFailErrMocked(t *testing.T, templatesIn []*types.Template) []*
 	assert.Nil(err, "Template test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathBlueprintTemplates).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathBlueprintTemplates, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	templatesOut, err := ds.ListTemplates()
 	assert.NotNil(err, "We are expecting an error")
 	assert.Nil(templatesOut, "Expecting nil output")
@@ -79,7 +80,7 @@ func ListTemplatesFailStatusMocked(t *testing.T, templatesIn []*types.Template)
 	assert.Nil(err, "Template test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathBlueprintTemplates).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathBlueprintTemplates, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	templatesOut, err := ds.ListTemplates()
 	assert.NotNil(err, "We are expecting an status code error")
 	assert.Nil(templatesOut, "Expecting nil output")
@@ -103,7 +104,7 @@ func ListTemplatesFailJSONMocked(t *testing.T, templatesIn []*types.Template) []
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathBlueprintTemplates).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathBlueprintTemplates, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	templatesOut, err := ds.ListTemplates()
 	assert.NotNil(err, "We are expecting a marshalling error")
 	assert.Nil(templatesOut, "Expecting nil output")
@@ -631,7 +632,11 @@ func ListTemplateScriptsMocked(
 	assert.Nil(err, "Template script test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathBlueprintTemplateScriptsByType, templateID, scriptType)).Return(drsIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathBlueprintTemplateScriptsByType, templateID, scriptType),
+		1,
+		utils.DefaultPageSize,
+	)).Return(drsIn, 200, nil)
 	templateScriptsOut, err := ds.ListTemplateScripts(templateID, scriptType)
 	assert.Nil(err, "Error getting template list")
 	assert.Equal(templateScriptsIn, templateScriptsOut, "ListTemplates returned different templates")
@@ -660,8 +665,11 @@ func ListTemplateScriptsFailErrMocked(
 	assert.Nil(err, "Template script test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathBlueprintTemplateScriptsByType, templateID, scriptType)).
-		Return(drsIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathBlueprintTemplateScriptsByType, templateID, scriptType),
+		1,
+		utils.DefaultPageSize,
+	)).Return(drsIn, 200, fmt.Errorf("mocked error"))
 	templateScriptsOut, err := ds.ListTemplateScripts(templateID, scriptType)
 	assert.NotNil(err, "We are expecting an error")
 	assert.Nil(templateScriptsOut, "Expecting nil output")
@@ -691,7 +699,11 @@ func ListTemplateScriptsFailStatusMocked(
 	assert.Nil(err, "Template script test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathBlueprintTemplateScriptsByType, templateID, scriptType)).Return(drsIn, 499, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathBlueprintTemplateScriptsByType, templateID, scriptType),
+		1,
+		utils.DefaultPageSize,
+	)).Return(drsIn, 499, nil)
 	templateScriptsOut, err := ds.ListTemplateScripts(templateID, scriptType)
 	assert.NotNil(err, "We are expecting an status code error")
 	assert.Nil(templateScriptsOut, "Expecting nil output")
@@ -720,7 +732,11 @@ func ListTemplateScriptsFailJSONMocked(
 	drsIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathBlueprintTemplateScriptsByType, templateID, scriptType)).Return(drsIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathBlueprintTemplateScriptsByType, templateID, scriptType),
+		1,
+		utils.DefaultPageSize,
+	)).Return(drsIn, 200, nil)
 	templateScriptsOut, err := ds.ListTemplateScripts(templateID, scriptType)
 	assert.NotNil(err, "We are expecting a marshalling error")
 	assert.Nil(templateScriptsOut, "Expecting nil output")
@@ -1272,7 +1288,8 @@ func ListTemplateServersMocked(
 	assert.Nil(err, "Template server test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathBlueprintTemplateServers, templateID)).Return(drsIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathBlueprintTemplateServers, templateID), 1, utils.DefaultPageSize)).
+		Return(drsIn, 200, nil)
 	templateServersOut, err := ds.ListTemplateServers(templateID)
 	assert.Nil(err, "Error getting template server list")
 	assert.Equal(templateServersIn, templateServersOut, "ListTemplates returned different template servers")
@@ -1300,7 +1317,7 @@ func ListTemplateServersFailErrMocked(
 	assert.Nil(err, "Template server test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathBlueprintTemplateServers, templateID)).
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathBlueprintTemplateServers, templateID), 1, utils.DefaultPageSize)).
 		Return(drsIn, 200, fmt.Errorf("mocked error"))
 	templateServersOut, err := ds.ListTemplateServers(templateID)
 	assert.NotNil(err, "We are expecting an error")
@@ -1330,7 +1347,8 @@ func ListTemplateServersFailStatusMocked(
 	assert.Nil(err, "Template server test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathBlueprintTemplateServers, templateID)).Return(drsIn, 499, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathBlueprintTemplateServers, templateID), 1, utils.DefaultPageSize)).
+		Return(drsIn, 499, nil)
 	templateServersOut, err := ds.ListTemplateServers(templateID)
 	assert.NotNil(err, "We are expecting an status code error")
 	assert.Nil(templateServersOut, "Expecting nil output")
@@ -1358,7 +1376,8 @@ func ListTemplateServersFailJSONMocked(
 	drsIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathBlueprintTemplateServers, templateID)).Return(drsIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathBlueprintTemplateServers, templateID), 1, utils.DefaultPageSize)).
+		Return(drsIn, 200, nil)
 	templateServersOut, err := ds.ListTemplateServers(templateID)
 	assert.NotNil(err, "We are expecting a marshalling error")
 	assert.Nil(templateServersOut, "Expecting nil output")
@@ -3,6 +3,7 @@
 package clientbrownfield
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -33,32 +34,76 @@ func NewBrownfieldCloudAccountService(concertoService utils.ConcertoService) (*B
 // ListBrownfieldCloudAccounts returns the list of Brownfield Cloud Accounts as an array of CloudAccount
 func (bcas *BrownfieldCloudAccountService) ListBrownfieldCloudAccounts() (
 	cloudAccounts []*types.CloudAccount, err error,
+) {
+	return bcas.ListBrownfieldCloudAccountsContext(context.Background())
+}
+
+// ListBrownfieldCloudAccountsContext is like ListBrownfieldCloudAccounts, but the request is cancelled as soon as ctx
+// is done
+func (bcas *BrownfieldCloudAccountService) ListBrownfieldCloudAccountsContext(
+	ctx context.Context,
+) (
+	cloudAccounts []*types.CloudAccount, err error,
 ) {
 	log.Debug("ListBrownfieldCloudAccounts")
 
-	data, status, err := bcas.concertoService.Get(APIPathBlueprintCloudAccounts)
+	cloudAccounts = []*types.CloudAccount{}
+	err = bcas.ListBrownfieldCloudAccountsPagesContext(ctx, func(page []*types.CloudAccount) bool {
+		cloudAccounts = append(cloudAccounts, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return cloudAccounts, nil
+}
 
-	if err = json.Unmarshal(data, &cloudAccounts); err != nil {
-		return nil, err
-	}
+// ListBrownfieldCloudAccountsPages is like ListBrownfieldCloudAccounts, but the list is streamed to fn one page at a
+// time, until fn returns false
+func (bcas *BrownfieldCloudAccountService) ListBrownfieldCloudAccountsPages(
+	fn func(cloudAccounts []*types.CloudAccount) bool,
+) error {
+	return bcas.ListBrownfieldCloudAccountsPagesContext(context.Background(), fn)
+}
 
-	return cloudAccounts, nil
+// ListBrownfieldCloudAccountsPagesContext is like ListBrownfieldCloudAccountsPages, but the requests are cancelled as
+// soon as ctx is done. The page options carried by ctx are applied
+func (bcas *BrownfieldCloudAccountService) ListBrownfieldCloudAccountsPagesContext(
+	ctx context.Context,
+	fn func(cloudAccounts []*types.CloudAccount) bool,
+) error {
+	log.Debug("ListBrownfieldCloudAccountsPages")
+
+	pager := utils.NewPager(ctx, bcas.concertoService, APIPathBlueprintCloudAccounts)
+	for pager.Next() {
+		var cloudAccounts []*types.CloudAccount
+		if err := pager.Decode(&cloudAccounts); err != nil {
+			return err
+		}
+		if !fn(cloudAccounts) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetBrownfieldCloudAccount returns a Brownfield Cloud Account by its ID
 func (bcas *BrownfieldCloudAccountService) GetBrownfieldCloudAccount(
 	cloudAccountID string,
+) (cloudAccount *types.CloudAccount, err error) {
+	return bcas.GetBrownfieldCloudAccountContext(context.Background(), cloudAccountID)
+}
+
+// GetBrownfieldCloudAccountContext is like GetBrownfieldCloudAccount, but the request is cancelled as soon as ctx is
+// done
+func (bcas *BrownfieldCloudAccountService) GetBrownfieldCloudAccountContext(
+	ctx context.Context,
+	cloudAccountID string,
 ) (cloudAccount *types.CloudAccount, err error) {
 	log.Debug("GetBrownfieldCloudAccount")
 
-	data, status, err := bcas.concertoService.Get(fmt.Sprintf(APIPathBlueprintCloudAccount, cloudAccountID))
+	data, status, err := bcas.concertoService.GetContext(ctx, fmt.Sprintf(APIPathBlueprintCloudAccount, cloudAccountID))
 	if err != nil {
 		return nil, err
 	}
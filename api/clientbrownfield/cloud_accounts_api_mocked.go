@@ -28,7 +28,7 @@ func ListBrownfieldCloudAccountsMocked(t *testing.T, cloudAccountsIn []*types.Cl
 	assert.Nil(err, "CloudAccounts test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathBlueprintCloudAccounts).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathBlueprintCloudAccounts, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	cloudAccountsOut, err := ds.ListBrownfieldCloudAccounts()
 	assert.Nil(err, "Error getting brownfield cloud account list")
 	assert.Equal(cloudAccountsIn, cloudAccountsOut, "ListBrownfieldCloudAccounts returned different cloud accounts")
@@ -55,7 +55,8 @@ func ListBrownfieldCloudAccountsFailErrMocked(
 	assert.Nil(err, "CloudAccounts test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathBlueprintCloudAccounts).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathBlueprintCloudAccounts, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	cloudAccountsOut, err := ds.ListBrownfieldCloudAccounts()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -84,7 +85,7 @@ func ListBrownfieldCloudAccountsFailStatusMocked(
 	assert.Nil(err, "CloudAccounts test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathBlueprintCloudAccounts).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathBlueprintCloudAccounts, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	cloudAccountsOut, err := ds.ListBrownfieldCloudAccounts()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -112,7 +113,7 @@ func ListBrownfieldCloudAccountsFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathBlueprintCloudAccounts).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathBlueprintCloudAccounts, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	cloudAccountsOut, err := ds.ListBrownfieldCloudAccounts()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
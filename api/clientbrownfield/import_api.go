@@ -3,6 +3,7 @@
 package clientbrownfield
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -38,10 +39,20 @@ func NewImportService(concertoService utils.ConcertoService) (*ImportService, er
 func (is *ImportService) ImportServers(
 	cloudAccountID string,
 	params *map[string]interface{},
+) (cloudAccount *types.CloudAccount, err error) {
+	return is.ImportServersContext(context.Background(), cloudAccountID, params)
+}
+
+// ImportServersContext is like ImportServers, but the request is cancelled as soon as ctx is done
+func (is *ImportService) ImportServersContext(
+	ctx context.Context,
+	cloudAccountID string,
+	params *map[string]interface{},
 ) (cloudAccount *types.CloudAccount, err error) {
 	log.Debug("ImportServers")
 
-	data, status, err := is.concertoService.Put(
+	data, status, err := is.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathBlueprintCloudAccountImportServers, cloudAccountID),
 		params,
 	)
@@ -64,10 +75,20 @@ func (is *ImportService) ImportServers(
 func (is *ImportService) ImportVPCs(
 	cloudAccountID string,
 	params *map[string]interface{},
+) (cloudAccount *types.CloudAccount, err error) {
+	return is.ImportVPCsContext(context.Background(), cloudAccountID, params)
+}
+
+// ImportVPCsContext is like ImportVPCs, but the request is cancelled as soon as ctx is done
+func (is *ImportService) ImportVPCsContext(
+	ctx context.Context,
+	cloudAccountID string,
+	params *map[string]interface{},
 ) (cloudAccount *types.CloudAccount, err error) {
 	log.Debug("ImportVPCs")
 
-	data, status, err := is.concertoService.Put(
+	data, status, err := is.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathBlueprintCloudAccountImportVpcs, cloudAccountID),
 		params,
 	)
@@ -90,10 +111,20 @@ func (is *ImportService) ImportVPCs(
 func (is *ImportService) ImportFloatingIPs(
 	cloudAccountID string,
 	params *map[string]interface{},
+) (cloudAccount *types.CloudAccount, err error) {
+	return is.ImportFloatingIPsContext(context.Background(), cloudAccountID, params)
+}
+
+// ImportFloatingIPsContext is like ImportFloatingIPs, but the request is cancelled as soon as ctx is done
+func (is *ImportService) ImportFloatingIPsContext(
+	ctx context.Context,
+	cloudAccountID string,
+	params *map[string]interface{},
 ) (cloudAccount *types.CloudAccount, err error) {
 	log.Debug("ImportFloatingIPs")
 
-	data, status, err := is.concertoService.Put(
+	data, status, err := is.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathBlueprintCloudAccountImportFloatingIPs, cloudAccountID),
 		params,
 	)
@@ -116,10 +147,20 @@ func (is *ImportService) ImportFloatingIPs(
 func (is *ImportService) ImportVolumes(
 	cloudAccountID string,
 	params *map[string]interface{},
+) (cloudAccount *types.CloudAccount, err error) {
+	return is.ImportVolumesContext(context.Background(), cloudAccountID, params)
+}
+
+// ImportVolumesContext is like ImportVolumes, but the request is cancelled as soon as ctx is done
+func (is *ImportService) ImportVolumesContext(
+	ctx context.Context,
+	cloudAccountID string,
+	params *map[string]interface{},
 ) (cloudAccount *types.CloudAccount, err error) {
 	log.Debug("ImportVolumes")
 
-	data, status, err := is.concertoService.Put(
+	data, status, err := is.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathBlueprintCloudAccountImportVolumes, cloudAccountID),
 		params,
 	)
@@ -142,10 +183,20 @@ func (is *ImportService) ImportVolumes(
 func (is *ImportService) ImportKubernetesClusters(
 	cloudAccountID string,
 	params *map[string]interface{},
+) (cloudAccount *types.CloudAccount, err error) {
+	return is.ImportKubernetesClustersContext(context.Background(), cloudAccountID, params)
+}
+
+// ImportKubernetesClustersContext is like ImportKubernetesClusters, but the request is cancelled as soon as ctx is done
+func (is *ImportService) ImportKubernetesClustersContext(
+	ctx context.Context,
+	cloudAccountID string,
+	params *map[string]interface{},
 ) (cloudAccount *types.CloudAccount, err error) {
 	log.Debug("ImportKubernetesClusters")
 
-	data, status, err := is.concertoService.Put(
+	data, status, err := is.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathBlueprintCloudAccountImportKubernetesClusters, cloudAccountID),
 		params,
 	)
@@ -168,10 +219,20 @@ func (is *ImportService) ImportKubernetesClusters(
 func (is *ImportService) ImportPolicies(
 	cloudAccountID string,
 	params *map[string]interface{},
+) (cloudAccount *types.CloudAccount, err error) {
+	return is.ImportPoliciesContext(context.Background(), cloudAccountID, params)
+}
+
+// ImportPoliciesContext is like ImportPolicies, but the request is cancelled as soon as ctx is done
+func (is *ImportService) ImportPoliciesContext(
+	ctx context.Context,
+	cloudAccountID string,
+	params *map[string]interface{},
 ) (cloudAccount *types.CloudAccount, err error) {
 	log.Debug("ImportPolicies")
 
-	data, status, err := is.concertoService.Put(
+	data, status, err := is.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathBlueprintCloudAccountImportPolicies, cloudAccountID),
 		params,
 	)
@@ -3,7 +3,7 @@
 package cloud
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 
 	"github.com/ingrammicro/cio/api/types"
@@ -34,86 +34,219 @@ func NewCloudProviderService(concertoService utils.ConcertoService) (*CloudProvi
 
 // ListCloudProviders returns the list of cloudProviders as an array of CloudProvider
 func (cps *CloudProviderService) ListCloudProviders() (cloudProviders []*types.CloudProvider, err error) {
+	return cps.ListCloudProvidersContext(context.Background())
+}
+
+// ListCloudProvidersContext is like ListCloudProviders, but the request is cancelled as soon as ctx is done
+func (cps *CloudProviderService) ListCloudProvidersContext(
+	ctx context.Context,
+) (cloudProviders []*types.CloudProvider, err error) {
 	log.Debug("ListCloudProviders")
 
-	data, status, err := cps.concertoService.Get(APIPathCloudProviders)
+	cloudProviders = []*types.CloudProvider{}
+	err = cps.ListCloudProvidersPagesContext(ctx, func(page []*types.CloudProvider) bool {
+		cloudProviders = append(cloudProviders, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return cloudProviders, nil
+}
 
-	if err = json.Unmarshal(data, &cloudProviders); err != nil {
-		return nil, err
-	}
+// ListCloudProvidersPages is like ListCloudProviders, but the list is streamed to fn one page at a time, until fn
+// returns false
+func (cps *CloudProviderService) ListCloudProvidersPages(fn func(cloudProviders []*types.CloudProvider) bool) error {
+	return cps.ListCloudProvidersPagesContext(context.Background(), fn)
+}
 
-	return cloudProviders, nil
+// ListCloudProvidersPagesContext is like ListCloudProvidersPages, but the requests are cancelled as soon as ctx is
+// done. The page options carried by ctx are applied
+func (cps *CloudProviderService) ListCloudProvidersPagesContext(
+	ctx context.Context,
+	fn func(cloudProviders []*types.CloudProvider) bool,
+) error {
+	log.Debug("ListCloudProvidersPages")
+
+	pager := utils.NewPager(ctx, cps.concertoService, APIPathCloudProviders)
+	for pager.Next() {
+		var cloudProviders []*types.CloudProvider
+		if err := pager.Decode(&cloudProviders); err != nil {
+			return err
+		}
+		if !fn(cloudProviders) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // ListServerStoragePlans returns the list of storage plans as an array of StoragePlan
 func (cps *CloudProviderService) ListServerStoragePlans(
 	providerID string,
+) (storagePlans []*types.StoragePlan, err error) {
+	return cps.ListServerStoragePlansContext(context.Background(), providerID)
+}
+
+// ListServerStoragePlansContext is like ListServerStoragePlans, but the request is cancelled as soon as ctx is done
+func (cps *CloudProviderService) ListServerStoragePlansContext(
+	ctx context.Context,
+	providerID string,
 ) (storagePlans []*types.StoragePlan, err error) {
 	log.Debug("ListServerStoragePlans")
 
-	data, status, err := cps.concertoService.Get(fmt.Sprintf(APIPathCloudProviderStoragePlans, providerID))
+	storagePlans = []*types.StoragePlan{}
+	err = cps.ListServerStoragePlansPagesContext(ctx, providerID, func(page []*types.StoragePlan) bool {
+		storagePlans = append(storagePlans, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return storagePlans, nil
+}
 
-	if err = json.Unmarshal(data, &storagePlans); err != nil {
-		return nil, err
-	}
+// ListServerStoragePlansPages is like ListServerStoragePlans, but the list is streamed to fn one page at a time, until
+// fn returns false
+func (cps *CloudProviderService) ListServerStoragePlansPages(
+	providerID string,
+	fn func(storagePlans []*types.StoragePlan) bool,
+) error {
+	return cps.ListServerStoragePlansPagesContext(context.Background(), providerID, fn)
+}
 
-	return storagePlans, nil
+// ListServerStoragePlansPagesContext is like ListServerStoragePlansPages, but the requests are cancelled as soon as ctx
+// is done. The page options carried by ctx are applied
+func (cps *CloudProviderService) ListServerStoragePlansPagesContext(
+	ctx context.Context,
+	providerID string,
+	fn func(storagePlans []*types.StoragePlan) bool,
+) error {
+	log.Debug("ListServerStoragePlansPages")
+
+	pager := utils.NewPager(ctx, cps.concertoService, fmt.Sprintf(APIPathCloudProviderStoragePlans, providerID))
+	for pager.Next() {
+		var storagePlans []*types.StoragePlan
+		if err := pager.Decode(&storagePlans); err != nil {
+			return err
+		}
+		if !fn(storagePlans) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // ListLoadBalancerPlans returns the list of load balancer plans as an array of LoadBalancerPlan
 func (cps *CloudProviderService) ListLoadBalancerPlans(
 	providerID string,
+) (loadBalancerPlans []*types.LoadBalancerPlan, err error) {
+	return cps.ListLoadBalancerPlansContext(context.Background(), providerID)
+}
+
+// ListLoadBalancerPlansContext is like ListLoadBalancerPlans, but the request is cancelled as soon as ctx is done
+func (cps *CloudProviderService) ListLoadBalancerPlansContext(
+	ctx context.Context,
+	providerID string,
 ) (loadBalancerPlans []*types.LoadBalancerPlan, err error) {
 	log.Debug("ListLoadBalancerPlans")
 
-	data, status, err := cps.concertoService.Get(
-		fmt.Sprintf(APIPathCloudProviderLoadBalancerPlans, providerID),
-	)
+	loadBalancerPlans = []*types.LoadBalancerPlan{}
+	err = cps.ListLoadBalancerPlansPagesContext(ctx, providerID, func(page []*types.LoadBalancerPlan) bool {
+		loadBalancerPlans = append(loadBalancerPlans, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return loadBalancerPlans, nil
+}
 
-	if err = json.Unmarshal(data, &loadBalancerPlans); err != nil {
-		return nil, err
-	}
+// ListLoadBalancerPlansPages is like ListLoadBalancerPlans, but the list is streamed to fn one page at a time, until fn
+// returns false
+func (cps *CloudProviderService) ListLoadBalancerPlansPages(
+	providerID string,
+	fn func(loadBalancerPlans []*types.LoadBalancerPlan) bool,
+) error {
+	return cps.ListLoadBalancerPlansPagesContext(context.Background(), providerID, fn)
+}
 
-	return loadBalancerPlans, nil
+// ListLoadBalancerPlansPagesContext is like ListLoadBalancerPlansPages, but the requests are cancelled as soon as ctx
+// is done. The page options carried by ctx are applied
+func (cps *CloudProviderService) ListLoadBalancerPlansPagesContext(
+	ctx context.Context,
+	providerID string,
+	fn func(loadBalancerPlans []*types.LoadBalancerPlan) bool,
+) error {
+	log.Debug("ListLoadBalancerPlansPages")
+
+	pager := utils.NewPager(ctx, cps.concertoService, fmt.Sprintf(APIPathCloudProviderLoadBalancerPlans, providerID))
+	for pager.Next() {
+		var loadBalancerPlans []*types.LoadBalancerPlan
+		if err := pager.Decode(&loadBalancerPlans); err != nil {
+			return err
+		}
+		if !fn(loadBalancerPlans) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // ListClusterPlans returns the list of cluster plans as an array of ClusterPlan
 func (cps *CloudProviderService) ListClusterPlans(providerID string) (clusterPlans []*types.ClusterPlan, err error) {
+	return cps.ListClusterPlansContext(context.Background(), providerID)
+}
+
+// ListClusterPlansContext is like ListClusterPlans, but the request is cancelled as soon as ctx is done
+func (cps *CloudProviderService) ListClusterPlansContext(
+	ctx context.Context,
+	providerID string,
+) (clusterPlans []*types.ClusterPlan, err error) {
 	log.Debug("ListClusterPlans")
 
-	data, status, err := cps.concertoService.Get(fmt.Sprintf(APIPathCloudProviderClusterPlans, providerID))
+	clusterPlans = []*types.ClusterPlan{}
+	err = cps.ListClusterPlansPagesContext(ctx, providerID, func(page []*types.ClusterPlan) bool {
+		clusterPlans = append(clusterPlans, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return clusterPlans, nil
+}
 
-	if err = json.Unmarshal(data, &clusterPlans); err != nil {
-		return nil, err
-	}
+// ListClusterPlansPages is like ListClusterPlans, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (cps *CloudProviderService) ListClusterPlansPages(
+	providerID string,
+	fn func(clusterPlans []*types.ClusterPlan) bool,
+) error {
+	return cps.ListClusterPlansPagesContext(context.Background(), providerID, fn)
+}
 
-	return clusterPlans, nil
+// ListClusterPlansPagesContext is like ListClusterPlansPages, but the requests are cancelled as soon as ctx is done.
+// The page options carried by ctx are applied
+func (cps *CloudProviderService) ListClusterPlansPagesContext(
+	ctx context.Context,
+	providerID string,
+	fn func(clusterPlans []*types.ClusterPlan) bool,
+) error {
+	log.Debug("ListClusterPlansPages")
+
+	pager := utils.NewPager(ctx, cps.concertoService, fmt.Sprintf(APIPathCloudProviderClusterPlans, providerID))
+	for pager.Next() {
+		var clusterPlans []*types.ClusterPlan
+		if err := pager.Decode(&clusterPlans); err != nil {
+			return err
+		}
+		if !fn(clusterPlans) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
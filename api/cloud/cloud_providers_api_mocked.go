@@ -28,7 +28,7 @@ func ListCloudProvidersMocked(t *testing.T, cloudProvidersIn []*types.CloudProvi
 	assert.Nil(err, "CloudProvider test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCloudProviders).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathCloudProviders, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	cloudProvidersOut, err := ds.ListCloudProviders()
 	assert.Nil(err, "Error getting cloudProvider list")
 	assert.Equal(cloudProvidersIn, cloudProvidersOut, "ListCloudProviders returned different cloudProviders")
@@ -52,7 +52,8 @@ func ListCloudProvidersFailErrMocked(t *testing.T, cloudProvidersIn []*types.Clo
 	assert.Nil(err, "CloudProvider test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCloudProviders).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathCloudProviders, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	cloudProvidersOut, err := ds.ListCloudProviders()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -78,7 +79,7 @@ func ListCloudProvidersFailStatusMocked(t *testing.T, cloudProvidersIn []*types.
 	assert.Nil(err, "CloudProvider test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCloudProviders).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathCloudProviders, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	cloudProvidersOut, err := ds.ListCloudProviders()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -103,7 +104,7 @@ func ListCloudProvidersFailJSONMocked(t *testing.T, cloudProvidersIn []*types.Cl
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathCloudProviders).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathCloudProviders, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	cloudProvidersOut, err := ds.ListCloudProviders()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -133,7 +134,8 @@ func ListServerStoragePlansMocked(
 	assert.Nil(err, "Storage plan test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderStoragePlans, providerID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudProviderStoragePlans, providerID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	storagePlansOut, err := ds.ListServerStoragePlans(providerID)
 	assert.Nil(err, "Error getting storage plan list")
 	assert.Equal(storagePlansIn, storagePlansOut, "ListServerStoragePlans returned different storage plans")
@@ -161,7 +163,7 @@ func ListServerStoragePlansFailErrMocked(
 	assert.Nil(err, "Storage plan test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderStoragePlans, providerID)).
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudProviderStoragePlans, providerID), 1, utils.DefaultPageSize)).
 		Return(dIn, 200, fmt.Errorf("mocked error"))
 	storagePlansOut, err := ds.ListServerStoragePlans(providerID)
 
@@ -192,7 +194,8 @@ func ListServerStoragePlansFailStatusMocked(
 	assert.Nil(err, "Storage plan test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderStoragePlans, providerID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudProviderStoragePlans, providerID), 1, utils.DefaultPageSize)).
+		Return(dIn, 499, nil)
 	storagePlansOut, err := ds.ListServerStoragePlans(providerID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -221,7 +224,8 @@ func ListServerStoragePlansFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderStoragePlans, providerID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudProviderStoragePlans, providerID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	storagePlansOut, err := ds.ListServerStoragePlans(providerID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -251,7 +255,11 @@ func ListLoadBalancerPlansMocked(
 	assert.Nil(err, "LoadBalancerPlans test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderLoadBalancerPlans, providerID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathCloudProviderLoadBalancerPlans, providerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	loadBalancerPlansOut, err := ds.ListLoadBalancerPlans(providerID)
 
 	assert.Nil(err, "Error getting load balancer plan list")
@@ -284,8 +292,11 @@ func ListLoadBalancerPlansFailErrMocked(
 	assert.Nil(err, "LoadBalancerPlans test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderLoadBalancerPlans, providerID)).
-		Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathCloudProviderLoadBalancerPlans, providerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, fmt.Errorf("mocked error"))
 	loadBalancerPlansOut, err := ds.ListLoadBalancerPlans(providerID)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -315,7 +326,11 @@ func ListLoadBalancerPlansFailStatusMocked(
 	assert.Nil(err, "LoadBalancerPlans test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderLoadBalancerPlans, providerID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathCloudProviderLoadBalancerPlans, providerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 499, nil)
 	loadBalancerPlansOut, err := ds.ListLoadBalancerPlans(providerID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -344,7 +359,11 @@ func ListLoadBalancerPlansFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderLoadBalancerPlans, providerID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathCloudProviderLoadBalancerPlans, providerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	loadBalancerPlansOut, err := ds.ListLoadBalancerPlans(providerID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -370,7 +389,8 @@ func ListClusterPlansMocked(t *testing.T, clusterPlansIn []*types.ClusterPlan, p
 	assert.Nil(err, "ClusterPlans test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderClusterPlans, providerID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudProviderClusterPlans, providerID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	clusterPlansOut, err := ds.ListClusterPlans(providerID)
 
 	assert.Nil(err, "Error getting cluster plan list")
@@ -397,7 +417,7 @@ func ListClusterPlansFailErrMocked(
 	assert.Nil(err, "ClusterPlans test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderClusterPlans, providerID)).
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudProviderClusterPlans, providerID), 1, utils.DefaultPageSize)).
 		Return(dIn, 200, fmt.Errorf("mocked error"))
 	clusterPlansOut, err := ds.ListClusterPlans(providerID)
 
@@ -426,7 +446,8 @@ func ListClusterPlansFailStatusMocked(
 	assert.Nil(err, "ClusterPlans test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderClusterPlans, providerID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudProviderClusterPlans, providerID), 1, utils.DefaultPageSize)).
+		Return(dIn, 499, nil)
 	clusterPlansOut, err := ds.ListClusterPlans(providerID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -453,7 +474,8 @@ func ListClusterPlansFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderClusterPlans, providerID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudProviderClusterPlans, providerID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	clusterPlansOut, err := ds.ListClusterPlans(providerID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
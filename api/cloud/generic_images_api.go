@@ -3,7 +3,7 @@
 package cloud
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 
 	"github.com/ingrammicro/cio/api/types"
@@ -31,20 +31,50 @@ func NewGenericImageService(concertoService utils.ConcertoService) (*GenericImag
 
 // ListGenericImages returns the list of generic images as an array of GenericImage
 func (gis *GenericImageService) ListGenericImages() (genericImages []*types.GenericImage, err error) {
+	return gis.ListGenericImagesContext(context.Background())
+}
+
+// ListGenericImagesContext is like ListGenericImages, but the request is cancelled as soon as ctx is done
+func (gis *GenericImageService) ListGenericImagesContext(
+	ctx context.Context,
+) (genericImages []*types.GenericImage, err error) {
 	log.Debug("ListGenericImages")
 
-	data, status, err := gis.concertoService.Get(APIPathCloudGenericImages)
+	genericImages = []*types.GenericImage{}
+	err = gis.ListGenericImagesPagesContext(ctx, func(page []*types.GenericImage) bool {
+		genericImages = append(genericImages, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return genericImages, nil
+}
 
-	if err = json.Unmarshal(data, &genericImages); err != nil {
-		return nil, err
-	}
+// ListGenericImagesPages is like ListGenericImages, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (gis *GenericImageService) ListGenericImagesPages(fn func(genericImages []*types.GenericImage) bool) error {
+	return gis.ListGenericImagesPagesContext(context.Background(), fn)
+}
 
-	return genericImages, nil
+// ListGenericImagesPagesContext is like ListGenericImagesPages, but the requests are cancelled as soon as ctx is done.
+// The page options carried by ctx are applied
+func (gis *GenericImageService) ListGenericImagesPagesContext(
+	ctx context.Context,
+	fn func(genericImages []*types.GenericImage) bool,
+) error {
+	log.Debug("ListGenericImagesPages")
+
+	pager := utils.NewPager(ctx, gis.concertoService, APIPathCloudGenericImages)
+	for pager.Next() {
+		var genericImages []*types.GenericImage
+		if err := pager.Decode(&genericImages); err != nil {
+			return err
+		}
+		if !fn(genericImages) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
@@ -30,7 +30,7 @@ func ListGenericImagesMocked(t *testing.T, genericImagesIn []*types.GenericImage
 	assert.Nil(err, "GenericImage test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCloudGenericImages).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathCloudGenericImages, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	genericImagesOut, err := ds.ListGenericImages()
 	assert.Nil(err, "Error getting genericImage list")
 	assert.Equal(genericImagesIn, genericImagesOut, "ListGenericImages returned different genericImages")
@@ -54,7 +54,8 @@ func ListGenericImagesFailErrMocked(t *testing.T, genericImagesIn []*types.Gener
 	assert.Nil(err, "GenericImage test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCloudGenericImages).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathCloudGenericImages, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	genericImagesOut, err := ds.ListGenericImages()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -80,7 +81,7 @@ func ListGenericImagesFailStatusMocked(t *testing.T, genericImagesIn []*types.Ge
 	assert.Nil(err, "GenericImage test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCloudGenericImages).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathCloudGenericImages, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	genericImagesOut, err := ds.ListGenericImages()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -105,7 +106,7 @@ func ListGenericImagesFailJSONMocked(t *testing.T, genericImagesIn []*types.Gene
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathCloudGenericImages).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathCloudGenericImages, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	genericImagesOut, err := ds.ListGenericImages()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
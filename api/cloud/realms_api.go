@@ -3,6 +3,7 @@
 package cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -33,29 +34,62 @@ func NewRealmService(concertoService utils.ConcertoService) (*RealmService, erro
 
 // ListRealms returns the list of realms as an array of Realm
 func (rs *RealmService) ListRealms(providerID string) (realms []*types.Realm, err error) {
+	return rs.ListRealmsContext(context.Background(), providerID)
+}
+
+// ListRealmsContext is like ListRealms, but the request is cancelled as soon as ctx is done
+func (rs *RealmService) ListRealmsContext(ctx context.Context, providerID string) (realms []*types.Realm, err error) {
 	log.Debug("ListRealms")
 
-	data, status, err := rs.concertoService.Get(fmt.Sprintf(APIPathCloudProviderRealms, providerID))
+	realms = []*types.Realm{}
+	err = rs.ListRealmsPagesContext(ctx, providerID, func(page []*types.Realm) bool {
+		realms = append(realms, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return realms, nil
+}
 
-	if err = json.Unmarshal(data, &realms); err != nil {
-		return nil, err
-	}
+// ListRealmsPages is like ListRealms, but the list is streamed to fn one page at a time, until fn returns false
+func (rs *RealmService) ListRealmsPages(providerID string, fn func(realms []*types.Realm) bool) error {
+	return rs.ListRealmsPagesContext(context.Background(), providerID, fn)
+}
 
-	return realms, nil
+// ListRealmsPagesContext is like ListRealmsPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (rs *RealmService) ListRealmsPagesContext(
+	ctx context.Context,
+	providerID string,
+	fn func(realms []*types.Realm) bool,
+) error {
+	log.Debug("ListRealmsPages")
+
+	pager := utils.NewPager(ctx, rs.concertoService, fmt.Sprintf(APIPathCloudProviderRealms, providerID))
+	for pager.Next() {
+		var realms []*types.Realm
+		if err := pager.Decode(&realms); err != nil {
+			return err
+		}
+		if !fn(realms) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetRealm returns a realm by its ID
 func (rs *RealmService) GetRealm(realmID string) (realm *types.Realm, err error) {
+	return rs.GetRealmContext(context.Background(), realmID)
+}
+
+// GetRealmContext is like GetRealm, but the request is cancelled as soon as ctx is done
+func (rs *RealmService) GetRealmContext(ctx context.Context, realmID string) (realm *types.Realm, err error) {
 	log.Debug("GetRealm")
 
-	data, status, err := rs.concertoService.Get(fmt.Sprintf(APIPathCloudRealm, realmID))
+	data, status, err := rs.concertoService.GetContext(ctx, fmt.Sprintf(APIPathCloudRealm, realmID))
 	if err != nil {
 		return nil, err
 	}
@@ -73,20 +107,55 @@ func (rs *RealmService) GetRealm(realmID string) (realm *types.Realm, err error)
 
 // ListRealmNodePoolPlans returns the list of node pool plans as an array of NodePoolPlan
 func (rs *RealmService) ListRealmNodePoolPlans(realmID string) (nodePoolPlans []*types.NodePoolPlan, err error) {
+	return rs.ListRealmNodePoolPlansContext(context.Background(), realmID)
+}
+
+// ListRealmNodePoolPlansContext is like ListRealmNodePoolPlans, but the request is cancelled as soon as ctx is done
+func (rs *RealmService) ListRealmNodePoolPlansContext(
+	ctx context.Context,
+	realmID string,
+) (nodePoolPlans []*types.NodePoolPlan, err error) {
 	log.Debug("ListRealmNodePoolPlans")
 
-	data, status, err := rs.concertoService.Get(fmt.Sprintf(APIPathCloudRealmNodePoolPlans, realmID))
+	nodePoolPlans = []*types.NodePoolPlan{}
+	err = rs.ListRealmNodePoolPlansPagesContext(ctx, realmID, func(page []*types.NodePoolPlan) bool {
+		nodePoolPlans = append(nodePoolPlans, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return nodePoolPlans, nil
+}
 
-	if err = json.Unmarshal(data, &nodePoolPlans); err != nil {
-		return nil, err
-	}
+// ListRealmNodePoolPlansPages is like ListRealmNodePoolPlans, but the list is streamed to fn one page at a time, until
+// fn returns false
+func (rs *RealmService) ListRealmNodePoolPlansPages(
+	realmID string,
+	fn func(nodePoolPlans []*types.NodePoolPlan) bool,
+) error {
+	return rs.ListRealmNodePoolPlansPagesContext(context.Background(), realmID, fn)
+}
 
-	return nodePoolPlans, nil
+// ListRealmNodePoolPlansPagesContext is like ListRealmNodePoolPlansPages, but the requests are cancelled as soon as ctx
+// is done. The page options carried by ctx are applied
+func (rs *RealmService) ListRealmNodePoolPlansPagesContext(
+	ctx context.Context,
+	realmID string,
+	fn func(nodePoolPlans []*types.NodePoolPlan) bool,
+) error {
+	log.Debug("ListRealmNodePoolPlansPages")
+
+	pager := utils.NewPager(ctx, rs.concertoService, fmt.Sprintf(APIPathCloudRealmNodePoolPlans, realmID))
+	for pager.Next() {
+		var nodePoolPlans []*types.NodePoolPlan
+		if err := pager.Decode(&nodePoolPlans); err != nil {
+			return err
+		}
+		if !fn(nodePoolPlans) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
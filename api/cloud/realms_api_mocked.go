@@ -28,7 +28,8 @@ func ListRealmsMocked(t *testing.T, realmsIn []*types.Realm, cloudProviderID str
 	assert.Nil(err, "Realms test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderRealms, cloudProviderID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudProviderRealms, cloudProviderID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	realmsOut, err := ds.ListRealms(cloudProviderID)
 
 	assert.Nil(err, "Error getting realms")
@@ -53,7 +54,7 @@ func ListRealmsFailErrMocked(t *testing.T, realmsIn []*types.Realm, cloudProvide
 	assert.Nil(err, "Realms test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderRealms, cloudProviderID)).
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudProviderRealms, cloudProviderID), 1, utils.DefaultPageSize)).
 		Return(dIn, 200, fmt.Errorf("mocked error"))
 	realmsOut, err := ds.ListRealms(cloudProviderID)
 
@@ -80,7 +81,8 @@ func ListRealmsFailStatusMocked(t *testing.T, realmsIn []*types.Realm, cloudProv
 	assert.Nil(err, "Realms test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderRealms, cloudProviderID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudProviderRealms, cloudProviderID), 1, utils.DefaultPageSize)).
+		Return(dIn, 499, nil)
 	realmsOut, err := ds.ListRealms(cloudProviderID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -105,7 +107,8 @@ func ListRealmsFailJSONMocked(t *testing.T, realmsIn []*types.Realm, cloudProvid
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderRealms, cloudProviderID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudProviderRealms, cloudProviderID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	realmsOut, err := ds.ListRealms(cloudProviderID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -235,7 +238,8 @@ func ListRealmNodePoolPlansMocked(
 	assert.Nil(err, "NodePoolPlans test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudRealmNodePoolPlans, realmID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudRealmNodePoolPlans, realmID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	nodePoolPlansOut, err := ds.ListRealmNodePoolPlans(realmID)
 
 	assert.Nil(err, "Error getting node pool plan list")
@@ -262,7 +266,7 @@ func ListRealmNodePoolPlansFailErrMocked(
 	assert.Nil(err, "NodePoolPlans test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudRealmNodePoolPlans, realmID)).
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudRealmNodePoolPlans, realmID), 1, utils.DefaultPageSize)).
 		Return(dIn, 200, fmt.Errorf("mocked error"))
 	nodePoolPlansOut, err := ds.ListRealmNodePoolPlans(realmID)
 
@@ -291,7 +295,8 @@ func ListRealmNodePoolPlansFailStatusMocked(
 	assert.Nil(err, "NodePoolPlans test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudRealmNodePoolPlans, realmID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudRealmNodePoolPlans, realmID), 1, utils.DefaultPageSize)).
+		Return(dIn, 499, nil)
 	nodePoolPlansOut, err := ds.ListRealmNodePoolPlans(realmID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -318,7 +323,8 @@ func ListRealmNodePoolPlansFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudRealmNodePoolPlans, realmID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudRealmNodePoolPlans, realmID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	nodePoolPlansOut, err := ds.ListRealmNodePoolPlans(realmID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
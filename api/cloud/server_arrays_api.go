@@ -3,6 +3,7 @@
 package cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -36,29 +37,67 @@ func NewServerArrayService(concertoService utils.ConcertoService) (*ServerArrayS
 
 // ListServerArrays returns the list of server arrays as an array of ServerArray
 func (sas *ServerArrayService) ListServerArrays() (serverArrays []*types.ServerArray, err error) {
+	return sas.ListServerArraysContext(context.Background())
+}
+
+// ListServerArraysContext is like ListServerArrays, but the request is cancelled as soon as ctx is done
+func (sas *ServerArrayService) ListServerArraysContext(
+	ctx context.Context,
+) (serverArrays []*types.ServerArray, err error) {
 	log.Debug("ListServerArrays")
 
-	data, status, err := sas.concertoService.Get(APIPathCloudServerArrays)
+	serverArrays = []*types.ServerArray{}
+	err = sas.ListServerArraysPagesContext(ctx, func(page []*types.ServerArray) bool {
+		serverArrays = append(serverArrays, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return serverArrays, nil
+}
 
-	if err = json.Unmarshal(data, &serverArrays); err != nil {
-		return nil, err
-	}
+// ListServerArraysPages is like ListServerArrays, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (sas *ServerArrayService) ListServerArraysPages(fn func(serverArrays []*types.ServerArray) bool) error {
+	return sas.ListServerArraysPagesContext(context.Background(), fn)
+}
 
-	return serverArrays, nil
+// ListServerArraysPagesContext is like ListServerArraysPages, but the requests are cancelled as soon as ctx is done.
+// The page options carried by ctx are applied
+func (sas *ServerArrayService) ListServerArraysPagesContext(
+	ctx context.Context,
+	fn func(serverArrays []*types.ServerArray) bool,
+) error {
+	log.Debug("ListServerArraysPages")
+
+	pager := utils.NewPager(ctx, sas.concertoService, APIPathCloudServerArrays)
+	for pager.Next() {
+		var serverArrays []*types.ServerArray
+		if err := pager.Decode(&serverArrays); err != nil {
+			return err
+		}
+		if !fn(serverArrays) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetServerArray returns a server array by its ID
 func (sas *ServerArrayService) GetServerArray(serverArrayID string) (serverArray *types.ServerArray, err error) {
+	return sas.GetServerArrayContext(context.Background(), serverArrayID)
+}
+
+// GetServerArrayContext is like GetServerArray, but the request is cancelled as soon as ctx is done
+func (sas *ServerArrayService) GetServerArrayContext(
+	ctx context.Context,
+	serverArrayID string,
+) (serverArray *types.ServerArray, err error) {
 	log.Debug("GetServerArray")
 
-	data, status, err := sas.concertoService.Get(fmt.Sprintf(APIPathCloudServerArray, serverArrayID))
+	data, status, err := sas.concertoService.GetContext(ctx, fmt.Sprintf(APIPathCloudServerArray, serverArrayID))
 	if err != nil {
 		return nil, err
 	}
@@ -77,10 +116,18 @@ func (sas *ServerArrayService) GetServerArray(serverArrayID string) (serverArray
 // CreateServerArray creates a server array
 func (sas *ServerArrayService) CreateServerArray(
 	serverArrayParams *map[string]interface{},
+) (serverArray *types.ServerArray, err error) {
+	return sas.CreateServerArrayContext(context.Background(), serverArrayParams)
+}
+
+// CreateServerArrayContext is like CreateServerArray, but the request is cancelled as soon as ctx is done
+func (sas *ServerArrayService) CreateServerArrayContext(
+	ctx context.Context,
+	serverArrayParams *map[string]interface{},
 ) (serverArray *types.ServerArray, err error) {
 	log.Debug("CreateServerArray")
 
-	data, status, err := sas.concertoService.Post(APIPathCloudServerArrays, serverArrayParams)
+	data, status, err := sas.concertoService.PostContext(ctx, APIPathCloudServerArrays, serverArrayParams)
 	if err != nil {
 		return nil, err
 	}
@@ -100,10 +147,20 @@ func (sas *ServerArrayService) CreateServerArray(
 func (sas *ServerArrayService) UpdateServerArray(
 	serverArrayID string,
 	serverArrayParams *map[string]interface{},
+) (serverArray *types.ServerArray, err error) {
+	return sas.UpdateServerArrayContext(context.Background(), serverArrayID, serverArrayParams)
+}
+
+// UpdateServerArrayContext is like UpdateServerArray, but the request is cancelled as soon as ctx is done
+func (sas *ServerArrayService) UpdateServerArrayContext(
+	ctx context.Context,
+	serverArrayID string,
+	serverArrayParams *map[string]interface{},
 ) (serverArray *types.ServerArray, err error) {
 	log.Debug("UpdateServerArray")
 
-	data, status, err := sas.concertoService.Put(
+	data, status, err := sas.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathCloudServerArray, serverArrayID),
 		serverArrayParams,
 	)
@@ -126,10 +183,20 @@ func (sas *ServerArrayService) UpdateServerArray(
 func (sas *ServerArrayService) BootServerArray(
 	serverArrayID string,
 	serverArrayParams *map[string]interface{},
+) (serverArray *types.ServerArray, err error) {
+	return sas.BootServerArrayContext(context.Background(), serverArrayID, serverArrayParams)
+}
+
+// BootServerArrayContext is like BootServerArray, but the request is cancelled as soon as ctx is done
+func (sas *ServerArrayService) BootServerArrayContext(
+	ctx context.Context,
+	serverArrayID string,
+	serverArrayParams *map[string]interface{},
 ) (serverArray *types.ServerArray, err error) {
 	log.Debug("BootServerArray")
 
-	data, status, err := sas.concertoService.Put(
+	data, status, err := sas.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathCloudServerArrayBoot, serverArrayID),
 		serverArrayParams,
 	)
@@ -152,10 +219,20 @@ func (sas *ServerArrayService) BootServerArray(
 func (sas *ServerArrayService) ShutdownServerArray(
 	serverArrayID string,
 	serverArrayParams *map[string]interface{},
+) (serverArray *types.ServerArray, err error) {
+	return sas.ShutdownServerArrayContext(context.Background(), serverArrayID, serverArrayParams)
+}
+
+// ShutdownServerArrayContext is like ShutdownServerArray, but the request is cancelled as soon as ctx is done
+func (sas *ServerArrayService) ShutdownServerArrayContext(
+	ctx context.Context,
+	serverArrayID string,
+	serverArrayParams *map[string]interface{},
 ) (serverArray *types.ServerArray, err error) {
 	log.Debug("ShutdownServerArray")
 
-	data, status, err := sas.concertoService.Put(
+	data, status, err := sas.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathCloudServerArrayShutdown, serverArrayID),
 		serverArrayParams,
 	)
@@ -178,10 +255,20 @@ func (sas *ServerArrayService) ShutdownServerArray(
 func (sas *ServerArrayService) EmptyServerArray(
 	serverArrayID string,
 	serverArrayParams *map[string]interface{},
+) (serverArray *types.ServerArray, err error) {
+	return sas.EmptyServerArrayContext(context.Background(), serverArrayID, serverArrayParams)
+}
+
+// EmptyServerArrayContext is like EmptyServerArray, but the request is cancelled as soon as ctx is done
+func (sas *ServerArrayService) EmptyServerArrayContext(
+	ctx context.Context,
+	serverArrayID string,
+	serverArrayParams *map[string]interface{},
 ) (serverArray *types.ServerArray, err error) {
 	log.Debug("EmptyServerArray")
 
-	data, status, err := sas.concertoService.Put(
+	data, status, err := sas.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathCloudServerArrayEmpty, serverArrayID),
 		serverArrayParams,
 	)
@@ -204,10 +291,20 @@ func (sas *ServerArrayService) EmptyServerArray(
 func (sas *ServerArrayService) EnlargeServerArray(
 	serverArrayID string,
 	serverArrayParams *map[string]interface{},
+) (serverArray *types.ServerArray, err error) {
+	return sas.EnlargeServerArrayContext(context.Background(), serverArrayID, serverArrayParams)
+}
+
+// EnlargeServerArrayContext is like EnlargeServerArray, but the request is cancelled as soon as ctx is done
+func (sas *ServerArrayService) EnlargeServerArrayContext(
+	ctx context.Context,
+	serverArrayID string,
+	serverArrayParams *map[string]interface{},
 ) (serverArray *types.ServerArray, err error) {
 	log.Debug("EnlargeServerArray")
 
-	data, status, err := sas.concertoService.Post(
+	data, status, err := sas.concertoService.PostContext(
+		ctx,
 		fmt.Sprintf(APIPathCloudServerArrayServers, serverArrayID),
 		serverArrayParams,
 	)
@@ -228,29 +325,69 @@ func (sas *ServerArrayService) EnlargeServerArray(
 
 // ListServerArrayServers returns the list of servers in a server array as an array of Server
 func (sas *ServerArrayService) ListServerArrayServers(serverArrayID string) (servers []*types.Server, err error) {
+	return sas.ListServerArrayServersContext(context.Background(), serverArrayID)
+}
+
+// ListServerArrayServersContext is like ListServerArrayServers, but the request is cancelled as soon as ctx is done
+func (sas *ServerArrayService) ListServerArrayServersContext(
+	ctx context.Context,
+	serverArrayID string,
+) (servers []*types.Server, err error) {
 	log.Debug("ListServerArrayServers")
 
-	data, status, err := sas.concertoService.Get(fmt.Sprintf(APIPathCloudServerArrayServers, serverArrayID))
+	servers = []*types.Server{}
+	err = sas.ListServerArrayServersPagesContext(ctx, serverArrayID, func(page []*types.Server) bool {
+		servers = append(servers, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return servers, nil
+}
 
-	if err = json.Unmarshal(data, &servers); err != nil {
-		return nil, err
-	}
+// ListServerArrayServersPages is like ListServerArrayServers, but the list is streamed to fn one page at a time, until
+// fn returns false
+func (sas *ServerArrayService) ListServerArrayServersPages(
+	serverArrayID string,
+	fn func(servers []*types.Server) bool,
+) error {
+	return sas.ListServerArrayServersPagesContext(context.Background(), serverArrayID, fn)
+}
 
-	return servers, nil
+// ListServerArrayServersPagesContext is like ListServerArrayServersPages, but the requests are cancelled as soon as ctx
+// is done. The page options carried by ctx are applied
+func (sas *ServerArrayService) ListServerArrayServersPagesContext(
+	ctx context.Context,
+	serverArrayID string,
+	fn func(servers []*types.Server) bool,
+) error {
+	log.Debug("ListServerArrayServersPages")
+
+	pager := utils.NewPager(ctx, sas.concertoService, fmt.Sprintf(APIPathCloudServerArrayServers, serverArrayID))
+	for pager.Next() {
+		var servers []*types.Server
+		if err := pager.Decode(&servers); err != nil {
+			return err
+		}
+		if !fn(servers) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // DeleteServerArray deletes a server array by its ID
 func (sas *ServerArrayService) DeleteServerArray(serverArrayID string) (err error) {
+	return sas.DeleteServerArrayContext(context.Background(), serverArrayID)
+}
+
+// DeleteServerArrayContext is like DeleteServerArray, but the request is cancelled as soon as ctx is done
+func (sas *ServerArrayService) DeleteServerArrayContext(ctx context.Context, serverArrayID string) (err error) {
 	log.Debug("DeleteServerArray")
 
-	data, status, err := sas.concertoService.Delete(fmt.Sprintf(APIPathCloudServerArray, serverArrayID))
+	data, status, err := sas.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathCloudServerArray, serverArrayID))
 	if err != nil {
 		return err
 	}
@@ -30,7 +30,7 @@ func ListServerArraysMocked(t *testing.T, serverArraysIn []*types.ServerArray) [
 	assert.Nil(err, "Server array test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCloudServerArrays).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathCloudServerArrays, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	serverArraysOut, err := ds.ListServerArrays()
 	assert.Nil(err, "Error getting server array list")
 	assert.Equal(serverArraysIn, serverArraysOut, "ListServerArrays returned different server arrays")
@@ -54,7 +54,8 @@ func ListServerArraysFailErrMocked(t *testing.T, serverArraysIn []*types.ServerA
 	assert.Nil(err, "Server array test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCloudServerArrays).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathCloudServerArrays, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	serverArraysOut, err := ds.ListServerArrays()
 	assert.NotNil(err, "We are expecting an error")
 	assert.Nil(serverArraysOut, "Expecting nil output")
@@ -79,7 +80,7 @@ func ListServerArraysFailStatusMocked(t *testing.T, serverArraysIn []*types.Serv
 	assert.Nil(err, "Server array test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCloudServerArrays).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathCloudServerArrays, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	serverArraysOut, err := ds.ListServerArrays()
 	assert.NotNil(err, "We are expecting an status code error")
 	assert.Nil(serverArraysOut, "Expecting nil output")
@@ -103,7 +104,7 @@ func ListServerArraysFailJSONMocked(t *testing.T, serverArraysIn []*types.Server
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathCloudServerArrays).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathCloudServerArrays, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	serverArraysOut, err := ds.ListServerArrays()
 	assert.NotNil(err, "We are expecting a marshalling error")
 	assert.Nil(serverArraysOut, "Expecting nil output")
@@ -935,7 +936,8 @@ func ListServerArrayServersMocked(t *testing.T, serversIn []*types.Server, serve
 	assert.Nil(err, "Server array test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerArrayServers, serverArrayID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerArrayServers, serverArrayID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	serversOut, err := ds.ListServerArrayServers(serverArrayID)
 	assert.Nil(err, "Error getting server list")
 	assert.Equal(serversIn, serversOut, "ListServerArrayServers returned different servers")
@@ -963,7 +965,7 @@ func ListServerArrayServersFailErrMocked(
 	assert.Nil(err, "Server array test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerArrayServers, serverArrayID)).
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerArrayServers, serverArrayID), 1, utils.DefaultPageSize)).
 		Return(dIn, 200, fmt.Errorf("mocked error"))
 	serversOut, err := ds.ListServerArrayServers(serverArrayID)
 	assert.NotNil(err, "We are expecting an error")
@@ -993,7 +995,8 @@ func ListServerArrayServersFailStatusMocked(
 	assert.Nil(err, "Server array test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerArrayServers, serverArrayID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerArrayServers, serverArrayID), 1, utils.DefaultPageSize)).
+		Return(dIn, 499, nil)
 	serversOut, err := ds.ListServerArrayServers(serverArrayID)
 	assert.NotNil(err, "We are expecting an status code error")
 	assert.Nil(serversOut, "Expecting nil output")
@@ -1021,7 +1024,8 @@ func ListServerArrayServersFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerArrayServers, serverArrayID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerArrayServers, serverArrayID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	serversOut, err := ds.ListServerArrayServers(serverArrayID)
 	assert.NotNil(err, "We are expecting a marshalling error")
 	assert.Nil(serversOut, "Expecting nil output")
@@ -3,6 +3,7 @@
 package cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -34,30 +35,80 @@ func NewServerPlanService(concertoService utils.ConcertoService) (*ServerPlanSer
 func (sps *ServerPlanService) ListServerPlans(
 	providerID string,
 	realmID string,
+) (serverPlans []*types.ServerPlan, err error) {
+	return sps.ListServerPlansContext(context.Background(), providerID, realmID)
+}
+
+// ListServerPlansContext is like ListServerPlans, but the request is cancelled as soon as ctx is done
+func (sps *ServerPlanService) ListServerPlansContext(
+	ctx context.Context,
+	providerID string,
+	realmID string,
 ) (serverPlans []*types.ServerPlan, err error) {
 	log.Debug("ListServerPlans")
 
-	data, status, err := sps.concertoService.Get(fmt.Sprintf(APIPathCloudProviderServerPlansByRealm, providerID, realmID))
+	serverPlans = []*types.ServerPlan{}
+	err = sps.ListServerPlansPagesContext(ctx, providerID, realmID, func(page []*types.ServerPlan) bool {
+		serverPlans = append(serverPlans, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return serverPlans, nil
+}
 
-	if err = json.Unmarshal(data, &serverPlans); err != nil {
-		return nil, err
-	}
+// ListServerPlansPages is like ListServerPlans, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (sps *ServerPlanService) ListServerPlansPages(
+	providerID string,
+	realmID string,
+	fn func(serverPlans []*types.ServerPlan) bool,
+) error {
+	return sps.ListServerPlansPagesContext(context.Background(), providerID, realmID, fn)
+}
 
-	return serverPlans, nil
+// ListServerPlansPagesContext is like ListServerPlansPages, but the requests are cancelled as soon as ctx is done. The
+// page options carried by ctx are applied
+func (sps *ServerPlanService) ListServerPlansPagesContext(
+	ctx context.Context,
+	providerID string,
+	realmID string,
+	fn func(serverPlans []*types.ServerPlan) bool,
+) error {
+	log.Debug("ListServerPlansPages")
+
+	pager := utils.NewPager(
+		ctx,
+		sps.concertoService,
+		fmt.Sprintf(APIPathCloudProviderServerPlansByRealm, providerID, realmID),
+	)
+	for pager.Next() {
+		var serverPlans []*types.ServerPlan
+		if err := pager.Decode(&serverPlans); err != nil {
+			return err
+		}
+		if !fn(serverPlans) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetServerPlan returns a serverPlan by its ID
 func (sps *ServerPlanService) GetServerPlan(planID string) (serverPlan *types.ServerPlan, err error) {
+	return sps.GetServerPlanContext(context.Background(), planID)
+}
+
+// GetServerPlanContext is like GetServerPlan, but the request is cancelled as soon as ctx is done
+func (sps *ServerPlanService) GetServerPlanContext(
+	ctx context.Context,
+	planID string,
+) (serverPlan *types.ServerPlan, err error) {
 	log.Debug("GetServerPlan")
 
-	data, status, err := sps.concertoService.Get(fmt.Sprintf(APIPathCloudServerPlans, planID))
+	data, status, err := sps.concertoService.GetContext(ctx, fmt.Sprintf(APIPathCloudServerPlans, planID))
 	if err != nil {
 		return nil, err
 	}
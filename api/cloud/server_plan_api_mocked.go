@@ -35,7 +35,11 @@ func ListServerPlansMocked(
 	assert.Nil(err, "ServerPlan test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderServerPlansByRealm, cloudProviderId, realmId)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathCloudProviderServerPlansByRealm, cloudProviderId, realmId),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	serverPlansOut, err := ds.ListServerPlans(cloudProviderId, realmId)
 	assert.Nil(err, "Error getting serverPlan list")
 	assert.Equal(serverPlansIn, serverPlansOut, "ListServerPlans returned different serverPlans")
@@ -64,8 +68,11 @@ func ListServerPlansFailErrMocked(
 	assert.Nil(err, "ServerPlan test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderServerPlansByRealm, cloudProviderId, realmId)).
-		Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathCloudProviderServerPlansByRealm, cloudProviderId, realmId),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, fmt.Errorf("mocked error"))
 	serverPlansOut, err := ds.ListServerPlans(cloudProviderId, realmId)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -96,7 +103,11 @@ func ListServerPlansFailStatusMocked(
 	assert.Nil(err, "ServerPlan test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderServerPlansByRealm, cloudProviderId, realmId)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathCloudProviderServerPlansByRealm, cloudProviderId, realmId),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 499, nil)
 	serverPlansOut, err := ds.ListServerPlans(cloudProviderId, realmId)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -126,7 +137,11 @@ func ListServerPlansFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudProviderServerPlansByRealm, cloudProviderId, realmId)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathCloudProviderServerPlansByRealm, cloudProviderId, realmId),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	serverPlansOut, err := ds.ListServerPlans(cloudProviderId, realmId)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
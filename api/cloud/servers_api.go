@@ -3,6 +3,7 @@
 package cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -41,29 +42,58 @@ func NewServerService(concertoService utils.ConcertoService) (*ServerService, er
 
 // ListServers returns the list of servers as an array of Server
 func (ss *ServerService) ListServers() (servers []*types.Server, err error) {
+	return ss.ListServersContext(context.Background())
+}
+
+// ListServersContext is like ListServers, but the request is cancelled as soon as ctx is done
+func (ss *ServerService) ListServersContext(ctx context.Context) (servers []*types.Server, err error) {
 	log.Debug("ListServers")
 
-	data, status, err := ss.concertoService.Get(APIPathCloudServers)
+	servers = []*types.Server{}
+	err = ss.ListServersPagesContext(ctx, func(page []*types.Server) bool {
+		servers = append(servers, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return servers, nil
+}
 
-	if err = json.Unmarshal(data, &servers); err != nil {
-		return nil, err
-	}
+// ListServersPages is like ListServers, but the list is streamed to fn one page at a time, until fn returns false
+func (ss *ServerService) ListServersPages(fn func(servers []*types.Server) bool) error {
+	return ss.ListServersPagesContext(context.Background(), fn)
+}
 
-	return servers, nil
+// ListServersPagesContext is like ListServersPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (ss *ServerService) ListServersPagesContext(ctx context.Context, fn func(servers []*types.Server) bool) error {
+	log.Debug("ListServersPages")
+
+	pager := utils.NewPager(ctx, ss.concertoService, APIPathCloudServers)
+	for pager.Next() {
+		var servers []*types.Server
+		if err := pager.Decode(&servers); err != nil {
+			return err
+		}
+		if !fn(servers) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetServer returns a server by its ID
 func (ss *ServerService) GetServer(serverID string) (server *types.Server, err error) {
+	return ss.GetServerContext(context.Background(), serverID)
+}
+
+// GetServerContext is like GetServer, but the request is cancelled as soon as ctx is done
+func (ss *ServerService) GetServerContext(ctx context.Context, serverID string) (server *types.Server, err error) {
 	log.Debug("GetServer")
 
-	data, status, err := ss.concertoService.Get(fmt.Sprintf(APIPathCloudServer, serverID))
+	data, status, err := ss.concertoService.GetContext(ctx, fmt.Sprintf(APIPathCloudServer, serverID))
 	if err != nil {
 		return nil, err
 	}
@@ -81,9 +111,17 @@ func (ss *ServerService) GetServer(serverID string) (server *types.Server, err e
 
 // CreateServer creates a server
 func (ss *ServerService) CreateServer(serverParams *map[string]interface{}) (server *types.Server, err error) {
+	return ss.CreateServerContext(context.Background(), serverParams)
+}
+
+// CreateServerContext is like CreateServer, but the request is cancelled as soon as ctx is done
+func (ss *ServerService) CreateServerContext(
+	ctx context.Context,
+	serverParams *map[string]interface{},
+) (server *types.Server, err error) {
 	log.Debug("CreateServer")
 
-	data, status, err := ss.concertoService.Post(APIPathCloudServers, serverParams)
+	data, status, err := ss.concertoService.PostContext(ctx, APIPathCloudServers, serverParams)
 
 	if err != nil {
 		return nil, err
@@ -100,14 +138,40 @@ func (ss *ServerService) CreateServer(serverParams *map[string]interface{}) (ser
 	return server, nil
 }
 
+// CreateServerWithInput is like CreateServer, but takes a typed input, which is validated before being sent
+func (ss *ServerService) CreateServerWithInput(input *types.ServerCreateInput) (server *types.Server, err error) {
+	return ss.CreateServerWithInputContext(context.Background(), input)
+}
+
+// CreateServerWithInputContext is like CreateServerWithInput, but the request is cancelled as soon as ctx is done
+func (ss *ServerService) CreateServerWithInputContext(
+	ctx context.Context,
+	input *types.ServerCreateInput,
+) (server *types.Server, err error) {
+	serverParams, err := types.InputParams(input)
+	if err != nil {
+		return nil, err
+	}
+	return ss.CreateServerContext(ctx, serverParams)
+}
+
 // UpdateServer updates a server by its ID
 func (ss *ServerService) UpdateServer(
 	serverID string,
 	serverParams *map[string]interface{},
+) (server *types.Server, err error) {
+	return ss.UpdateServerContext(context.Background(), serverID, serverParams)
+}
+
+// UpdateServerContext is like UpdateServer, but the request is cancelled as soon as ctx is done
+func (ss *ServerService) UpdateServerContext(
+	ctx context.Context,
+	serverID string,
+	serverParams *map[string]interface{},
 ) (server *types.Server, err error) {
 	log.Debug("UpdateServer")
 
-	data, status, err := ss.concertoService.Put(fmt.Sprintf(APIPathCloudServer, serverID), serverParams)
+	data, status, err := ss.concertoService.PutContext(ctx, fmt.Sprintf(APIPathCloudServer, serverID), serverParams)
 
 	if err != nil {
 		return nil, err
@@ -124,14 +188,44 @@ func (ss *ServerService) UpdateServer(
 	return server, nil
 }
 
+// UpdateServerWithInput is like UpdateServer, but takes a typed input, which is validated before being sent
+func (ss *ServerService) UpdateServerWithInput(
+	serverID string,
+	input *types.ServerUpdateInput,
+) (server *types.Server, err error) {
+	return ss.UpdateServerWithInputContext(context.Background(), serverID, input)
+}
+
+// UpdateServerWithInputContext is like UpdateServerWithInput, but the request is cancelled as soon as ctx is done
+func (ss *ServerService) UpdateServerWithInputContext(
+	ctx context.Context,
+	serverID string,
+	input *types.ServerUpdateInput,
+) (server *types.Server, err error) {
+	serverParams, err := types.InputParams(input)
+	if err != nil {
+		return nil, err
+	}
+	return ss.UpdateServerContext(ctx, serverID, serverParams)
+}
+
 // BootServer boots a server by its ID
 func (ss *ServerService) BootServer(
 	serverID string,
 	serverParams *map[string]interface{},
+) (server *types.Server, err error) {
+	return ss.BootServerContext(context.Background(), serverID, serverParams)
+}
+
+// BootServerContext is like BootServer, but the request is cancelled as soon as ctx is done
+func (ss *ServerService) BootServerContext(
+	ctx context.Context,
+	serverID string,
+	serverParams *map[string]interface{},
 ) (server *types.Server, err error) {
 	log.Debug("BootServer")
 
-	data, status, err := ss.concertoService.Put(fmt.Sprintf(APIPathCloudServerBoot, serverID), serverParams)
+	data, status, err := ss.concertoService.PutContext(ctx, fmt.Sprintf(APIPathCloudServerBoot, serverID), serverParams)
 
 	if err != nil {
 		return nil, err
@@ -152,10 +246,23 @@ func (ss *ServerService) BootServer(
 func (ss *ServerService) RebootServer(
 	serverID string,
 	serverParams *map[string]interface{},
+) (server *types.Server, err error) {
+	return ss.RebootServerContext(context.Background(), serverID, serverParams)
+}
+
+// RebootServerContext is like RebootServer, but the request is cancelled as soon as ctx is done
+func (ss *ServerService) RebootServerContext(
+	ctx context.Context,
+	serverID string,
+	serverParams *map[string]interface{},
 ) (server *types.Server, err error) {
 	log.Debug("RebootServer")
 
-	data, status, err := ss.concertoService.Put(fmt.Sprintf(APIPathCloudServerReboot, serverID), serverParams)
+	data, status, err := ss.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathCloudServerReboot, serverID),
+		serverParams,
+	)
 
 	if err != nil {
 		return nil, err
@@ -176,10 +283,23 @@ func (ss *ServerService) RebootServer(
 func (ss *ServerService) ShutdownServer(
 	serverID string,
 	serverParams *map[string]interface{},
+) (server *types.Server, err error) {
+	return ss.ShutdownServerContext(context.Background(), serverID, serverParams)
+}
+
+// ShutdownServerContext is like ShutdownServer, but the request is cancelled as soon as ctx is done
+func (ss *ServerService) ShutdownServerContext(
+	ctx context.Context,
+	serverID string,
+	serverParams *map[string]interface{},
 ) (server *types.Server, err error) {
 	log.Debug("ShutdownServer")
 
-	data, status, err := ss.concertoService.Put(fmt.Sprintf(APIPathCloudServerShutdown, serverID), serverParams)
+	data, status, err := ss.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathCloudServerShutdown, serverID),
+		serverParams,
+	)
 
 	if err != nil {
 		return nil, err
@@ -200,10 +320,23 @@ func (ss *ServerService) ShutdownServer(
 func (ss *ServerService) OverrideServer(
 	serverID string,
 	serverParams *map[string]interface{},
+) (server *types.Server, err error) {
+	return ss.OverrideServerContext(context.Background(), serverID, serverParams)
+}
+
+// OverrideServerContext is like OverrideServer, but the request is cancelled as soon as ctx is done
+func (ss *ServerService) OverrideServerContext(
+	ctx context.Context,
+	serverID string,
+	serverParams *map[string]interface{},
 ) (server *types.Server, err error) {
 	log.Debug("OverrideServer")
 
-	data, status, err := ss.concertoService.Put(fmt.Sprintf(APIPathCloudServerOverride, serverID), serverParams)
+	data, status, err := ss.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathCloudServerOverride, serverID),
+		serverParams,
+	)
 
 	if err != nil {
 		return nil, err
@@ -222,9 +355,14 @@ func (ss *ServerService) OverrideServer(
 
 // DeleteServer deletes a server by its ID
 func (ss *ServerService) DeleteServer(serverID string) (err error) {
+	return ss.DeleteServerContext(context.Background(), serverID)
+}
+
+// DeleteServerContext is like DeleteServer, but the request is cancelled as soon as ctx is done
+func (ss *ServerService) DeleteServerContext(ctx context.Context, serverID string) (err error) {
 	log.Debug("DeleteServer")
 
-	data, status, err := ss.concertoService.Delete(fmt.Sprintf(APIPathCloudServer, serverID))
+	data, status, err := ss.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathCloudServer, serverID))
 	if err != nil {
 		return err
 	}
@@ -238,86 +376,213 @@ func (ss *ServerService) DeleteServer(serverID string) (err error) {
 
 // ListServerFloatingIPs returns the list of floating IPs as an array of FloatingIP
 func (ss *ServerService) ListServerFloatingIPs(serverID string) (floatingIPs []*types.FloatingIP, err error) {
+	return ss.ListServerFloatingIPsContext(context.Background(), serverID)
+}
+
+// ListServerFloatingIPsContext is like ListServerFloatingIPs, but the request is cancelled as soon as ctx is done
+func (ss *ServerService) ListServerFloatingIPsContext(
+	ctx context.Context,
+	serverID string,
+) (floatingIPs []*types.FloatingIP, err error) {
 	log.Debug("ListServerFloatingIPs")
 
-	data, status, err := ss.concertoService.Get(fmt.Sprintf(APIPathCloudServerFloatingIPs, serverID))
+	floatingIPs = []*types.FloatingIP{}
+	err = ss.ListServerFloatingIPsPagesContext(ctx, serverID, func(page []*types.FloatingIP) bool {
+		floatingIPs = append(floatingIPs, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return floatingIPs, nil
+}
 
-	if err = json.Unmarshal(data, &floatingIPs); err != nil {
-		return nil, err
-	}
+// ListServerFloatingIPsPages is like ListServerFloatingIPs, but the list is streamed to fn one page at a time, until fn
+// returns false
+func (ss *ServerService) ListServerFloatingIPsPages(
+	serverID string,
+	fn func(floatingIPs []*types.FloatingIP) bool,
+) error {
+	return ss.ListServerFloatingIPsPagesContext(context.Background(), serverID, fn)
+}
 
-	return floatingIPs, nil
+// ListServerFloatingIPsPagesContext is like ListServerFloatingIPsPages, but the requests are cancelled as soon as ctx
+// is done. The page options carried by ctx are applied
+func (ss *ServerService) ListServerFloatingIPsPagesContext(
+	ctx context.Context,
+	serverID string,
+	fn func(floatingIPs []*types.FloatingIP) bool,
+) error {
+	log.Debug("ListServerFloatingIPsPages")
+
+	pager := utils.NewPager(ctx, ss.concertoService, fmt.Sprintf(APIPathCloudServerFloatingIPs, serverID))
+	for pager.Next() {
+		var floatingIPs []*types.FloatingIP
+		if err := pager.Decode(&floatingIPs); err != nil {
+			return err
+		}
+		if !fn(floatingIPs) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // ListServerVolumes returns the list of volumes as an array of Volume
 func (ss *ServerService) ListServerVolumes(serverID string) (volumes []*types.Volume, err error) {
+	return ss.ListServerVolumesContext(context.Background(), serverID)
+}
+
+// ListServerVolumesContext is like ListServerVolumes, but the request is cancelled as soon as ctx is done
+func (ss *ServerService) ListServerVolumesContext(
+	ctx context.Context,
+	serverID string,
+) (volumes []*types.Volume, err error) {
 	log.Debug("ListServerVolumes")
 
-	data, status, err := ss.concertoService.Get(fmt.Sprintf(APIPathCloudServerVolumes, serverID))
+	volumes = []*types.Volume{}
+	err = ss.ListServerVolumesPagesContext(ctx, serverID, func(page []*types.Volume) bool {
+		volumes = append(volumes, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return volumes, nil
+}
 
-	if err = json.Unmarshal(data, &volumes); err != nil {
-		return nil, err
-	}
+// ListServerVolumesPages is like ListServerVolumes, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (ss *ServerService) ListServerVolumesPages(serverID string, fn func(volumes []*types.Volume) bool) error {
+	return ss.ListServerVolumesPagesContext(context.Background(), serverID, fn)
+}
 
-	return volumes, nil
+// ListServerVolumesPagesContext is like ListServerVolumesPages, but the requests are cancelled as soon as ctx is done.
+// The page options carried by ctx are applied
+func (ss *ServerService) ListServerVolumesPagesContext(
+	ctx context.Context,
+	serverID string,
+	fn func(volumes []*types.Volume) bool,
+) error {
+	log.Debug("ListServerVolumesPages")
+
+	pager := utils.NewPager(ctx, ss.concertoService, fmt.Sprintf(APIPathCloudServerVolumes, serverID))
+	for pager.Next() {
+		var volumes []*types.Volume
+		if err := pager.Decode(&volumes); err != nil {
+			return err
+		}
+		if !fn(volumes) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 //======= Events ==========
 
 // ListEvents returns a list of events by server ID
 func (ss *ServerService) ListEvents(serverID string) (events []*types.Event, err error) {
+	return ss.ListEventsContext(context.Background(), serverID)
+}
+
+// ListEventsContext is like ListEvents, but the request is cancelled as soon as ctx is done
+func (ss *ServerService) ListEventsContext(ctx context.Context, serverID string) (events []*types.Event, err error) {
 	log.Debug("ListEvents")
 
-	data, status, err := ss.concertoService.Get(fmt.Sprintf(APIPathCloudServerEvents, serverID))
+	events = []*types.Event{}
+	err = ss.ListEventsPagesContext(ctx, serverID, func(page []*types.Event) bool {
+		events = append(events, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return events, nil
+}
 
-	if err = json.Unmarshal(data, &events); err != nil {
-		return nil, err
-	}
+// ListEventsPages is like ListEvents, but the list is streamed to fn one page at a time, until fn returns false
+func (ss *ServerService) ListEventsPages(serverID string, fn func(events []*types.Event) bool) error {
+	return ss.ListEventsPagesContext(context.Background(), serverID, fn)
+}
 
-	return events, nil
+// ListEventsPagesContext is like ListEventsPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (ss *ServerService) ListEventsPagesContext(
+	ctx context.Context,
+	serverID string,
+	fn func(events []*types.Event) bool,
+) error {
+	log.Debug("ListEventsPages")
+
+	pager := utils.NewPager(ctx, ss.concertoService, fmt.Sprintf(APIPathCloudServerEvents, serverID))
+	for pager.Next() {
+		var events []*types.Event
+		if err := pager.Decode(&events); err != nil {
+			return err
+		}
+		if !fn(events) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 //======= Operational Scripts ==========
 
 // ListOperationalScripts returns a list of scripts by server ID
 func (ss *ServerService) ListOperationalScripts(serverID string) (scripts []*types.ScriptChar, err error) {
+	return ss.ListOperationalScriptsContext(context.Background(), serverID)
+}
+
+// ListOperationalScriptsContext is like ListOperationalScripts, but the request is cancelled as soon as ctx is done
+func (ss *ServerService) ListOperationalScriptsContext(
+	ctx context.Context,
+	serverID string,
+) (scripts []*types.ScriptChar, err error) {
 	log.Debug("ListOperationalScripts")
 
-	data, status, err := ss.concertoService.Get(fmt.Sprintf(APIPathCloudServerOperationalScripts, serverID))
+	scripts = []*types.ScriptChar{}
+	err = ss.ListOperationalScriptsPagesContext(ctx, serverID, func(page []*types.ScriptChar) bool {
+		scripts = append(scripts, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return scripts, nil
+}
 
-	if err = json.Unmarshal(data, &scripts); err != nil {
-		return nil, err
-	}
+// ListOperationalScriptsPages is like ListOperationalScripts, but the list is streamed to fn one page at a time, until
+// fn returns false
+func (ss *ServerService) ListOperationalScriptsPages(serverID string, fn func(scripts []*types.ScriptChar) bool) error {
+	return ss.ListOperationalScriptsPagesContext(context.Background(), serverID, fn)
+}
 
-	return scripts, nil
+// ListOperationalScriptsPagesContext is like ListOperationalScriptsPages, but the requests are cancelled as soon as ctx
+// is done. The page options carried by ctx are applied
+func (ss *ServerService) ListOperationalScriptsPagesContext(
+	ctx context.Context,
+	serverID string,
+	fn func(scripts []*types.ScriptChar) bool,
+) error {
+	log.Debug("ListOperationalScriptsPages")
+
+	pager := utils.NewPager(ctx, ss.concertoService, fmt.Sprintf(APIPathCloudServerOperationalScripts, serverID))
+	for pager.Next() {
+		var scripts []*types.ScriptChar
+		if err := pager.Decode(&scripts); err != nil {
+			return err
+		}
+		if !fn(scripts) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // ExecuteOperationalScript executes an operational script by its server ID and the script id
@@ -325,10 +590,21 @@ func (ss *ServerService) ExecuteOperationalScript(
 	serverID string,
 	scriptID string,
 	serverParams *map[string]interface{},
+) (script *types.Event, err error) {
+	return ss.ExecuteOperationalScriptContext(context.Background(), serverID, scriptID, serverParams)
+}
+
+// ExecuteOperationalScriptContext is like ExecuteOperationalScript, but the request is cancelled as soon as ctx is done
+func (ss *ServerService) ExecuteOperationalScriptContext(
+	ctx context.Context,
+	serverID string,
+	scriptID string,
+	serverParams *map[string]interface{},
 ) (script *types.Event, err error) {
 	log.Debug("ExecuteOperationalScript")
 
-	data, status, err := ss.concertoService.Put(
+	data, status, err := ss.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathCloudServerOperationalScriptExecute, serverID, scriptID),
 		serverParams,
 	)
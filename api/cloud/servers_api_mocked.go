@@ -30,7 +30,7 @@ func ListServersMocked(t *testing.T, serversIn []*types.Server) []*types.Server
 	assert.Nil(err, "Server test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCloudServers).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathCloudServers, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	serversOut, err := ds.ListServers()
 	assert.Nil(err, "Error getting server list")
 	assert.Equal(serversIn, serversOut, "ListServers returned different servers")
@@ -54,7 +54,8 @@ func ListServersFailErrMocked(t *testing.T, serversIn []*types.Server) []*types.
 	assert.Nil(err, "Server test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCloudServers).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathCloudServers, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	serversOut, err := ds.ListServers()
 	assert.NotNil(err, "We are expecting an error")
 	assert.Nil(serversOut, "Expecting nil output")
@@ -79,7 +80,7 @@ func ListServersFailStatusMocked(t *testing.T, serversIn []*types.Server) []*typ
 	assert.Nil(err, "Server test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCloudServers).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathCloudServers, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	serversOut, err := ds.ListServers()
 	assert.NotNil(err, "We are expecting an status code error")
 	assert.Nil(serversOut, "Expecting nil output")
@@ -103,7 +104,7 @@ func ListServersFailJSONMocked(t *testing.T, serversIn []*types.Server) []*types
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathCloudServers).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathCloudServers, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	serversOut, err := ds.ListServers()
 	assert.NotNil(err, "We are expecting a marshalling error")
 	assert.Nil(serversOut, "Expecting nil output")
@@ -240,6 +241,73 @@ func CreateServerMocked(t *testing.T, serverIn *types.Server) *types.Server {
 	return serverOut
 }
 
+// CreateServerWithInputMocked test mocked function
+func CreateServerWithInputMocked(t *testing.T, serverIn *types.Server) *types.Server {
+
+	assert := assert.New(t)
+
+	// wire up
+	cs := &utils.MockConcertoService{}
+	ds, err := NewServerService(cs)
+	assert.Nil(err, "Couldn't load server service")
+	assert.NotNil(ds, "Server service not instanced")
+
+	input := &types.ServerCreateInput{
+		Name:              serverIn.Name,
+		SSHProfileID:      serverIn.SSHProfileID,
+		FirewallProfileID: serverIn.FirewallProfileID,
+		TemplateID:        serverIn.TemplateID,
+		ServerPlanID:      serverIn.ServerPlanID,
+		CloudAccountID:    serverIn.CloudAccountID,
+	}
+	mapIn := &map[string]interface{}{
+		"name":                serverIn.Name,
+		"ssh_profile_id":      serverIn.SSHProfileID,
+		"firewall_profile_id": serverIn.FirewallProfileID,
+		"template_id":         serverIn.TemplateID,
+		"server_plan_id":      serverIn.ServerPlanID,
+		"cloud_account_id":    serverIn.CloudAccountID,
+	}
+
+	// to json
+	dOut, err := json.Marshal(serverIn)
+	assert.Nil(err, "Server test data corrupted")
+
+	// call service
+	cs.On("Post", APIPathCloudServers, mapIn).Return(dOut, 200, nil)
+	serverOut, err := ds.CreateServerWithInput(input)
+	assert.Nil(err, "Error creating server")
+	assert.Equal(serverIn, serverOut, "CreateServerWithInput returned different servers")
+
+	return serverOut
+}
+
+// CreateServerWithInputFailValidationMocked test mocked function
+func CreateServerWithInputFailValidationMocked(t *testing.T, serverIn *types.Server) *types.Server {
+
+	assert := assert.New(t)
+
+	// wire up
+	cs := &utils.MockConcertoService{}
+	ds, err := NewServerService(cs)
+	assert.Nil(err, "Couldn't load server service")
+	assert.NotNil(ds, "Server service not instanced")
+
+	// call service
+	serverOut, err := ds.CreateServerWithInput(&types.ServerCreateInput{Name: serverIn.Name})
+	assert.Nil(serverOut, "Expecting nil output")
+	assert.Equal(
+		&types.MissingFieldsError{
+			Fields: []string{"ssh_profile_id", "firewall_profile_id", "template_id", "server_plan_id", "cloud_account_id"},
+		},
+		err,
+		"Inputs missing required fields should not be sent",
+	)
+	cs.AssertNotCalled(t, "Post")
+
+	return serverOut
+}
+
 // CreateServerFailErrMocked test mocked function
 func CreateServerFailErrMocked(t *testing.T, serverIn *types.Server) *types.Server {
 
@@ -357,6 +425,32 @@ func UpdateServerMocked(t *testing.T, serverIn *types.Server) *types.Server {
 	return serverOut
 }
 
+// UpdateServerWithInputMocked test mocked function
+func UpdateServerWithInputMocked(t *testing.T, serverIn *types.Server) *types.Server {
+
+	assert := assert.New(t)
+
+	// wire up
+	cs := &utils.MockConcertoService{}
+	ds, err := NewServerService(cs)
+	assert.Nil(err, "Couldn't load server service")
+	assert.NotNil(ds, "Server service not instanced")
+
+	mapIn := &map[string]interface{}{"name": serverIn.Name}
+
+	// to json
+	dOut, err := json.Marshal(serverIn)
+	assert.Nil(err, "Server test data corrupted")
+
+	// call service
+	cs.On("Put", fmt.Sprintf(APIPathCloudServer, serverIn.ID), mapIn).Return(dOut, 200, nil)
+	serverOut, err := ds.UpdateServerWithInput(serverIn.ID, &types.ServerUpdateInput{Name: serverIn.Name})
+	assert.Nil(err, "Error updating server")
+	assert.Equal(serverIn, serverOut, "UpdateServerWithInput returned different servers")
+
+	return serverOut
+}
+
 // UpdateServerFailErrMocked test mocked function
 func UpdateServerFailErrMocked(t *testing.T, serverIn *types.Server) *types.Server {
 
@@ -1001,7 +1095,8 @@ func ListServerFloatingIPsMocked(t *testing.T, floatingIPsIn []*types.FloatingIP
 	assert.Nil(err, "Server floating IP test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerFloatingIPs, serverID)).Return(fIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerFloatingIPs, serverID), 1, utils.DefaultPageSize)).
+		Return(fIn, 200, nil)
 	floatingIPsOut, err := ds.ListServerFloatingIPs(serverID)
 	assert.Nil(err, "Error getting server floating IP list")
 	assert.Equal(floatingIPsIn, floatingIPsOut, "ListServerFloatingIPsMocked returned different server floating IPs")
@@ -1029,7 +1124,8 @@ func ListServerFloatingIPsFailErrMocked(
 	assert.Nil(err, "Server floating IP test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerFloatingIPs, serverID)).Return(fIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerFloatingIPs, serverID), 1, utils.DefaultPageSize)).
+		Return(fIn, 200, fmt.Errorf("mocked error"))
 	floatingIPsOut, err := ds.ListServerFloatingIPs(serverID)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -1059,7 +1155,8 @@ func ListServerFloatingIPsFailStatusMocked(
 	assert.Nil(err, "Server floating IP test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerFloatingIPs, serverID)).Return(fIn, 499, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerFloatingIPs, serverID), 1, utils.DefaultPageSize)).
+		Return(fIn, 499, nil)
 	floatingIPsOut, err := ds.ListServerFloatingIPs(serverID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -1088,7 +1185,8 @@ func ListServerFloatingIPsFailJSONMocked(
 	fIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerFloatingIPs, serverID)).Return(fIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerFloatingIPs, serverID), 1, utils.DefaultPageSize)).
+		Return(fIn, 200, nil)
 	floatingIPsOut, err := ds.ListServerFloatingIPs(serverID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -1114,7 +1212,8 @@ func ListServerVolumesMocked(t *testing.T, volumesIn []*types.Volume, serverID s
 	assert.Nil(err, "Server volume test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerVolumes, serverID)).Return(vIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerVolumes, serverID), 1, utils.DefaultPageSize)).
+		Return(vIn, 200, nil)
 	vOut, err := ds.ListServerVolumes(serverID)
 	assert.Nil(err, "Error getting server volume list")
 	assert.Equal(volumesIn, vOut, "ListServerVolumesMocked returned different server volumes")
@@ -1138,7 +1237,8 @@ func ListServerVolumesFailErrMocked(t *testing.T, volumesIn []*types.Volume, ser
 	assert.Nil(err, "Server volume test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerVolumes, serverID)).Return(vIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerVolumes, serverID), 1, utils.DefaultPageSize)).
+		Return(vIn, 200, fmt.Errorf("mocked error"))
 	vOut, err := ds.ListServerVolumes(serverID)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -1164,7 +1264,8 @@ func ListServerVolumesFailStatusMocked(t *testing.T, volumesIn []*types.Volume,
 	assert.Nil(err, "Server volume test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerVolumes, serverID)).Return(vIn, 499, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerVolumes, serverID), 1, utils.DefaultPageSize)).
+		Return(vIn, 499, nil)
 	vOut, err := ds.ListServerVolumes(serverID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -1189,7 +1290,8 @@ func ListServerVolumesFailJSONMocked(t *testing.T, volumesIn []*types.Volume, se
 	vIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerVolumes, serverID)).Return(vIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerVolumes, serverID), 1, utils.DefaultPageSize)).
+		Return(vIn, 200, nil)
 	vOut, err := ds.ListServerVolumes(serverID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -1215,7 +1317,8 @@ func ListEventsListMocked(t *testing.T, eventsIn []*types.Event, serverID string
 	assert.Nil(err, "Server event test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerEvents, serverID)).Return(evIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerEvents, serverID), 1, utils.DefaultPageSize)).
+		Return(evIn, 200, nil)
 	evOut, err := ds.ListEvents(serverID)
 	assert.Nil(err, "Error getting server event list")
 	assert.Equal(eventsIn, evOut, "ListEventsList returned different server events")
@@ -1239,7 +1342,8 @@ func ListEventsListFailErrMocked(t *testing.T, eventsIn []*types.Event, serverID
 	assert.Nil(err, "Server event test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerEvents, serverID)).Return(evIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerEvents, serverID), 1, utils.DefaultPageSize)).
+		Return(evIn, 200, fmt.Errorf("mocked error"))
 	evOut, err := ds.ListEvents(serverID)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -1265,7 +1369,8 @@ func ListEventsListFailStatusMocked(t *testing.T, eventsIn []*types.Event, serve
 	assert.Nil(err, "Server event test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerEvents, serverID)).Return(evIn, 499, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerEvents, serverID), 1, utils.DefaultPageSize)).
+		Return(evIn, 499, nil)
 	evOut, err := ds.ListEvents(serverID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -1290,7 +1395,8 @@ func ListEventsListFailJSONMocked(t *testing.T, eventsIn []*types.Event, serverI
 	evIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerEvents, serverID)).Return(evIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerEvents, serverID), 1, utils.DefaultPageSize)).
+		Return(evIn, 200, nil)
 	evOut, err := ds.ListEvents(serverID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -1316,7 +1422,8 @@ func ListOperationalScriptsMocked(t *testing.T, scriptsIn []*types.ScriptChar, s
 	assert.Nil(err, "Server operational scripts test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerOperationalScripts, serverID)).Return(oscIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerOperationalScripts, serverID), 1, utils.DefaultPageSize)).
+		Return(oscIn, 200, nil)
 	scriptsOut, err := ds.ListOperationalScripts(serverID)
 	assert.Nil(err, "Error getting operational script list")
 	assert.Equal(scriptsIn, scriptsOut, "ListOperationalScripts returned different operational scripts")
@@ -1344,7 +1451,7 @@ func ListOperationalScriptsFailErrMocked(
 	assert.Nil(err, "Server operational scripts test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerOperationalScripts, serverID)).
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerOperationalScripts, serverID), 1, utils.DefaultPageSize)).
 		Return(oscIn, 200, fmt.Errorf("mocked error"))
 	scriptsOut, err := ds.ListOperationalScripts(serverID)
 
@@ -1375,7 +1482,8 @@ func ListOperationalScriptsFailStatusMocked(
 	assert.Nil(err, "Server operational scripts test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerOperationalScripts, serverID)).Return(oscIn, 499, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerOperationalScripts, serverID), 1, utils.DefaultPageSize)).
+		Return(oscIn, 499, nil)
 	scriptsOut, err := ds.ListOperationalScripts(serverID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -1404,7 +1512,8 @@ func ListOperationalScriptsFailJSONMocked(
 	oscIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerOperationalScripts, serverID)).Return(oscIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCloudServerOperationalScripts, serverID), 1, utils.DefaultPageSize)).
+		Return(oscIn, 200, nil)
 	scriptsOut, err := ds.ListOperationalScripts(serverID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -38,6 +38,8 @@ func TestCreateServer(t *testing.T) {
 	serversIn := testdata.GetServerData()
 	for _, serverIn := range serversIn {
 		CreateServerMocked(t, serverIn)
+		CreateServerWithInputMocked(t, serverIn)
+		CreateServerWithInputFailValidationMocked(t, serverIn)
 		CreateServerFailErrMocked(t, serverIn)
 		CreateServerFailStatusMocked(t, serverIn)
 		CreateServerFailJSONMocked(t, serverIn)
@@ -48,6 +50,7 @@ func TestUpdateServer(t *testing.T) {
 	serversIn := testdata.GetServerData()
 	for _, serverIn := range serversIn {
 		UpdateServerMocked(t, serverIn)
+		UpdateServerWithInputMocked(t, serverIn)
 		UpdateServerFailErrMocked(t, serverIn)
 		UpdateServerFailStatusMocked(t, serverIn)
 		UpdateServerFailJSONMocked(t, serverIn)
@@ -3,6 +3,7 @@
 package cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -32,29 +33,65 @@ func NewSSHProfileService(concertoService utils.ConcertoService) (*SSHProfileSer
 
 // ListSSHProfiles returns the list of sshProfiles as an array of SSHProfile
 func (sps *SSHProfileService) ListSSHProfiles() (sshProfiles []*types.SSHProfile, err error) {
+	return sps.ListSSHProfilesContext(context.Background())
+}
+
+// ListSSHProfilesContext is like ListSSHProfiles, but the request is cancelled as soon as ctx is done
+func (sps *SSHProfileService) ListSSHProfilesContext(ctx context.Context) (sshProfiles []*types.SSHProfile, err error) {
 	log.Debug("ListSSHProfiles")
 
-	data, status, err := sps.concertoService.Get(APIPathCloudSSHProfiles)
+	sshProfiles = []*types.SSHProfile{}
+	err = sps.ListSSHProfilesPagesContext(ctx, func(page []*types.SSHProfile) bool {
+		sshProfiles = append(sshProfiles, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return sshProfiles, nil
+}
 
-	if err = json.Unmarshal(data, &sshProfiles); err != nil {
-		return nil, err
-	}
+// ListSSHProfilesPages is like ListSSHProfiles, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (sps *SSHProfileService) ListSSHProfilesPages(fn func(sshProfiles []*types.SSHProfile) bool) error {
+	return sps.ListSSHProfilesPagesContext(context.Background(), fn)
+}
 
-	return sshProfiles, nil
+// ListSSHProfilesPagesContext is like ListSSHProfilesPages, but the requests are cancelled as soon as ctx is done. The
+// page options carried by ctx are applied
+func (sps *SSHProfileService) ListSSHProfilesPagesContext(
+	ctx context.Context,
+	fn func(sshProfiles []*types.SSHProfile) bool,
+) error {
+	log.Debug("ListSSHProfilesPages")
+
+	pager := utils.NewPager(ctx, sps.concertoService, APIPathCloudSSHProfiles)
+	for pager.Next() {
+		var sshProfiles []*types.SSHProfile
+		if err := pager.Decode(&sshProfiles); err != nil {
+			return err
+		}
+		if !fn(sshProfiles) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetSSHProfile returns a sshProfile by its ID
 func (sps *SSHProfileService) GetSSHProfile(sshProfileID string) (sshProfile *types.SSHProfile, err error) {
+	return sps.GetSSHProfileContext(context.Background(), sshProfileID)
+}
+
+// GetSSHProfileContext is like GetSSHProfile, but the request is cancelled as soon as ctx is done
+func (sps *SSHProfileService) GetSSHProfileContext(
+	ctx context.Context,
+	sshProfileID string,
+) (sshProfile *types.SSHProfile, err error) {
 	log.Debug("GetSSHProfile")
 
-	data, status, err := sps.concertoService.Get(fmt.Sprintf(APIPathCloudSSHProfile, sshProfileID))
+	data, status, err := sps.concertoService.GetContext(ctx, fmt.Sprintf(APIPathCloudSSHProfile, sshProfileID))
 	if err != nil {
 		return nil, err
 	}
@@ -73,10 +110,18 @@ func (sps *SSHProfileService) GetSSHProfile(sshProfileID string) (sshProfile *ty
 // CreateSSHProfile creates a sshProfile
 func (sps *SSHProfileService) CreateSSHProfile(
 	sshProfileParams *map[string]interface{},
+) (sshProfile *types.SSHProfile, err error) {
+	return sps.CreateSSHProfileContext(context.Background(), sshProfileParams)
+}
+
+// CreateSSHProfileContext is like CreateSSHProfile, but the request is cancelled as soon as ctx is done
+func (sps *SSHProfileService) CreateSSHProfileContext(
+	ctx context.Context,
+	sshProfileParams *map[string]interface{},
 ) (sshProfile *types.SSHProfile, err error) {
 	log.Debug("CreateSSHProfile")
 
-	data, status, err := sps.concertoService.Post(APIPathCloudSSHProfiles, sshProfileParams)
+	data, status, err := sps.concertoService.PostContext(ctx, APIPathCloudSSHProfiles, sshProfileParams)
 
 	if err != nil {
 		return nil, err
@@ -93,14 +138,47 @@ func (sps *SSHProfileService) CreateSSHProfile(
 	return sshProfile, nil
 }
 
+// CreateSSHProfileWithInput is like CreateSSHProfile, but takes a typed input, which is validated before being sent
+func (sps *SSHProfileService) CreateSSHProfileWithInput(
+	input *types.SSHProfileCreateInput,
+) (sshProfile *types.SSHProfile, err error) {
+	return sps.CreateSSHProfileWithInputContext(context.Background(), input)
+}
+
+// CreateSSHProfileWithInputContext is like CreateSSHProfileWithInput, but the request is cancelled as soon as ctx is
+// done
+func (sps *SSHProfileService) CreateSSHProfileWithInputContext(
+	ctx context.Context,
+	input *types.SSHProfileCreateInput,
+) (sshProfile *types.SSHProfile, err error) {
+	sshProfileParams, err := types.InputParams(input)
+	if err != nil {
+		return nil, err
+	}
+	return sps.CreateSSHProfileContext(ctx, sshProfileParams)
+}
+
 // UpdateSSHProfile updates a sshProfile by its ID
 func (sps *SSHProfileService) UpdateSSHProfile(
 	sshProfileID string,
 	sshProfileParams *map[string]interface{},
+) (sshProfile *types.SSHProfile, err error) {
+	return sps.UpdateSSHProfileContext(context.Background(), sshProfileID, sshProfileParams)
+}
+
+// UpdateSSHProfileContext is like UpdateSSHProfile, but the request is cancelled as soon as ctx is done
+func (sps *SSHProfileService) UpdateSSHProfileContext(
+	ctx context.Context,
+	sshProfileID string,
+	sshProfileParams *map[string]interface{},
 ) (sshProfile *types.SSHProfile, err error) {
 	log.Debug("UpdateSSHProfile")
 
-	data, status, err := sps.concertoService.Put(fmt.Sprintf(APIPathCloudSSHProfile, sshProfileID), sshProfileParams)
+	data, status, err := sps.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathCloudSSHProfile, sshProfileID),
+		sshProfileParams,
+	)
 
 	if err != nil {
 		return nil, err
@@ -117,11 +195,38 @@ func (sps *SSHProfileService) UpdateSSHProfile(
 	return sshProfile, nil
 }
 
+// UpdateSSHProfileWithInput is like UpdateSSHProfile, but takes a typed input, which is validated before being sent
+func (sps *SSHProfileService) UpdateSSHProfileWithInput(
+	sshProfileID string,
+	input *types.SSHProfileUpdateInput,
+) (sshProfile *types.SSHProfile, err error) {
+	return sps.UpdateSSHProfileWithInputContext(context.Background(), sshProfileID, input)
+}
+
+// UpdateSSHProfileWithInputContext is like UpdateSSHProfileWithInput, but the request is cancelled as soon as ctx is
+// done
+func (sps *SSHProfileService) UpdateSSHProfileWithInputContext(
+	ctx context.Context,
+	sshProfileID string,
+	input *types.SSHProfileUpdateInput,
+) (sshProfile *types.SSHProfile, err error) {
+	sshProfileParams, err := types.InputParams(input)
+	if err != nil {
+		return nil, err
+	}
+	return sps.UpdateSSHProfileContext(ctx, sshProfileID, sshProfileParams)
+}
+
 // DeleteSSHProfile deletes a sshProfile by its ID
 func (sps *SSHProfileService) DeleteSSHProfile(sshProfileID string) (err error) {
+	return sps.DeleteSSHProfileContext(context.Background(), sshProfileID)
+}
+
+// DeleteSSHProfileContext is like DeleteSSHProfile, but the request is cancelled as soon as ctx is done
+func (sps *SSHProfileService) DeleteSSHProfileContext(ctx context.Context, sshProfileID string) (err error) {
 	log.Debug("DeleteSSHProfile")
 
-	data, status, err := sps.concertoService.Delete(fmt.Sprintf(APIPathCloudSSHProfile, sshProfileID))
+	data, status, err := sps.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathCloudSSHProfile, sshProfileID))
 	if err != nil {
 		return err
 	}
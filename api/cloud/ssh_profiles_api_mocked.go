@@ -30,7 +30,7 @@ func ListSSHProfilesMocked(t *testing.T, sshProfilesIn []*types.SSHProfile) []*t
 	assert.Nil(err, "SSHProfile test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCloudSSHProfiles).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathCloudSSHProfiles, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	sshProfilesOut, err := ds.ListSSHProfiles()
 	assert.Nil(err, "Error getting sshProfile list")
 	assert.Equal(sshProfilesIn, sshProfilesOut, "ListSSHProfiles returned different sshProfiles")
@@ -54,7 +54,8 @@ func ListSSHProfilesFailErrMocked(t *testing.T, sshProfilesIn []*types.SSHProfil
 	assert.Nil(err, "SSHProfile test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCloudSSHProfiles).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathCloudSSHProfiles, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	sshProfilesOut, err := ds.ListSSHProfiles()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -80,7 +81,7 @@ func ListSSHProfilesFailStatusMocked(t *testing.T, sshProfilesIn []*types.SSHPro
 	assert.Nil(err, "SSHProfile test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCloudSSHProfiles).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathCloudSSHProfiles, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	sshProfilesOut, err := ds.ListSSHProfiles()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -105,7 +106,7 @@ func ListSSHProfilesFailJSONMocked(t *testing.T, sshProfilesIn []*types.SSHProfi
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathCloudSSHProfiles).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathCloudSSHProfiles, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	sshProfilesOut, err := ds.ListSSHProfiles()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -3,6 +3,7 @@
 package cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -36,10 +37,22 @@ func NewTemporaryArchiveService(concertoService utils.ConcertoService) (*Tempora
 // CreateTemporaryArchive creates a temporary archive
 func (tas *TemporaryArchiveService) CreateTemporaryArchive(
 	temporaryArchiveParams *map[string]interface{},
+) (temporaryArchive *types.TemporaryArchive, err error) {
+	return tas.CreateTemporaryArchiveContext(context.Background(), temporaryArchiveParams)
+}
+
+// CreateTemporaryArchiveContext is like CreateTemporaryArchive, but the request is cancelled as soon as ctx is done
+func (tas *TemporaryArchiveService) CreateTemporaryArchiveContext(
+	ctx context.Context,
+	temporaryArchiveParams *map[string]interface{},
 ) (temporaryArchive *types.TemporaryArchive, err error) {
 	log.Debug("CreateTemporaryArchive")
 
-	data, status, err := tas.concertoService.Post(APIPathPluginsToscaTemporaryArchives, temporaryArchiveParams)
+	data, status, err := tas.concertoService.PostContext(
+		ctx,
+		APIPathPluginsToscaTemporaryArchives,
+		temporaryArchiveParams,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -57,9 +70,18 @@ func (tas *TemporaryArchiveService) CreateTemporaryArchive(
 
 // UploadTemporaryArchive uploads a temporary archive file
 func (tas *TemporaryArchiveService) UploadTemporaryArchive(sourceFilePath string, targetURL string) error {
+	return tas.UploadTemporaryArchiveContext(context.Background(), sourceFilePath, targetURL)
+}
+
+// UploadTemporaryArchiveContext is like UploadTemporaryArchive, but the request is cancelled as soon as ctx is done
+func (tas *TemporaryArchiveService) UploadTemporaryArchiveContext(
+	ctx context.Context,
+	sourceFilePath string,
+	targetURL string,
+) error {
 	log.Debug("UploadTemporaryArchive")
 
-	data, status, err := tas.concertoService.PutFile(sourceFilePath, targetURL)
+	data, status, err := tas.concertoService.PutFileContext(ctx, sourceFilePath, targetURL)
 	if err != nil {
 		return err
 	}
@@ -75,10 +97,25 @@ func (tas *TemporaryArchiveService) UploadTemporaryArchive(sourceFilePath string
 func (tas *TemporaryArchiveService) CreateTemporaryArchiveImport(
 	temporaryArchiveID string,
 	temporaryArchiveImportParams *map[string]interface{},
+) (temporaryArchiveImport *types.TemporaryArchiveImport, err error) {
+	return tas.CreateTemporaryArchiveImportContext(
+		context.Background(),
+		temporaryArchiveID,
+		temporaryArchiveImportParams,
+	)
+}
+
+// CreateTemporaryArchiveImportContext is like CreateTemporaryArchiveImport, but the request is cancelled as soon as ctx
+// is done
+func (tas *TemporaryArchiveService) CreateTemporaryArchiveImportContext(
+	ctx context.Context,
+	temporaryArchiveID string,
+	temporaryArchiveImportParams *map[string]interface{},
 ) (temporaryArchiveImport *types.TemporaryArchiveImport, err error) {
 	log.Debug("CreateTemporaryArchiveImport")
 
-	data, status, err := tas.concertoService.Post(
+	data, status, err := tas.concertoService.PostContext(
+		ctx,
 		fmt.Sprintf(APIPathPluginsToscaTemporaryArchiveImport, temporaryArchiveID),
 		temporaryArchiveImportParams,
 	)
@@ -100,10 +137,20 @@ func (tas *TemporaryArchiveService) CreateTemporaryArchiveImport(
 // GetTemporaryArchiveImport returns a temporary archive import by its ID
 func (tas *TemporaryArchiveService) GetTemporaryArchiveImport(
 	temporaryArchiveImportID string,
+) (temporaryArchiveImport *types.TemporaryArchiveImport, err error) {
+	return tas.GetTemporaryArchiveImportContext(context.Background(), temporaryArchiveImportID)
+}
+
+// GetTemporaryArchiveImportContext is like GetTemporaryArchiveImport, but the request is cancelled as soon as ctx is
+// done
+func (tas *TemporaryArchiveService) GetTemporaryArchiveImportContext(
+	ctx context.Context,
+	temporaryArchiveImportID string,
 ) (temporaryArchiveImport *types.TemporaryArchiveImport, err error) {
 	log.Debug("GetTemporaryArchiveImport")
 
-	data, status, err := tas.concertoService.Get(
+	data, status, err := tas.concertoService.GetContext(
+		ctx,
 		fmt.Sprintf(APIPathPluginsToscaTemporaryArchiveImport, temporaryArchiveImportID),
 	)
 	if err != nil {
@@ -124,10 +171,20 @@ func (tas *TemporaryArchiveService) GetTemporaryArchiveImport(
 // CreateTemporaryArchiveExport creates a temporary archive export
 func (tas *TemporaryArchiveService) CreateTemporaryArchiveExport(
 	temporaryArchiveExportParams *map[string]interface{},
+) (temporaryArchiveExport *types.TemporaryArchiveExport, err error) {
+	return tas.CreateTemporaryArchiveExportContext(context.Background(), temporaryArchiveExportParams)
+}
+
+// CreateTemporaryArchiveExportContext is like CreateTemporaryArchiveExport, but the request is cancelled as soon as ctx
+// is done
+func (tas *TemporaryArchiveService) CreateTemporaryArchiveExportContext(
+	ctx context.Context,
+	temporaryArchiveExportParams *map[string]interface{},
 ) (temporaryArchiveExport *types.TemporaryArchiveExport, err error) {
 	log.Debug("CreateTemporaryArchiveExport")
 
-	data, status, err := tas.concertoService.Post(
+	data, status, err := tas.concertoService.PostContext(
+		ctx,
 		APIPathPluginsToscaTemporaryArchivesExport,
 		temporaryArchiveExportParams,
 	)
@@ -149,10 +206,20 @@ func (tas *TemporaryArchiveService) CreateTemporaryArchiveExport(
 // GetTemporaryArchiveExportTask returns a temporary archive export task by its ID
 func (tas *TemporaryArchiveService) GetTemporaryArchiveExportTask(
 	temporaryArchiveID string,
+) (temporaryArchiveExportTask *types.TemporaryArchiveExportTask, err error) {
+	return tas.GetTemporaryArchiveExportTaskContext(context.Background(), temporaryArchiveID)
+}
+
+// GetTemporaryArchiveExportTaskContext is like GetTemporaryArchiveExportTask, but the request is cancelled as soon as
+// ctx is done
+func (tas *TemporaryArchiveService) GetTemporaryArchiveExportTaskContext(
+	ctx context.Context,
+	temporaryArchiveID string,
 ) (temporaryArchiveExportTask *types.TemporaryArchiveExportTask, err error) {
 	log.Debug("GetTemporaryArchiveExportTask")
 
-	data, status, err := tas.concertoService.Get(
+	data, status, err := tas.concertoService.GetContext(
+		ctx,
 		fmt.Sprintf(APIPathPluginsToscaTemporaryArchiveExport, temporaryArchiveID),
 	)
 	if err != nil {
@@ -174,10 +241,20 @@ func (tas *TemporaryArchiveService) GetTemporaryArchiveExportTask(
 func (tas *TemporaryArchiveService) DownloadTemporaryArchiveExport(
 	url string,
 	filepath string,
+) (realFileName string, status int, err error) {
+	return tas.DownloadTemporaryArchiveExportContext(context.Background(), url, filepath)
+}
+
+// DownloadTemporaryArchiveExportContext is like DownloadTemporaryArchiveExport, but the request is cancelled as soon as
+// ctx is done
+func (tas *TemporaryArchiveService) DownloadTemporaryArchiveExportContext(
+	ctx context.Context,
+	url string,
+	filepath string,
 ) (realFileName string, status int, err error) {
 	log.Debug("DownloadTemporaryArchiveExport")
 
-	realFileName, status, err = tas.concertoService.GetFile(url, filepath, false)
+	realFileName, status, err = tas.concertoService.GetFileContext(ctx, url, filepath, false)
 	if err != nil {
 		return realFileName, status, err
 	}
@@ -3,6 +3,7 @@
 package cloudapplication
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -37,40 +38,82 @@ func NewCloudApplicationDeploymentService(
 // ListDeployments returns the list of cloud application deployments as an array of CloudApplicationDeployment
 func (cads *CloudApplicationDeploymentService) ListDeployments() (
 	deployments []*types.CloudApplicationDeployment, err error,
+) {
+	return cads.ListDeploymentsContext(context.Background())
+}
+
+// ListDeploymentsContext is like ListDeployments, but the request is cancelled as soon as ctx is done
+func (cads *CloudApplicationDeploymentService) ListDeploymentsContext(
+	ctx context.Context,
+) (
+	deployments []*types.CloudApplicationDeployment, err error,
 ) {
 	log.Debug("ListDeployments")
 
-	data, status, err := cads.concertoService.Get(APIPathDeploymentLabels)
+	err = cads.ListDeploymentsPagesContext(ctx, func(page []*types.CloudApplicationDeployment) bool {
+		deployments = append(deployments, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return deployments, nil
+}
 
-	if err = json.Unmarshal(data, &deployments); err != nil {
-		return nil, err
-	}
+// ListDeploymentsPages is like ListDeployments, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (cads *CloudApplicationDeploymentService) ListDeploymentsPages(
+	fn func(deployments []*types.CloudApplicationDeployment) bool,
+) error {
+	return cads.ListDeploymentsPagesContext(context.Background(), fn)
+}
 
-	// Only takes internal labels (with a Namespace defined as cat:deployment)
-	var filteredDeployments []*types.CloudApplicationDeployment
-	for _, dep := range deployments {
-		if dep.Namespace == "cat:deployment" {
-			filteredDeployments = append(filteredDeployments, dep)
+// ListDeploymentsPagesContext is like ListDeploymentsPages, but the requests are cancelled as soon as ctx is done. The
+// page options carried by ctx are applied
+func (cads *CloudApplicationDeploymentService) ListDeploymentsPagesContext(
+	ctx context.Context,
+	fn func(deployments []*types.CloudApplicationDeployment) bool,
+) error {
+	log.Debug("ListDeploymentsPages")
+
+	pager := utils.NewPager(ctx, cads.concertoService, APIPathDeploymentLabels)
+	for pager.Next() {
+		var deployments []*types.CloudApplicationDeployment
+		if err := pager.Decode(&deployments); err != nil {
+			return err
+		}
+
+		// Only takes internal labels (with a Namespace defined as cat:deployment)
+		var filteredDeployments []*types.CloudApplicationDeployment
+		for _, dep := range deployments {
+			if dep.Namespace == "cat:deployment" {
+				filteredDeployments = append(filteredDeployments, dep)
+			}
 		}
-	}
 
-	return filteredDeployments, nil
+		if !fn(filteredDeployments) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetDeployment returns a cloud application deployment by its ID
 func (cads *CloudApplicationDeploymentService) GetDeployment(
 	deploymentID string,
+) (deployment *types.CloudApplicationDeployment, status int, err error) {
+	return cads.GetDeploymentContext(context.Background(), deploymentID)
+}
+
+// GetDeploymentContext is like GetDeployment, but the request is cancelled as soon as ctx is done
+func (cads *CloudApplicationDeploymentService) GetDeploymentContext(
+	ctx context.Context,
+	deploymentID string,
 ) (deployment *types.CloudApplicationDeployment, status int, err error) {
 	log.Debug("GetDeployment")
 
-	data, status, err := cads.concertoService.Get(fmt.Sprintf(APIPathPluginsToscaDeployment, deploymentID))
+	data, status, err := cads.concertoService.GetContext(ctx, fmt.Sprintf(APIPathPluginsToscaDeployment, deploymentID))
 	if err != nil {
 		return nil, status, err
 	}
@@ -89,10 +132,21 @@ func (cads *CloudApplicationDeploymentService) GetDeployment(
 // DeleteDeployment deletes a cloud application deployment by its ID
 func (cads *CloudApplicationDeploymentService) DeleteDeployment(
 	deploymentID string,
+) (deployment *types.CloudApplicationDeployment, err error) {
+	return cads.DeleteDeploymentContext(context.Background(), deploymentID)
+}
+
+// DeleteDeploymentContext is like DeleteDeployment, but the request is cancelled as soon as ctx is done
+func (cads *CloudApplicationDeploymentService) DeleteDeploymentContext(
+	ctx context.Context,
+	deploymentID string,
 ) (deployment *types.CloudApplicationDeployment, err error) {
 	log.Debug("DeleteDeployment")
 
-	data, status, err := cads.concertoService.Delete(fmt.Sprintf(APIPathPluginsToscaDeployment, deploymentID))
+	data, status, err := cads.concertoService.DeleteContext(
+		ctx,
+		fmt.Sprintf(APIPathPluginsToscaDeployment, deploymentID),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -112,10 +166,20 @@ func (cads *CloudApplicationDeploymentService) DeleteDeployment(
 func (cads *CloudApplicationDeploymentService) CreateDeploymentTask(
 	catID string,
 	deploymentParams *map[string]interface{},
+) (deploymentTask *types.CloudApplicationDeploymentTask, err error) {
+	return cads.CreateDeploymentTaskContext(context.Background(), catID, deploymentParams)
+}
+
+// CreateDeploymentTaskContext is like CreateDeploymentTask, but the request is cancelled as soon as ctx is done
+func (cads *CloudApplicationDeploymentService) CreateDeploymentTaskContext(
+	ctx context.Context,
+	catID string,
+	deploymentParams *map[string]interface{},
 ) (deploymentTask *types.CloudApplicationDeploymentTask, err error) {
 	log.Debug("CreateDeploymentTask")
 
-	data, status, err := cads.concertoService.Post(
+	data, status, err := cads.concertoService.PostContext(
+		ctx,
 		fmt.Sprintf(APIPathPluginsToscaCatDeploymentTasks, catID),
 		deploymentParams,
 	)
@@ -138,10 +202,20 @@ func (cads *CloudApplicationDeploymentService) CreateDeploymentTask(
 func (cads *CloudApplicationDeploymentService) GetDeploymentTask(
 	catID string,
 	deploymentTaskID string,
+) (deploymentTask *types.CloudApplicationDeploymentTask, err error) {
+	return cads.GetDeploymentTaskContext(context.Background(), catID, deploymentTaskID)
+}
+
+// GetDeploymentTaskContext is like GetDeploymentTask, but the request is cancelled as soon as ctx is done
+func (cads *CloudApplicationDeploymentService) GetDeploymentTaskContext(
+	ctx context.Context,
+	catID string,
+	deploymentTaskID string,
 ) (deploymentTask *types.CloudApplicationDeploymentTask, err error) {
 	log.Debug("GetDeploymentTask")
 
-	data, status, err := cads.concertoService.Get(
+	data, status, err := cads.concertoService.GetContext(
+		ctx,
 		fmt.Sprintf(APIPathPluginsToscaCatDeploymentTask, catID, deploymentTaskID),
 	)
 	if err != nil {
@@ -3,6 +3,7 @@
 package cloudapplication
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -35,31 +36,70 @@ func NewCloudApplicationTemplateService(
 
 // ListTemplates returns the list of cloud application templates as an array of CloudApplicationTemplate
 func (cats *CloudApplicationTemplateService) ListTemplates() (templates []*types.CloudApplicationTemplate, err error) {
+	return cats.ListTemplatesContext(context.Background())
+}
+
+// ListTemplatesContext is like ListTemplates, but the request is cancelled as soon as ctx is done
+func (cats *CloudApplicationTemplateService) ListTemplatesContext(
+	ctx context.Context,
+) (templates []*types.CloudApplicationTemplate, err error) {
 	log.Debug("ListTemplates")
 
-	data, status, err := cats.concertoService.Get(APIPathPluginsToscaCats)
+	templates = []*types.CloudApplicationTemplate{}
+	err = cats.ListTemplatesPagesContext(ctx, func(page []*types.CloudApplicationTemplate) bool {
+		templates = append(templates, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return templates, nil
+}
 
-	if err = json.Unmarshal(data, &templates); err != nil {
-		return nil, err
-	}
+// ListTemplatesPages is like ListTemplates, but the list is streamed to fn one page at a time, until fn returns false
+func (cats *CloudApplicationTemplateService) ListTemplatesPages(
+	fn func(templates []*types.CloudApplicationTemplate) bool,
+) error {
+	return cats.ListTemplatesPagesContext(context.Background(), fn)
+}
 
-	return templates, nil
+// ListTemplatesPagesContext is like ListTemplatesPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (cats *CloudApplicationTemplateService) ListTemplatesPagesContext(
+	ctx context.Context,
+	fn func(templates []*types.CloudApplicationTemplate) bool,
+) error {
+	log.Debug("ListTemplatesPages")
+
+	pager := utils.NewPager(ctx, cats.concertoService, APIPathPluginsToscaCats)
+	for pager.Next() {
+		var templates []*types.CloudApplicationTemplate
+		if err := pager.Decode(&templates); err != nil {
+			return err
+		}
+		if !fn(templates) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetTemplate returns a cloud application template by its ID
 func (cats *CloudApplicationTemplateService) GetTemplate(
 	templateID string,
+) (template *types.CloudApplicationTemplate, err error) {
+	return cats.GetTemplateContext(context.Background(), templateID)
+}
+
+// GetTemplateContext is like GetTemplate, but the request is cancelled as soon as ctx is done
+func (cats *CloudApplicationTemplateService) GetTemplateContext(
+	ctx context.Context,
+	templateID string,
 ) (template *types.CloudApplicationTemplate, err error) {
 	log.Debug("GetTemplate")
 
-	data, status, err := cats.concertoService.Get(fmt.Sprintf(APIPathPluginsToscaCat, templateID))
+	data, status, err := cats.concertoService.GetContext(ctx, fmt.Sprintf(APIPathPluginsToscaCat, templateID))
 	if err != nil {
 		return nil, err
 	}
@@ -78,10 +118,18 @@ func (cats *CloudApplicationTemplateService) GetTemplate(
 // CreateTemplate creates a cloud application template
 func (cats *CloudApplicationTemplateService) CreateTemplate(
 	catParams *map[string]interface{},
+) (template *types.CloudApplicationTemplate, err error) {
+	return cats.CreateTemplateContext(context.Background(), catParams)
+}
+
+// CreateTemplateContext is like CreateTemplate, but the request is cancelled as soon as ctx is done
+func (cats *CloudApplicationTemplateService) CreateTemplateContext(
+	ctx context.Context,
+	catParams *map[string]interface{},
 ) (template *types.CloudApplicationTemplate, err error) {
 	log.Debug("CreateTemplate")
 
-	data, status, err := cats.concertoService.Post(APIPathPluginsToscaCats, catParams)
+	data, status, err := cats.concertoService.PostContext(ctx, APIPathPluginsToscaCats, catParams)
 	if err != nil {
 		return nil, err
 	}
@@ -99,9 +147,18 @@ func (cats *CloudApplicationTemplateService) CreateTemplate(
 
 // UploadTemplate uploads a cloud application template file
 func (cats *CloudApplicationTemplateService) UploadTemplate(sourceFilePath string, targetURL string) error {
+	return cats.UploadTemplateContext(context.Background(), sourceFilePath, targetURL)
+}
+
+// UploadTemplateContext is like UploadTemplate, but the request is cancelled as soon as ctx is done
+func (cats *CloudApplicationTemplateService) UploadTemplateContext(
+	ctx context.Context,
+	sourceFilePath string,
+	targetURL string,
+) error {
 	log.Debug("UploadTemplate")
 
-	data, status, err := cats.concertoService.PutFile(sourceFilePath, targetURL)
+	data, status, err := cats.concertoService.PutFileContext(ctx, sourceFilePath, targetURL)
 	if err != nil {
 		return err
 	}
@@ -116,11 +173,20 @@ func (cats *CloudApplicationTemplateService) UploadTemplate(sourceFilePath strin
 // ParseMetadataTemplate process cloud application template metadata
 func (cats *CloudApplicationTemplateService) ParseMetadataTemplate(
 	templateID string,
+) (template *types.CloudApplicationTemplate, err error) {
+	return cats.ParseMetadataTemplateContext(context.Background(), templateID)
+}
+
+// ParseMetadataTemplateContext is like ParseMetadataTemplate, but the request is cancelled as soon as ctx is done
+func (cats *CloudApplicationTemplateService) ParseMetadataTemplateContext(
+	ctx context.Context,
+	templateID string,
 ) (template *types.CloudApplicationTemplate, err error) {
 	log.Debug("ParseMetadataTemplate")
 
 	catIn := map[string]interface{}{}
-	data, status, err := cats.concertoService.Put(
+	data, status, err := cats.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathPluginsToscaCatParseMetadata, templateID),
 		&catIn,
 	)
@@ -141,9 +207,14 @@ func (cats *CloudApplicationTemplateService) ParseMetadataTemplate(
 
 // DeleteTemplate deletes a cloud application template by its ID
 func (cats *CloudApplicationTemplateService) DeleteTemplate(templateID string) (err error) {
+	return cats.DeleteTemplateContext(context.Background(), templateID)
+}
+
+// DeleteTemplateContext is like DeleteTemplate, but the request is cancelled as soon as ctx is done
+func (cats *CloudApplicationTemplateService) DeleteTemplateContext(ctx context.Context, templateID string) (err error) {
 	log.Debug("DeleteTemplate")
 
-	data, status, err := cats.concertoService.Delete(fmt.Sprintf(APIPathPluginsToscaCat, templateID))
+	data, status, err := cats.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathPluginsToscaCat, templateID))
 	if err != nil {
 		return err
 	}
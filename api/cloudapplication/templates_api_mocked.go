@@ -31,7 +31,7 @@ func ListTemplatesMocked(
 	assert.Nil(err, "CloudApplicationTemplates test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathPluginsToscaCats).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathPluginsToscaCats, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	cloudApplicationTemplatesOut, err := ds.ListTemplates()
 
 	assert.Nil(err, "Error getting cloud application templates")
@@ -63,7 +63,8 @@ func ListTemplatesFailErrMocked(
 	assert.Nil(err, "CloudApplicationTemplates test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathPluginsToscaCats).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathPluginsToscaCats, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	cloudApplicationTemplatesOut, err := ds.ListTemplates()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -92,7 +93,7 @@ func ListTemplatesFailStatusMocked(
 	assert.Nil(err, "CloudApplicationTemplates test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathPluginsToscaCats).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathPluginsToscaCats, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	cloudApplicationTemplatesOut, err := ds.ListTemplates()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -120,7 +121,7 @@ func ListTemplatesFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathPluginsToscaCats).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathPluginsToscaCats, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	cloudApplicationTemplatesOut, err := ds.ListTemplates()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
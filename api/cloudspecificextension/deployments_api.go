@@ -3,6 +3,7 @@
 package cloudspecificextension
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -37,32 +38,74 @@ func NewCloudSpecificExtensionDeploymentService(
 // CloudSpecificExtensionDeployment
 func (cseds *CloudSpecificExtensionDeploymentService) ListDeployments() (
 	deployments []*types.CloudSpecificExtensionDeployment, err error,
+) {
+	return cseds.ListDeploymentsContext(context.Background())
+}
+
+// ListDeploymentsContext is like ListDeployments, but the request is cancelled as soon as ctx is done
+func (cseds *CloudSpecificExtensionDeploymentService) ListDeploymentsContext(
+	ctx context.Context,
+) (
+	deployments []*types.CloudSpecificExtensionDeployment, err error,
 ) {
 	log.Debug("ListDeployments")
 
-	data, status, err := cseds.concertoService.Get(APIPathCseDeployments)
+	deployments = []*types.CloudSpecificExtensionDeployment{}
+	err = cseds.ListDeploymentsPagesContext(ctx, func(page []*types.CloudSpecificExtensionDeployment) bool {
+		deployments = append(deployments, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return deployments, nil
+}
 
-	if err = json.Unmarshal(data, &deployments); err != nil {
-		return nil, err
-	}
+// ListDeploymentsPages is like ListDeployments, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (cseds *CloudSpecificExtensionDeploymentService) ListDeploymentsPages(
+	fn func(deployments []*types.CloudSpecificExtensionDeployment) bool,
+) error {
+	return cseds.ListDeploymentsPagesContext(context.Background(), fn)
+}
 
-	return deployments, nil
+// ListDeploymentsPagesContext is like ListDeploymentsPages, but the requests are cancelled as soon as ctx is done. The
+// page options carried by ctx are applied
+func (cseds *CloudSpecificExtensionDeploymentService) ListDeploymentsPagesContext(
+	ctx context.Context,
+	fn func(deployments []*types.CloudSpecificExtensionDeployment) bool,
+) error {
+	log.Debug("ListDeploymentsPages")
+
+	pager := utils.NewPager(ctx, cseds.concertoService, APIPathCseDeployments)
+	for pager.Next() {
+		var deployments []*types.CloudSpecificExtensionDeployment
+		if err := pager.Decode(&deployments); err != nil {
+			return err
+		}
+		if !fn(deployments) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetDeployment returns a cloud specific extension deployment by its ID
 func (cseds *CloudSpecificExtensionDeploymentService) GetDeployment(
 	deploymentID string,
+) (deployment *types.CloudSpecificExtensionDeployment, err error) {
+	return cseds.GetDeploymentContext(context.Background(), deploymentID)
+}
+
+// GetDeploymentContext is like GetDeployment, but the request is cancelled as soon as ctx is done
+func (cseds *CloudSpecificExtensionDeploymentService) GetDeploymentContext(
+	ctx context.Context,
+	deploymentID string,
 ) (deployment *types.CloudSpecificExtensionDeployment, err error) {
 	log.Debug("GetDeployment")
 
-	data, status, err := cseds.concertoService.Get(fmt.Sprintf(APIPathCseDeployment, deploymentID))
+	data, status, err := cseds.concertoService.GetContext(ctx, fmt.Sprintf(APIPathCseDeployment, deploymentID))
 	if err != nil {
 		return nil, err
 	}
@@ -82,10 +125,20 @@ func (cseds *CloudSpecificExtensionDeploymentService) GetDeployment(
 func (cseds *CloudSpecificExtensionDeploymentService) CreateDeployment(
 	templateID string,
 	deploymentParams *map[string]interface{},
+) (deployment *types.CloudSpecificExtensionDeployment, err error) {
+	return cseds.CreateDeploymentContext(context.Background(), templateID, deploymentParams)
+}
+
+// CreateDeploymentContext is like CreateDeployment, but the request is cancelled as soon as ctx is done
+func (cseds *CloudSpecificExtensionDeploymentService) CreateDeploymentContext(
+	ctx context.Context,
+	templateID string,
+	deploymentParams *map[string]interface{},
 ) (deployment *types.CloudSpecificExtensionDeployment, err error) {
 	log.Debug("CreateDeployment")
 
-	data, status, err := cseds.concertoService.Post(
+	data, status, err := cseds.concertoService.PostContext(
+		ctx,
 		fmt.Sprintf(APIPathCseTemplateDeployments, templateID),
 		deploymentParams,
 	)
@@ -108,10 +161,23 @@ func (cseds *CloudSpecificExtensionDeploymentService) CreateDeployment(
 func (cseds *CloudSpecificExtensionDeploymentService) UpdateDeployment(
 	deploymentID string,
 	deploymentParams *map[string]interface{},
+) (deployment *types.CloudSpecificExtensionDeployment, err error) {
+	return cseds.UpdateDeploymentContext(context.Background(), deploymentID, deploymentParams)
+}
+
+// UpdateDeploymentContext is like UpdateDeployment, but the request is cancelled as soon as ctx is done
+func (cseds *CloudSpecificExtensionDeploymentService) UpdateDeploymentContext(
+	ctx context.Context,
+	deploymentID string,
+	deploymentParams *map[string]interface{},
 ) (deployment *types.CloudSpecificExtensionDeployment, err error) {
 	log.Debug("UpdateDeployment")
 
-	data, status, err := cseds.concertoService.Put(fmt.Sprintf(APIPathCseDeployment, deploymentID), deploymentParams)
+	data, status, err := cseds.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathCseDeployment, deploymentID),
+		deploymentParams,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -130,10 +196,18 @@ func (cseds *CloudSpecificExtensionDeploymentService) UpdateDeployment(
 // DeleteDeployment deletes a cloud specific extension deployment by its ID
 func (cseds *CloudSpecificExtensionDeploymentService) DeleteDeployment(
 	deploymentID string,
+) (deployment *types.CloudSpecificExtensionDeployment, err error) {
+	return cseds.DeleteDeploymentContext(context.Background(), deploymentID)
+}
+
+// DeleteDeploymentContext is like DeleteDeployment, but the request is cancelled as soon as ctx is done
+func (cseds *CloudSpecificExtensionDeploymentService) DeleteDeploymentContext(
+	ctx context.Context,
+	deploymentID string,
 ) (deployment *types.CloudSpecificExtensionDeployment, err error) {
 	log.Debug("DeleteDeployment")
 
-	data, status, err := cseds.concertoService.Delete(fmt.Sprintf(APIPathCseDeployment, deploymentID))
+	data, status, err := cseds.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathCseDeployment, deploymentID))
 	if err != nil {
 		return nil, err
 	}
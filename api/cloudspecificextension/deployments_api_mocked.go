@@ -31,7 +31,7 @@ func ListDeploymentsMocked(
 	assert.Nil(err, "CloudSpecificExtensionDeployments test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCseDeployments).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathCseDeployments, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	cloudSpecificExtensionDeploymentsOut, err := ds.ListDeployments()
 
 	assert.Nil(err, "Error getting cloud specific extension deployments")
@@ -63,7 +63,8 @@ func ListDeploymentsFailErrMocked(
 	assert.Nil(err, "CloudSpecificExtensionDeployments test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCseDeployments).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathCseDeployments, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	cloudSpecificExtensionDeploymentsOut, err := ds.ListDeployments()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -92,7 +93,7 @@ func ListDeploymentsFailStatusMocked(
 	assert.Nil(err, "CloudSpecificExtensionDeployments test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCseDeployments).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathCseDeployments, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	cloudSpecificExtensionDeploymentsOut, err := ds.ListDeployments()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -120,7 +121,7 @@ func ListDeploymentsFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathCseDeployments).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathCseDeployments, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	cloudSpecificExtensionDeploymentsOut, err := ds.ListDeployments()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
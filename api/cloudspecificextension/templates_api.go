@@ -3,6 +3,7 @@
 package cloudspecificextension
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -35,32 +36,73 @@ func NewCloudSpecificExtensionTemplateService(
 // ListTemplates returns the list of cloud specific extension templates as an array of CloudSpecificExtensionTemplate
 func (csets *CloudSpecificExtensionTemplateService) ListTemplates() (
 	templates []*types.CloudSpecificExtensionTemplate, err error,
+) {
+	return csets.ListTemplatesContext(context.Background())
+}
+
+// ListTemplatesContext is like ListTemplates, but the request is cancelled as soon as ctx is done
+func (csets *CloudSpecificExtensionTemplateService) ListTemplatesContext(
+	ctx context.Context,
+) (
+	templates []*types.CloudSpecificExtensionTemplate, err error,
 ) {
 	log.Debug("ListTemplates")
 
-	data, status, err := csets.concertoService.Get(APIPathCseTemplates)
+	templates = []*types.CloudSpecificExtensionTemplate{}
+	err = csets.ListTemplatesPagesContext(ctx, func(page []*types.CloudSpecificExtensionTemplate) bool {
+		templates = append(templates, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return templates, nil
+}
 
-	if err = json.Unmarshal(data, &templates); err != nil {
-		return nil, err
-	}
+// ListTemplatesPages is like ListTemplates, but the list is streamed to fn one page at a time, until fn returns false
+func (csets *CloudSpecificExtensionTemplateService) ListTemplatesPages(
+	fn func(templates []*types.CloudSpecificExtensionTemplate) bool,
+) error {
+	return csets.ListTemplatesPagesContext(context.Background(), fn)
+}
 
-	return templates, nil
+// ListTemplatesPagesContext is like ListTemplatesPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (csets *CloudSpecificExtensionTemplateService) ListTemplatesPagesContext(
+	ctx context.Context,
+	fn func(templates []*types.CloudSpecificExtensionTemplate) bool,
+) error {
+	log.Debug("ListTemplatesPages")
+
+	pager := utils.NewPager(ctx, csets.concertoService, APIPathCseTemplates)
+	for pager.Next() {
+		var templates []*types.CloudSpecificExtensionTemplate
+		if err := pager.Decode(&templates); err != nil {
+			return err
+		}
+		if !fn(templates) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetTemplate returns a cloud specific extension template by its ID
 func (csets *CloudSpecificExtensionTemplateService) GetTemplate(
 	templateID string,
+) (template *types.CloudSpecificExtensionTemplate, err error) {
+	return csets.GetTemplateContext(context.Background(), templateID)
+}
+
+// GetTemplateContext is like GetTemplate, but the request is cancelled as soon as ctx is done
+func (csets *CloudSpecificExtensionTemplateService) GetTemplateContext(
+	ctx context.Context,
+	templateID string,
 ) (template *types.CloudSpecificExtensionTemplate, err error) {
 	log.Debug("GetTemplate")
 
-	data, status, err := csets.concertoService.Get(fmt.Sprintf(APIPathCseTemplate, templateID))
+	data, status, err := csets.concertoService.GetContext(ctx, fmt.Sprintf(APIPathCseTemplate, templateID))
 	if err != nil {
 		return nil, err
 	}
@@ -79,10 +121,18 @@ func (csets *CloudSpecificExtensionTemplateService) GetTemplate(
 // CreateTemplate creates a cloud specific extension template
 func (csets *CloudSpecificExtensionTemplateService) CreateTemplate(
 	templateParams *map[string]interface{},
+) (template *types.CloudSpecificExtensionTemplate, err error) {
+	return csets.CreateTemplateContext(context.Background(), templateParams)
+}
+
+// CreateTemplateContext is like CreateTemplate, but the request is cancelled as soon as ctx is done
+func (csets *CloudSpecificExtensionTemplateService) CreateTemplateContext(
+	ctx context.Context,
+	templateParams *map[string]interface{},
 ) (template *types.CloudSpecificExtensionTemplate, err error) {
 	log.Debug("CreateTemplate")
 
-	data, status, err := csets.concertoService.Post(APIPathCseTemplates, templateParams)
+	data, status, err := csets.concertoService.PostContext(ctx, APIPathCseTemplates, templateParams)
 	if err != nil {
 		return nil, err
 	}
@@ -102,10 +152,23 @@ func (csets *CloudSpecificExtensionTemplateService) CreateTemplate(
 func (csets *CloudSpecificExtensionTemplateService) UpdateTemplate(
 	templateID string,
 	templateParams *map[string]interface{},
+) (template *types.CloudSpecificExtensionTemplate, err error) {
+	return csets.UpdateTemplateContext(context.Background(), templateID, templateParams)
+}
+
+// UpdateTemplateContext is like UpdateTemplate, but the request is cancelled as soon as ctx is done
+func (csets *CloudSpecificExtensionTemplateService) UpdateTemplateContext(
+	ctx context.Context,
+	templateID string,
+	templateParams *map[string]interface{},
 ) (template *types.CloudSpecificExtensionTemplate, err error) {
 	log.Debug("UpdateTemplate")
 
-	data, status, err := csets.concertoService.Put(fmt.Sprintf(APIPathCseTemplate, templateID), templateParams)
+	data, status, err := csets.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathCseTemplate, templateID),
+		templateParams,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -125,30 +188,73 @@ func (csets *CloudSpecificExtensionTemplateService) UpdateTemplate(
 // CloudSpecificExtensionDeployment
 func (csets *CloudSpecificExtensionTemplateService) ListDeployments(
 	templateID string,
+) (deployments []*types.CloudSpecificExtensionDeployment, err error) {
+	return csets.ListDeploymentsContext(context.Background(), templateID)
+}
+
+// ListDeploymentsContext is like ListDeployments, but the request is cancelled as soon as ctx is done
+func (csets *CloudSpecificExtensionTemplateService) ListDeploymentsContext(
+	ctx context.Context,
+	templateID string,
 ) (deployments []*types.CloudSpecificExtensionDeployment, err error) {
 	log.Debug("ListDeployments")
 
-	data, status, err := csets.concertoService.Get(fmt.Sprintf(APIPathCseTemplateDeployments, templateID))
+	deployments = []*types.CloudSpecificExtensionDeployment{}
+	err = csets.ListDeploymentsPagesContext(ctx, templateID, func(page []*types.CloudSpecificExtensionDeployment) bool {
+		deployments = append(deployments, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return deployments, nil
+}
 
-	if err = json.Unmarshal(data, &deployments); err != nil {
-		return nil, err
-	}
+// ListDeploymentsPages is like ListDeployments, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (csets *CloudSpecificExtensionTemplateService) ListDeploymentsPages(
+	templateID string,
+	fn func(deployments []*types.CloudSpecificExtensionDeployment) bool,
+) error {
+	return csets.ListDeploymentsPagesContext(context.Background(), templateID, fn)
+}
 
-	return deployments, nil
+// ListDeploymentsPagesContext is like ListDeploymentsPages, but the requests are cancelled as soon as ctx is done. The
+// page options carried by ctx are applied
+func (csets *CloudSpecificExtensionTemplateService) ListDeploymentsPagesContext(
+	ctx context.Context,
+	templateID string,
+	fn func(deployments []*types.CloudSpecificExtensionDeployment) bool,
+) error {
+	log.Debug("ListDeploymentsPages")
+
+	pager := utils.NewPager(ctx, csets.concertoService, fmt.Sprintf(APIPathCseTemplateDeployments, templateID))
+	for pager.Next() {
+		var deployments []*types.CloudSpecificExtensionDeployment
+		if err := pager.Decode(&deployments); err != nil {
+			return err
+		}
+		if !fn(deployments) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // DeleteTemplate deletes a cloud specific extension template by its ID
 func (csets *CloudSpecificExtensionTemplateService) DeleteTemplate(templateID string) (err error) {
+	return csets.DeleteTemplateContext(context.Background(), templateID)
+}
+
+// DeleteTemplateContext is like DeleteTemplate, but the request is cancelled as soon as ctx is done
+func (csets *CloudSpecificExtensionTemplateService) DeleteTemplateContext(
+	ctx context.Context,
+	templateID string,
+) (err error) {
 	log.Debug("DeleteTemplate")
 
-	data, status, err := csets.concertoService.Delete(fmt.Sprintf(APIPathCseTemplate, templateID))
+	data, status, err := csets.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathCseTemplate, templateID))
 	if err != nil {
 		return err
 	}
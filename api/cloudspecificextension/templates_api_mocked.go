@@ -31,7 +31,7 @@ func ListTemplatesMocked(
 	assert.Nil(err, "CloudSpecificExtensionTemplates test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCseTemplates).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathCseTemplates, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	cloudSpecificExtensionTemplatesOut, err := ds.ListTemplates()
 
 	assert.Nil(err, "Error getting cloud specific extension templates")
@@ -63,7 +63,8 @@ func ListTemplatesFailErrMocked(
 	assert.Nil(err, "CloudSpecificExtensionTemplates test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCseTemplates).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathCseTemplates, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	cloudSpecificExtensionTemplatesOut, err := ds.ListTemplates()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -92,7 +93,7 @@ func ListTemplatesFailStatusMocked(
 	assert.Nil(err, "CloudSpecificExtensionTemplates test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathCseTemplates).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathCseTemplates, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	cloudSpecificExtensionTemplatesOut, err := ds.ListTemplates()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -120,7 +121,7 @@ func ListTemplatesFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathCseTemplates).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathCseTemplates, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	cloudSpecificExtensionTemplatesOut, err := ds.ListTemplates()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -538,7 +539,8 @@ func ListTemplateDeploymentsMocked(
 	assert.Nil(err, "CloudSpecificExtensionDeployments test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCseTemplateDeployments, cloudAccountID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCseTemplateDeployments, cloudAccountID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	cloudSpecificExtensionDeploymentsOut, err := ds.ListDeployments(cloudAccountID)
 
 	assert.Nil(err, "Error getting cloud specific extension deployments")
@@ -571,7 +573,7 @@ func ListTemplateDeploymentsFailErrMocked(
 	assert.Nil(err, "CloudSpecificExtensionDeployments test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCseTemplateDeployments, cloudAccountID)).
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCseTemplateDeployments, cloudAccountID), 1, utils.DefaultPageSize)).
 		Return(dIn, 200, fmt.Errorf("mocked error"))
 	cloudSpecificExtensionDeploymentsOut, err := ds.ListDeployments(cloudAccountID)
 
@@ -602,7 +604,8 @@ func ListTemplateDeploymentsFailStatusMocked(
 	assert.Nil(err, "CloudSpecificExtensionDeployments test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCseTemplateDeployments, cloudAccountID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCseTemplateDeployments, cloudAccountID), 1, utils.DefaultPageSize)).
+		Return(dIn, 499, nil)
 	cloudSpecificExtensionDeploymentsOut, err := ds.ListDeployments(cloudAccountID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -631,7 +634,8 @@ func ListTemplateDeploymentsFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCseTemplateDeployments, cloudAccountID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathCseTemplateDeployments, cloudAccountID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	cloudSpecificExtensionDeploymentsOut, err := ds.ListDeployments(cloudAccountID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
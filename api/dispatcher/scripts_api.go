@@ -3,6 +3,7 @@
 package dispatcher
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -34,10 +35,22 @@ func NewDispatcherService(concertoService utils.ConcertoService) (*DispatcherSer
 // GetDispatcherScriptCharacterizationsByType returns script characterizations list for a given phase
 func (ds *DispatcherService) GetDispatcherScriptCharacterizationsByType(
 	phase string,
+) (scriptCharacterizations []*types.ScriptCharacterization, err error) {
+	return ds.GetDispatcherScriptCharacterizationsByTypeContext(context.Background(), phase)
+}
+
+// GetDispatcherScriptCharacterizationsByTypeContext is like GetDispatcherScriptCharacterizationsByType, but the request
+// is cancelled as soon as ctx is done
+func (ds *DispatcherService) GetDispatcherScriptCharacterizationsByTypeContext(
+	ctx context.Context,
+	phase string,
 ) (scriptCharacterizations []*types.ScriptCharacterization, err error) {
 	log.Debug("GetDispatcherScriptCharacterizationsByType")
 
-	data, status, err := ds.concertoService.Get(fmt.Sprintf(APIPathBlueprintScriptCharacterizationsByType, phase))
+	data, status, err := ds.concertoService.GetContext(
+		ctx,
+		fmt.Sprintf(APIPathBlueprintScriptCharacterizationsByType, phase),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -56,10 +69,20 @@ func (ds *DispatcherService) GetDispatcherScriptCharacterizationsByType(
 // GetDispatcherScriptCharacterizationByUUID returns script characterizations list for a given UUID
 func (ds *DispatcherService) GetDispatcherScriptCharacterizationByUUID(
 	scriptCharacterizationUUID string,
+) (*types.ScriptCharacterization, error) {
+	return ds.GetDispatcherScriptCharacterizationByUUIDContext(context.Background(), scriptCharacterizationUUID)
+}
+
+// GetDispatcherScriptCharacterizationByUUIDContext is like GetDispatcherScriptCharacterizationByUUID, but the request
+// is cancelled as soon as ctx is done
+func (ds *DispatcherService) GetDispatcherScriptCharacterizationByUUIDContext(
+	ctx context.Context,
+	scriptCharacterizationUUID string,
 ) (*types.ScriptCharacterization, error) {
 	log.Debug("GetDispatcherScriptCharacterizationByUUID")
 
-	data, status, err := ds.concertoService.Get(
+	data, status, err := ds.concertoService.GetContext(
+		ctx,
 		fmt.Sprintf(APIPathBlueprintScriptCharacterization, scriptCharacterizationUUID),
 	)
 	if err != nil {
@@ -80,10 +103,22 @@ func (ds *DispatcherService) GetDispatcherScriptCharacterizationByUUID(
 // ReportScriptConclusions reports a result
 func (ds *DispatcherService) ReportScriptConclusions(
 	scriptConclusions *map[string]interface{},
+) (command *types.ScriptConclusion, status int, err error) {
+	return ds.ReportScriptConclusionsContext(context.Background(), scriptConclusions)
+}
+
+// ReportScriptConclusionsContext is like ReportScriptConclusions, but the request is cancelled as soon as ctx is done
+func (ds *DispatcherService) ReportScriptConclusionsContext(
+	ctx context.Context,
+	scriptConclusions *map[string]interface{},
 ) (command *types.ScriptConclusion, status int, err error) {
 	log.Debug("ReportScriptConclusions")
 
-	data, status, err := ds.concertoService.Post(APIPathBlueprintScriptConclusions, scriptConclusions)
+	data, status, err := ds.concertoService.PostIdempotentContext(
+		ctx,
+		APIPathBlueprintScriptConclusions,
+		scriptConclusions,
+	)
 	if err != nil {
 		return nil, status, err
 	}
@@ -103,10 +138,19 @@ func (ds *DispatcherService) ReportScriptConclusions(
 func (ds *DispatcherService) DownloadAttachment(
 	url string,
 	filePath string,
+) (realFileName string, status int, err error) {
+	return ds.DownloadAttachmentContext(context.Background(), url, filePath)
+}
+
+// DownloadAttachmentContext is like DownloadAttachment, but the request is cancelled as soon as ctx is done
+func (ds *DispatcherService) DownloadAttachmentContext(
+	ctx context.Context,
+	url string,
+	filePath string,
 ) (realFileName string, status int, err error) {
 	log.Debug("DownloadAttachment")
 
-	realFileName, status, err = ds.concertoService.GetFile(url, filePath, true)
+	realFileName, status, err = ds.concertoService.GetFileContext(ctx, url, filePath, true)
 	if err != nil {
 		return realFileName, status, err
 	}
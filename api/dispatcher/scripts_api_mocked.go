@@ -271,7 +271,7 @@ func ReportScriptConclusionsMocked(t *testing.T, scIn *types.ScriptConclusion) *
 	assert.Nil(err, "Dispatcher test data corrupted")
 
 	// call service
-	cs.On("Post", APIPathBlueprintScriptConclusions, mapIn).Return(dOut, 200, nil)
+	cs.On("PostIdempotent", APIPathBlueprintScriptConclusions, mapIn).Return(dOut, 200, nil)
 	scOut, _, err := ds.ReportScriptConclusions(mapIn)
 	assert.Nil(err, "Error processing dispatcher")
 	assert.Equal(scIn, scOut, "ReportScriptConclusions returned different dispatcher")
@@ -299,7 +299,7 @@ func ReportScriptConclusionsFailErrMocked(t *testing.T, cbIn *types.ScriptConclu
 	assert.Nil(err, "Dispatcher test data corrupted")
 
 	// call service
-	cs.On("Post", APIPathBlueprintScriptConclusions, mapIn).Return(dOut, 200, fmt.Errorf("mocked error"))
+	cs.On("PostIdempotent", APIPathBlueprintScriptConclusions, mapIn).Return(dOut, 200, fmt.Errorf("mocked error"))
 	scOut, _, err := ds.ReportScriptConclusions(mapIn)
 	assert.NotNil(err, "We are expecting an error")
 	assert.Nil(scOut, "Expecting nil output")
@@ -328,7 +328,7 @@ func ReportScriptConclusionsFailStatusMocked(t *testing.T, cbIn *types.ScriptCon
 	assert.Nil(err, "Dispatcher test data corrupted")
 
 	// call service
-	cs.On("Post", APIPathBlueprintScriptConclusions, mapIn).Return(dOut, 499, nil)
+	cs.On("PostIdempotent", APIPathBlueprintScriptConclusions, mapIn).Return(dOut, 499, nil)
 	scOut, _, err := ds.ReportScriptConclusions(mapIn)
 	assert.NotNil(err, "We are expecting an status code error")
 	assert.Nil(scOut, "Expecting nil output")
@@ -356,7 +356,7 @@ func ReportScriptConclusionsFailJSONMocked(t *testing.T, cbIn *types.ScriptConcl
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Post", APIPathBlueprintScriptConclusions, mapIn).Return(dIn, 201, nil)
+	cs.On("PostIdempotent", APIPathBlueprintScriptConclusions, mapIn).Return(dIn, 201, nil)
 	scOut, _, err := ds.ReportScriptConclusions(mapIn)
 	assert.NotNil(err, "We are expecting a marshalling error")
 	assert.Nil(scOut, "Expecting nil output")
@@ -3,6 +3,7 @@
 package firewall
 
 import (
+	"context"
 	"crypto/md5"
 	"encoding/json"
 	"fmt"
@@ -33,9 +34,14 @@ func NewFirewallService(concertoService utils.ConcertoService) (*FirewallService
 
 // GetPolicy returns firewall policy
 func (fs *FirewallService) GetPolicy() (policy *types.Policy, err error) {
+	return fs.GetPolicyContext(context.Background())
+}
+
+// GetPolicyContext is like GetPolicy, but the request is cancelled as soon as ctx is done
+func (fs *FirewallService) GetPolicyContext(ctx context.Context) (policy *types.Policy, err error) {
 	log.Debug("GetPolicy")
 
-	data, status, err := fs.concertoService.Get(APIPathCloudFirewallProfile)
+	data, status, err := fs.concertoService.GetContext(ctx, APIPathCloudFirewallProfile)
 	if err != nil {
 		return nil, err
 	}
@@ -54,9 +60,17 @@ func (fs *FirewallService) GetPolicy() (policy *types.Policy, err error) {
 
 // AddPolicyRule adds a new firewall policy rule
 func (fs *FirewallService) AddPolicyRule(ruleParams *map[string]interface{}) (policyRule *types.PolicyRule, err error) {
+	return fs.AddPolicyRuleContext(context.Background(), ruleParams)
+}
+
+// AddPolicyRuleContext is like AddPolicyRule, but the request is cancelled as soon as ctx is done
+func (fs *FirewallService) AddPolicyRuleContext(
+	ctx context.Context,
+	ruleParams *map[string]interface{},
+) (policyRule *types.PolicyRule, err error) {
 	log.Debug("AddPolicyRule")
 
-	data, status, err := fs.concertoService.Post(APIPathCloudFirewallProfileRules, ruleParams)
+	data, status, err := fs.concertoService.PostContext(ctx, APIPathCloudFirewallProfileRules, ruleParams)
 	if err != nil {
 		return nil, err
 	}
@@ -73,9 +87,17 @@ func (fs *FirewallService) AddPolicyRule(ruleParams *map[string]interface{}) (po
 
 // UpdatePolicy update firewall policy
 func (fs *FirewallService) UpdatePolicy(policyParams *map[string]interface{}) (policy *types.Policy, err error) {
+	return fs.UpdatePolicyContext(context.Background(), policyParams)
+}
+
+// UpdatePolicyContext is like UpdatePolicy, but the request is cancelled as soon as ctx is done
+func (fs *FirewallService) UpdatePolicyContext(
+	ctx context.Context,
+	policyParams *map[string]interface{},
+) (policy *types.Policy, err error) {
 	log.Debug("UpdatePolicy")
 
-	data, status, err := fs.concertoService.Put(APIPathCloudFirewallProfile, policyParams)
+	data, status, err := fs.concertoService.PutContext(ctx, APIPathCloudFirewallProfile, policyParams)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@
 package kubernetes
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -35,29 +36,58 @@ func NewClusterService(concertoService utils.ConcertoService) (*ClusterService,
 
 // ListClusters returns the list of clusters as an array of cluster
 func (cs *ClusterService) ListClusters() (clusters []*types.Cluster, err error) {
+	return cs.ListClustersContext(context.Background())
+}
+
+// ListClustersContext is like ListClusters, but the request is cancelled as soon as ctx is done
+func (cs *ClusterService) ListClustersContext(ctx context.Context) (clusters []*types.Cluster, err error) {
 	log.Debug("ListClusters")
 
-	data, status, err := cs.concertoService.Get(APIPathKubernetesClusters)
+	clusters = []*types.Cluster{}
+	err = cs.ListClustersPagesContext(ctx, func(page []*types.Cluster) bool {
+		clusters = append(clusters, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return clusters, nil
+}
 
-	if err = json.Unmarshal(data, &clusters); err != nil {
-		return nil, err
-	}
+// ListClustersPages is like ListClusters, but the list is streamed to fn one page at a time, until fn returns false
+func (cs *ClusterService) ListClustersPages(fn func(clusters []*types.Cluster) bool) error {
+	return cs.ListClustersPagesContext(context.Background(), fn)
+}
 
-	return clusters, nil
+// ListClustersPagesContext is like ListClustersPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (cs *ClusterService) ListClustersPagesContext(ctx context.Context, fn func(clusters []*types.Cluster) bool) error {
+	log.Debug("ListClustersPages")
+
+	pager := utils.NewPager(ctx, cs.concertoService, APIPathKubernetesClusters)
+	for pager.Next() {
+		var clusters []*types.Cluster
+		if err := pager.Decode(&clusters); err != nil {
+			return err
+		}
+		if !fn(clusters) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetCluster returns a cluster by its ID
 func (cs *ClusterService) GetCluster(clusterID string) (cluster *types.Cluster, err error) {
+	return cs.GetClusterContext(context.Background(), clusterID)
+}
+
+// GetClusterContext is like GetCluster, but the request is cancelled as soon as ctx is done
+func (cs *ClusterService) GetClusterContext(ctx context.Context, clusterID string) (cluster *types.Cluster, err error) {
 	log.Debug("GetCluster")
 
-	data, status, err := cs.concertoService.Get(fmt.Sprintf(APIPathKubernetesCluster, clusterID))
+	data, status, err := cs.concertoService.GetContext(ctx, fmt.Sprintf(APIPathKubernetesCluster, clusterID))
 	if err != nil {
 		return nil, err
 	}
@@ -75,9 +105,17 @@ func (cs *ClusterService) GetCluster(clusterID string) (cluster *types.Cluster,
 
 // CreateCluster creates a cluster
 func (cs *ClusterService) CreateCluster(clusterParams *map[string]interface{}) (cluster *types.Cluster, err error) {
+	return cs.CreateClusterContext(context.Background(), clusterParams)
+}
+
+// CreateClusterContext is like CreateCluster, but the request is cancelled as soon as ctx is done
+func (cs *ClusterService) CreateClusterContext(
+	ctx context.Context,
+	clusterParams *map[string]interface{},
+) (cluster *types.Cluster, err error) {
 	log.Debug("CreateCluster")
 
-	data, status, err := cs.concertoService.Post(APIPathKubernetesClusters, clusterParams)
+	data, status, err := cs.concertoService.PostContext(ctx, APIPathKubernetesClusters, clusterParams)
 	if err != nil {
 		return nil, err
 	}
@@ -96,10 +134,23 @@ func (cs *ClusterService) CreateCluster(clusterParams *map[string]interface{}) (
 // UpdateCluster updates a cluster by its ID
 func (cs *ClusterService) UpdateCluster(
 	clusterID string, clusterParams *map[string]interface{},
+) (cluster *types.Cluster, err error) {
+	return cs.UpdateClusterContext(context.Background(), clusterID, clusterParams)
+}
+
+// UpdateClusterContext is like UpdateCluster, but the request is cancelled as soon as ctx is done
+func (cs *ClusterService) UpdateClusterContext(
+	ctx context.Context,
+	clusterID string,
+	clusterParams *map[string]interface{},
 ) (cluster *types.Cluster, err error) {
 	log.Debug("UpdateCluster")
 
-	data, status, err := cs.concertoService.Put(fmt.Sprintf(APIPathKubernetesCluster, clusterID), clusterParams)
+	data, status, err := cs.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathKubernetesCluster, clusterID),
+		clusterParams,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -117,9 +168,17 @@ func (cs *ClusterService) UpdateCluster(
 
 // DeleteCluster deletes a cluster by its ID
 func (cs *ClusterService) DeleteCluster(clusterID string) (cluster *types.Cluster, err error) {
+	return cs.DeleteClusterContext(context.Background(), clusterID)
+}
+
+// DeleteClusterContext is like DeleteCluster, but the request is cancelled as soon as ctx is done
+func (cs *ClusterService) DeleteClusterContext(
+	ctx context.Context,
+	clusterID string,
+) (cluster *types.Cluster, err error) {
 	log.Debug("DeleteCluster")
 
-	data, status, err := cs.concertoService.Delete(fmt.Sprintf(APIPathKubernetesCluster, clusterID))
+	data, status, err := cs.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathKubernetesCluster, clusterID))
 	if err != nil {
 		return nil, err
 	}
@@ -138,10 +197,25 @@ func (cs *ClusterService) DeleteCluster(clusterID string) (cluster *types.Cluste
 // RetryCluster retries a cluster by its ID
 func (cs *ClusterService) RetryCluster(clusterID string, clusterParams *map[string]interface{}) (
 	cluster *types.Cluster, err error,
+) {
+	return cs.RetryClusterContext(context.Background(), clusterID, clusterParams)
+}
+
+// RetryClusterContext is like RetryCluster, but the request is cancelled as soon as ctx is done
+func (cs *ClusterService) RetryClusterContext(
+	ctx context.Context,
+	clusterID string,
+	clusterParams *map[string]interface{},
+) (
+	cluster *types.Cluster, err error,
 ) {
 	log.Debug("RetryCluster")
 
-	data, status, err := cs.concertoService.Put(fmt.Sprintf(APIPathKubernetesClusterRetry, clusterID), clusterParams)
+	data, status, err := cs.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathKubernetesClusterRetry, clusterID),
+		clusterParams,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -159,9 +233,14 @@ func (cs *ClusterService) RetryCluster(clusterID string, clusterParams *map[stri
 
 // DiscardCluster discards a cluster by its ID
 func (cs *ClusterService) DiscardCluster(clusterID string) (err error) {
+	return cs.DiscardClusterContext(context.Background(), clusterID)
+}
+
+// DiscardClusterContext is like DiscardCluster, but the request is cancelled as soon as ctx is done
+func (cs *ClusterService) DiscardClusterContext(ctx context.Context, clusterID string) (err error) {
 	log.Debug("DiscardCluster")
 
-	data, status, err := cs.concertoService.Delete(fmt.Sprintf(APIPathKubernetesClusterDiscard, clusterID))
+	data, status, err := cs.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathKubernetesClusterDiscard, clusterID))
 	if err != nil {
 		return err
 	}
@@ -175,9 +254,17 @@ func (cs *ClusterService) DiscardCluster(clusterID string) (err error) {
 
 // GetClusterPlan returns a cluster plan by its ID
 func (cs *ClusterService) GetClusterPlan(clusterPlanID string) (clusterPlan *types.ClusterPlan, err error) {
+	return cs.GetClusterPlanContext(context.Background(), clusterPlanID)
+}
+
+// GetClusterPlanContext is like GetClusterPlan, but the request is cancelled as soon as ctx is done
+func (cs *ClusterService) GetClusterPlanContext(
+	ctx context.Context,
+	clusterPlanID string,
+) (clusterPlan *types.ClusterPlan, err error) {
 	log.Debug("GetClusterPlan")
 
-	data, status, err := cs.concertoService.Get(fmt.Sprintf(APIPathKubernetesClusterPlan, clusterPlanID))
+	data, status, err := cs.concertoService.GetContext(ctx, fmt.Sprintf(APIPathKubernetesClusterPlan, clusterPlanID))
 	if err != nil {
 		return nil, err
 	}
@@ -28,7 +28,7 @@ func ListClustersMocked(t *testing.T, clustersIn []*types.Cluster) []*types.Clus
 	assert.Nil(err, "Clusters test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathKubernetesClusters).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathKubernetesClusters, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	clustersOut, err := ds.ListClusters()
 
 	assert.Nil(err, "Error getting clusters")
@@ -53,7 +53,8 @@ func ListClustersFailErrMocked(t *testing.T, clustersIn []*types.Cluster) []*typ
 	assert.Nil(err, "Clusters test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathKubernetesClusters).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathKubernetesClusters, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	clustersOut, err := ds.ListClusters()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -79,7 +80,7 @@ func ListClustersFailStatusMocked(t *testing.T, clustersIn []*types.Cluster) []*
 	assert.Nil(err, "Clusters test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathKubernetesClusters).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathKubernetesClusters, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	clustersOut, err := ds.ListClusters()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -104,7 +105,7 @@ func ListClustersFailJSONMocked(t *testing.T, clustersIn []*types.Cluster) []*ty
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathKubernetesClusters).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathKubernetesClusters, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	clustersOut, err := ds.ListClusters()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
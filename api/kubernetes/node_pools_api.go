@@ -3,6 +3,7 @@
 package kubernetes
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -34,30 +35,68 @@ func NewNodePoolService(concertoService utils.ConcertoService) (*NodePoolService
 
 // ListNodePools returns the list of node pools as an array of node pool for a given cluster ID
 func (nps *NodePoolService) ListNodePools(clusterID string) (nodePools []*types.NodePool, err error) {
-	log.Debug("ListNodePools")
+	return nps.ListNodePoolsContext(context.Background(), clusterID)
+}
 
-	data, status, err := nps.concertoService.Get(fmt.Sprintf(APIPathKubernetesClusterNodePools, clusterID))
+// ListNodePoolsContext is like ListNodePools, but the request is cancelled as soon as ctx is done
+func (nps *NodePoolService) ListNodePoolsContext(
+	ctx context.Context,
+	clusterID string,
+) (nodePools []*types.NodePool, err error) {
+	log.Debug("ListNodePools")
 
+	nodePools = []*types.NodePool{}
+	err = nps.ListNodePoolsPagesContext(ctx, clusterID, func(page []*types.NodePool) bool {
+		nodePools = append(nodePools, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return nodePools, nil
+}
 
-	if err = json.Unmarshal(data, &nodePools); err != nil {
-		return nil, err
-	}
+// ListNodePoolsPages is like ListNodePools, but the list is streamed to fn one page at a time, until fn returns false
+func (nps *NodePoolService) ListNodePoolsPages(clusterID string, fn func(nodePools []*types.NodePool) bool) error {
+	return nps.ListNodePoolsPagesContext(context.Background(), clusterID, fn)
+}
 
-	return nodePools, nil
+// ListNodePoolsPagesContext is like ListNodePoolsPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (nps *NodePoolService) ListNodePoolsPagesContext(
+	ctx context.Context,
+	clusterID string,
+	fn func(nodePools []*types.NodePool) bool,
+) error {
+	log.Debug("ListNodePoolsPages")
+
+	pager := utils.NewPager(ctx, nps.concertoService, fmt.Sprintf(APIPathKubernetesClusterNodePools, clusterID))
+	for pager.Next() {
+		var nodePools []*types.NodePool
+		if err := pager.Decode(&nodePools); err != nil {
+			return err
+		}
+		if !fn(nodePools) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetNodePool returns a node pool by its ID
 func (nps *NodePoolService) GetNodePool(nodePoolID string) (nodePool *types.NodePool, err error) {
+	return nps.GetNodePoolContext(context.Background(), nodePoolID)
+}
+
+// GetNodePoolContext is like GetNodePool, but the request is cancelled as soon as ctx is done
+func (nps *NodePoolService) GetNodePoolContext(
+	ctx context.Context,
+	nodePoolID string,
+) (nodePool *types.NodePool, err error) {
 	log.Debug("GetNodePool")
 
-	data, status, err := nps.concertoService.Get(fmt.Sprintf(APIPathKubernetesNodePool, nodePoolID))
+	data, status, err := nps.concertoService.GetContext(ctx, fmt.Sprintf(APIPathKubernetesNodePool, nodePoolID))
 	if err != nil {
 		return nil, err
 	}
@@ -76,10 +115,20 @@ func (nps *NodePoolService) GetNodePool(nodePoolID string) (nodePool *types.Node
 // CreateNodePool creates a node pool
 func (nps *NodePoolService) CreateNodePool(
 	clusterID string, nodePoolParams *map[string]interface{},
+) (nodePool *types.NodePool, err error) {
+	return nps.CreateNodePoolContext(context.Background(), clusterID, nodePoolParams)
+}
+
+// CreateNodePoolContext is like CreateNodePool, but the request is cancelled as soon as ctx is done
+func (nps *NodePoolService) CreateNodePoolContext(
+	ctx context.Context,
+	clusterID string,
+	nodePoolParams *map[string]interface{},
 ) (nodePool *types.NodePool, err error) {
 	log.Debug("CreateNodePool")
 
-	data, status, err := nps.concertoService.Post(
+	data, status, err := nps.concertoService.PostContext(
+		ctx,
 		fmt.Sprintf(APIPathKubernetesClusterNodePools, clusterID),
 		nodePoolParams,
 	)
@@ -101,10 +150,23 @@ func (nps *NodePoolService) CreateNodePool(
 // UpdateNodePool updates a node pool by its ID
 func (nps *NodePoolService) UpdateNodePool(
 	nodePoolID string, nodePoolParams *map[string]interface{},
+) (nodePool *types.NodePool, err error) {
+	return nps.UpdateNodePoolContext(context.Background(), nodePoolID, nodePoolParams)
+}
+
+// UpdateNodePoolContext is like UpdateNodePool, but the request is cancelled as soon as ctx is done
+func (nps *NodePoolService) UpdateNodePoolContext(
+	ctx context.Context,
+	nodePoolID string,
+	nodePoolParams *map[string]interface{},
 ) (nodePool *types.NodePool, err error) {
 	log.Debug("UpdateNodePool")
 
-	data, status, err := nps.concertoService.Put(fmt.Sprintf(APIPathKubernetesNodePool, nodePoolID), nodePoolParams)
+	data, status, err := nps.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathKubernetesNodePool, nodePoolID),
+		nodePoolParams,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -122,9 +184,17 @@ func (nps *NodePoolService) UpdateNodePool(
 
 // DeleteNodePool deletes a node pool by its ID
 func (nps *NodePoolService) DeleteNodePool(nodePoolID string) (nodePool *types.NodePool, err error) {
+	return nps.DeleteNodePoolContext(context.Background(), nodePoolID)
+}
+
+// DeleteNodePoolContext is like DeleteNodePool, but the request is cancelled as soon as ctx is done
+func (nps *NodePoolService) DeleteNodePoolContext(
+	ctx context.Context,
+	nodePoolID string,
+) (nodePool *types.NodePool, err error) {
 	log.Debug("DeleteNodePool")
 
-	data, status, err := nps.concertoService.Delete(fmt.Sprintf(APIPathKubernetesNodePool, nodePoolID))
+	data, status, err := nps.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathKubernetesNodePool, nodePoolID))
 	if err != nil {
 		return nil, err
 	}
@@ -143,10 +213,20 @@ func (nps *NodePoolService) DeleteNodePool(nodePoolID string) (nodePool *types.N
 // RetryNodePool retries a node pool by its ID
 func (nps *NodePoolService) RetryNodePool(
 	nodePoolID string, nodePoolParams *map[string]interface{},
+) (nodePool *types.NodePool, err error) {
+	return nps.RetryNodePoolContext(context.Background(), nodePoolID, nodePoolParams)
+}
+
+// RetryNodePoolContext is like RetryNodePool, but the request is cancelled as soon as ctx is done
+func (nps *NodePoolService) RetryNodePoolContext(
+	ctx context.Context,
+	nodePoolID string,
+	nodePoolParams *map[string]interface{},
 ) (nodePool *types.NodePool, err error) {
 	log.Debug("RetryNodePool")
 
-	data, status, err := nps.concertoService.Put(
+	data, status, err := nps.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathKubernetesNodePoolRetry, nodePoolID),
 		nodePoolParams,
 	)
@@ -167,9 +247,17 @@ func (nps *NodePoolService) RetryNodePool(
 
 // GetNodePoolPlan returns a node pool plan by its ID
 func (nps *NodePoolService) GetNodePoolPlan(nodePoolPlanID string) (nodePoolPlan *types.NodePoolPlan, err error) {
+	return nps.GetNodePoolPlanContext(context.Background(), nodePoolPlanID)
+}
+
+// GetNodePoolPlanContext is like GetNodePoolPlan, but the request is cancelled as soon as ctx is done
+func (nps *NodePoolService) GetNodePoolPlanContext(
+	ctx context.Context,
+	nodePoolPlanID string,
+) (nodePoolPlan *types.NodePoolPlan, err error) {
 	log.Debug("GetNodePoolPlan")
 
-	data, status, err := nps.concertoService.Get(fmt.Sprintf(APIPathKubernetesNodePoolPlan, nodePoolPlanID))
+	data, status, err := nps.concertoService.GetContext(ctx, fmt.Sprintf(APIPathKubernetesNodePoolPlan, nodePoolPlanID))
 	if err != nil {
 		return nil, err
 	}
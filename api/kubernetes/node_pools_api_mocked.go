@@ -28,7 +28,8 @@ func ListNodePoolsMocked(t *testing.T, clusterID string, nodePoolsIn []*types.No
 	assert.Nil(err, "NodePools test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathKubernetesClusterNodePools, clusterID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathKubernetesClusterNodePools, clusterID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	nodePoolsOut, err := ds.ListNodePools(clusterID)
 
 	assert.Nil(err, "Error getting node pools")
@@ -53,7 +54,7 @@ func ListNodePoolsFailErrMocked(t *testing.T, clusterID string, nodePoolsIn []*t
 	assert.Nil(err, "NodePools test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathKubernetesClusterNodePools, clusterID)).
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathKubernetesClusterNodePools, clusterID), 1, utils.DefaultPageSize)).
 		Return(dIn, 200, fmt.Errorf("mocked error"))
 	nodePoolsOut, err := ds.ListNodePools(clusterID)
 
@@ -80,7 +81,8 @@ func ListNodePoolsFailStatusMocked(t *testing.T, clusterID string, nodePoolsIn [
 	assert.Nil(err, "NodePools test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathKubernetesClusterNodePools, clusterID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathKubernetesClusterNodePools, clusterID), 1, utils.DefaultPageSize)).
+		Return(dIn, 499, nil)
 	nodePoolsOut, err := ds.ListNodePools(clusterID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -105,7 +107,8 @@ func ListNodePoolsFailJSONMocked(t *testing.T, clusterID string, nodePoolsIn []*
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathKubernetesClusterNodePools, clusterID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathKubernetesClusterNodePools, clusterID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	nodePoolsOut, err := ds.ListNodePools(clusterID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -3,6 +3,7 @@
 package labels
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -33,37 +34,69 @@ func NewLabelService(concertoService utils.ConcertoService) (*LabelService, erro
 
 // ListLabels returns the list of labels as an array of Label
 func (ls *LabelService) ListLabels() (labels []*types.Label, err error) {
+	return ls.ListLabelsContext(context.Background())
+}
+
+// ListLabelsContext is like ListLabels, but the request is cancelled as soon as ctx is done
+func (ls *LabelService) ListLabelsContext(ctx context.Context) (labels []*types.Label, err error) {
 	log.Debug("ListLabels")
 
-	data, status, err := ls.concertoService.Get(APIPathLabels)
+	err = ls.ListLabelsPagesContext(ctx, func(page []*types.Label) bool {
+		labels = append(labels, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return labels, nil
+}
 
-	if err = json.Unmarshal(data, &labels); err != nil {
-		return nil, err
-	}
+// ListLabelsPages is like ListLabels, but the list is streamed to fn one page at a time, until fn returns false
+func (ls *LabelService) ListLabelsPages(fn func(labels []*types.Label) bool) error {
+	return ls.ListLabelsPagesContext(context.Background(), fn)
+}
+
+// ListLabelsPagesContext is like ListLabelsPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (ls *LabelService) ListLabelsPagesContext(ctx context.Context, fn func(labels []*types.Label) bool) error {
+	log.Debug("ListLabelsPages")
 
-	// exclude internal labels (with a Namespace defined)
-	var filteredLabels []*types.Label
-	for _, label := range labels {
-		if label.Namespace == "" {
-			filteredLabels = append(filteredLabels, label)
+	pager := utils.NewPager(ctx, ls.concertoService, APIPathLabels)
+	for pager.Next() {
+		var labels []*types.Label
+		if err := pager.Decode(&labels); err != nil {
+			return err
+		}
+
+		// exclude internal labels (with a Namespace defined)
+		var filteredLabels []*types.Label
+		for _, label := range labels {
+			if label.Namespace == "" {
+				filteredLabels = append(filteredLabels, label)
+			}
 		}
-	}
 
-	return filteredLabels, nil
+		if !fn(filteredLabels) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // CreateLabel creates a label
 func (ls *LabelService) CreateLabel(labelParams *map[string]interface{}) (label *types.Label, err error) {
+	return ls.CreateLabelContext(context.Background(), labelParams)
+}
+
+// CreateLabelContext is like CreateLabel, but the request is cancelled as soon as ctx is done
+func (ls *LabelService) CreateLabelContext(
+	ctx context.Context,
+	labelParams *map[string]interface{},
+) (label *types.Label, err error) {
 	log.Debug("CreateLabel")
 
-	data, status, err := ls.concertoService.Post(APIPathLabels, labelParams)
+	data, status, err := ls.concertoService.PostContext(ctx, APIPathLabels, labelParams)
 
 	if err != nil {
 		return nil, err
@@ -84,10 +117,19 @@ func (ls *LabelService) CreateLabel(labelParams *map[string]interface{}) (label
 func (ls *LabelService) AddLabel(
 	labelID string,
 	labelParams *map[string]interface{},
+) (labeledResources []*types.LabeledResource, err error) {
+	return ls.AddLabelContext(context.Background(), labelID, labelParams)
+}
+
+// AddLabelContext is like AddLabel, but the request is cancelled as soon as ctx is done
+func (ls *LabelService) AddLabelContext(
+	ctx context.Context,
+	labelID string,
+	labelParams *map[string]interface{},
 ) (labeledResources []*types.LabeledResource, err error) {
 	log.Debug("AddLabel")
 
-	data, status, err := ls.concertoService.Post(fmt.Sprintf(APIPathLabelResources, labelID), labelParams)
+	data, status, err := ls.concertoService.PostContext(ctx, fmt.Sprintf(APIPathLabelResources, labelID), labelParams)
 
 	if err != nil {
 		return nil, err
@@ -106,9 +148,20 @@ func (ls *LabelService) AddLabel(
 
 // RemoveLabel de-assigns a single label from a single labelable resource
 func (ls *LabelService) RemoveLabel(labelID string, resourceType string, resourceID string) error {
+	return ls.RemoveLabelContext(context.Background(), labelID, resourceType, resourceID)
+}
+
+// RemoveLabelContext is like RemoveLabel, but the request is cancelled as soon as ctx is done
+func (ls *LabelService) RemoveLabelContext(
+	ctx context.Context,
+	labelID string,
+	resourceType string,
+	resourceID string,
+) error {
 	log.Debug("RemoveLabel")
 
-	data, status, err := ls.concertoService.Delete(
+	data, status, err := ls.concertoService.DeleteContext(
+		ctx,
 		fmt.Sprintf(APIPathLabelResource, labelID, resourceType, resourceID),
 	)
 	if err != nil {
@@ -28,7 +28,7 @@ func ListLabelsMocked(t *testing.T, labelsIn []*types.Label) []*types.Label {
 	assert.Nil(err, "Label test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathLabels).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathLabels, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	labelsOut, err := ds.ListLabels()
 	assert.Nil(err, "Error getting labels list")
 	assert.Equal(labelsIn, labelsOut, "ListLabels returned different labels")
@@ -52,7 +52,7 @@ func ListLabelsMockedWithNamespace(t *testing.T, labelsIn []*types.Label) []*typ
 	assert.Nil(err, "Label test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathLabels).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathLabels, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	labelsOut, err := ds.ListLabels()
 	assert.Nil(err, "Error getting labels list")
 	assert.NotEqual(labelsIn, labelsOut, "ListLabels returned labels with Namespaces")
@@ -76,7 +76,7 @@ func ListLabelsFailErrMocked(t *testing.T, labelsIn []*types.Label) []*types.Lab
 	assert.Nil(err, "Label test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathLabels).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathLabels, 1, utils.DefaultPageSize)).Return(dIn, 200, fmt.Errorf("mocked error"))
 	labelsOut, err := ds.ListLabels()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -102,7 +102,7 @@ func ListLabelsFailStatusMocked(t *testing.T, labelsIn []*types.Label) []*types.
 	assert.Nil(err, "Label test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathLabels).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathLabels, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	labelsOut, err := ds.ListLabels()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -127,7 +127,7 @@ func ListLabelsFailJSONMocked(t *testing.T, labelsIn []*types.Label) []*types.La
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathLabels).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathLabels, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	labelsOut, err := ds.ListLabels()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -0,0 +1,588 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ingrammicro/cio/api/types"
+	"github.com/ingrammicro/cio/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// Actions taken to apply manifests
+const (
+	ActionCreate    = "create"
+	ActionUpdate    = "update"
+	ActionDelete    = "delete"
+	ActionUnchanged = "unchanged"
+)
+
+// idRegexp matches IMCO resource IDs, which references can take instead of names
+var idRegexp = regexp.MustCompile("^[0-9a-f]{24}$")
+
+// Result tells what was done, or would be done when planning, to apply a manifest
+type Result struct {
+	Kind    string  `json:"kind"              header:"KIND"`
+	Name    string  `json:"name"              header:"NAME"`
+	ID      string  `json:"id"                header:"ID"`
+	Action  string  `json:"action"            header:"ACTION"`
+	Changes Changes `json:"changes,omitempty" header:"CHANGES"`
+}
+
+// Change is the change of a field of a resource. From is nil for the fields set on creation
+type Change struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from,omitempty"`
+	To    interface{} `json:"to,omitempty"`
+}
+
+func (c *Change) String() string {
+	if c.From == nil {
+		return fmt.Sprintf("%s: %s", c.Field, formatValue(c.To))
+	}
+	return fmt.Sprintf("%s: %s -> %s", c.Field, formatValue(c.From), formatValue(c.To))
+}
+
+// Changes are the changes of the fields of a resource
+type Changes []*Change
+
+func (cs Changes) String() string {
+	changes := make([]string, 0, len(cs))
+	for _, c := range cs {
+		changes = append(changes, c.String())
+	}
+	return strings.Join(changes, ", ")
+}
+
+// Fields returns the names of the changed fields
+func (cs Changes) Fields() []string {
+	fields := make([]string, 0, len(cs))
+	for _, c := range cs {
+		fields = append(fields, c.Field)
+	}
+	return fields
+}
+
+// formatValue returns the JSON representation of a value, as it is sent to the API
+func formatValue(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
+
+// Drifted tells whether applying the manifests which gave the results changes anything
+func Drifted(results []*Result) bool {
+	for _, r := range results {
+		if r.Action != ActionUnchanged {
+			return true
+		}
+	}
+	return false
+}
+
+// LabelService is the part of the labels API used to label resources, as implemented by labels.LabelService
+type LabelService interface {
+	ListLabelsContext(ctx context.Context) ([]*types.Label, error)
+	CreateLabelContext(ctx context.Context, labelParams *map[string]interface{}) (*types.Label, error)
+	AddLabelContext(
+		ctx context.Context,
+		labelID string,
+		labelParams *map[string]interface{},
+	) ([]*types.LabeledResource, error)
+}
+
+// Applier creates or updates the resources described by manifests, so that they match them
+type Applier struct {
+	*inventory
+
+	// PruneLabel, if given, has the resources with that label which the manifests don't describe deleted
+	PruneLabel string
+
+	// IDs of the resources applied by kind, parent, identity and name
+	applied map[string]string
+
+	// dryRun has changes planned instead of made, giving placeholder IDs to the resources which would be created
+	dryRun  bool
+	planned map[string]bool
+}
+
+// NewApplier returns an applier of manifests describing resources of the given kinds
+func NewApplier(kinds map[string]*Kind, labels LabelService) *Applier {
+	return &Applier{
+		inventory: newInventory(kinds, labels),
+		applied:   make(map[string]string),
+		planned:   make(map[string]bool),
+	}
+}
+
+// Apply validates the manifests, then applies them in dependency order. It returns what was done until it finished
+// or failed
+func (a *Applier) Apply(ctx context.Context, manifests []*Manifest) ([]*Result, error) {
+	log.Debug("Apply")
+
+	a.dryRun = false
+	return a.run(ctx, manifests)
+}
+
+// Plan validates the manifests, then returns what applying them would do, comparing them with the existing resources
+// without changing any
+func (a *Applier) Plan(ctx context.Context, manifests []*Manifest) ([]*Result, error) {
+	log.Debug("Plan")
+
+	a.dryRun = true
+	return a.run(ctx, manifests)
+}
+
+func (a *Applier) run(ctx context.Context, manifests []*Manifest) ([]*Result, error) {
+	if err := Validate(manifests, a.kinds); err != nil {
+		return nil, err
+	}
+	sorted := append([]*Manifest{}, manifests...)
+	Sort(sorted)
+
+	results := make([]*Result, 0)
+	for _, m := range sorted {
+		result, err := a.apply(ctx, m)
+		if err != nil {
+			return results, fmt.Errorf("%s: couldn't apply %s: %w", m.Source, m, err)
+		}
+		log.Debugf("%s %s %s", m, result.ID, result.Action)
+		results = append(results, result)
+	}
+
+	if a.PruneLabel != "" {
+		pruned, err := a.prune(ctx)
+		results = append(results, pruned...)
+		if err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+func (a *Applier) apply(ctx context.Context, m *Manifest) (*Result, error) {
+	kind := a.kinds[m.Kind]
+	spec, err := a.resolveReferences(ctx, kind, m.Spec)
+	if err != nil {
+		return nil, err
+	}
+	parentID := ""
+	if kind.Parent != "" {
+		parentID = spec[kind.Parent].(string)
+	}
+
+	current, err := a.match(ctx, m, parentID)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{Kind: m.Kind, Name: m.Metadata.Name}
+	id := ""
+	if current == nil {
+		created, changes, err := a.create(ctx, m, kind, parentID, spec)
+		if err != nil {
+			return nil, err
+		}
+		if created != nil {
+			result.ID = created.ID()
+			id = result.ID
+		} else {
+			id = fmt.Sprintf("(new %s %s)", m.Kind, m.Metadata.Name)
+			a.planned[id] = true
+		}
+		result.Action = ActionCreate
+		result.Changes = changes
+	} else {
+		changes, err := a.update(ctx, m, kind, current, spec)
+		if err != nil {
+			return nil, err
+		}
+		result.ID = current.ID()
+		id = result.ID
+		result.Action = ActionUnchanged
+		if len(changes) > 0 {
+			result.Action = ActionUpdate
+			result.Changes = changes
+		}
+	}
+	a.applied[appliedKey(m.Kind, parentID, kind.identity(spec), m.Metadata.Name)] = id
+	return result, nil
+}
+
+func appliedKey(kind string, parentID string, identity string, name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", kind, parentID, identity, name)
+}
+
+// create creates the resource, with the labels of the manifest. It returns the resource, which is nil when planning,
+// and the fields set
+func (a *Applier) create(
+	ctx context.Context,
+	m *Manifest,
+	kind *Kind,
+	parentID string,
+	spec map[string]interface{},
+) (Object, Changes, error) {
+	params := make(map[string]interface{})
+	fields := make(Changes, 0)
+	for k, v := range spec {
+		if k != kind.Parent {
+			params[k] = v
+		}
+		fields = append(fields, &Change{Field: k, To: v})
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Field < fields[j].Field })
+	changes := append(Changes{{Field: "name", To: m.Metadata.Name}}, fields...)
+	params["name"] = m.Metadata.Name
+	if len(m.Metadata.Labels) > 0 {
+		changes = append(changes, &Change{Field: "labels", To: m.Metadata.Labels})
+	}
+	if a.dryRun {
+		return nil, changes, nil
+	}
+
+	if len(m.Metadata.Labels) > 0 {
+		labelIDs, err := a.labelIDs(ctx, m.Metadata.Labels)
+		if err != nil {
+			return nil, nil, err
+		}
+		params["label_ids"] = labelIDs
+	}
+	created, err := kind.Create(ctx, parentID, &params)
+	if err != nil {
+		return nil, nil, err
+	}
+	listing := fmt.Sprintf("%s/%s", m.Kind, parentID)
+	if objects, ok := a.existing[listing]; ok {
+		a.existing[listing] = append(objects, created)
+	}
+	return created, changes, nil
+}
+
+// update updates the fields of the resource differing from the manifest, and adds the labels it lacks. It returns the
+// changes, which are only planned when planning
+func (a *Applier) update(
+	ctx context.Context,
+	m *Manifest,
+	kind *Kind,
+	current Object,
+	spec map[string]interface{},
+) (Changes, error) {
+	changes, err := Diff(kind, current, m.Metadata.Name, spec)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) > 0 && !a.dryRun {
+		params := make(map[string]interface{})
+		for _, c := range changes {
+			params[c.Field] = c.To
+		}
+		if _, err := kind.Update(ctx, current.ID(), &params); err != nil {
+			return nil, err
+		}
+	}
+
+	missing, err := a.missingLabels(ctx, m, current)
+	if err != nil {
+		return nil, err
+	}
+	if len(missing) == 0 {
+		return changes, nil
+	}
+	if !a.dryRun {
+		for _, name := range missing {
+			if err := a.addLabel(ctx, name, kind.ResourceType, current.ID()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	names := a.labelNames(current.LabelIDs())
+	return append(changes, &Change{Field: "labels", From: names, To: append(names, missing...)}), nil
+}
+
+// Diff returns the changes of the fields of the spec, and of the name, which differ from the current resource. Fields
+// which can't be updated are an error
+func Diff(kind *Kind, current Object, name string, spec map[string]interface{}) (Changes, error) {
+	changes := make(Changes, 0)
+	if current.Name() != name {
+		changes = append(changes, &Change{Field: "name", From: current.Name(), To: name})
+	}
+	for k, v := range spec {
+		if k == kind.Parent || equalValues(v, current[k], utils.Contains(kind.Unordered, k)) {
+			continue
+		}
+		for _, immutable := range kind.Immutable {
+			if k == immutable {
+				return nil, fmt.Errorf("%s can't be changed from %v to %v", k, current[k], v)
+			}
+		}
+		changes = append(changes, &Change{Field: k, From: current[k], To: v})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes, nil
+}
+
+// equalValues compares a spec value with the one of a resource, as represented in JSON. Unordered lists are equal
+// when they have the same items, in any order
+func equalValues(specValue interface{}, currentValue interface{}, unordered bool) bool {
+	data, err := json.Marshal(specValue)
+	if err != nil {
+		return false
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return false
+	}
+	if unordered {
+		return reflect.DeepEqual(sortedItems(v), sortedItems(currentValue))
+	}
+	return reflect.DeepEqual(v, currentValue)
+}
+
+// sortedItems returns the JSON representations of the items of a list, sorted, or nil if the value is not a list
+func sortedItems(value interface{}) []string {
+	values, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+	items := make([]string, 0, len(values))
+	for _, v := range values {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil
+		}
+		items = append(items, string(data))
+	}
+	sort.Strings(items)
+	return items
+}
+
+// resolveReferences returns a copy of the spec where the fields referring to other resources take their IDs. Fields
+// referring to resources without parent are resolved first, as they can be the parent of the rest
+func (a *Applier) resolveReferences(
+	ctx context.Context,
+	kind *Kind,
+	spec map[string]interface{},
+) (map[string]interface{}, error) {
+	resolved := make(map[string]interface{})
+	for k, v := range spec {
+		resolved[k] = v
+	}
+
+	fields := make([]string, 0)
+	for field := range kind.References {
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		pi, pj := a.kinds[kind.References[fields[i]]].Parent, a.kinds[kind.References[fields[j]]].Parent
+		if (pi == "") != (pj == "") {
+			return pi == ""
+		}
+		return fields[i] < fields[j]
+	})
+
+	for _, field := range fields {
+		value, ok := resolved[field].(string)
+		if !ok || value == "" {
+			continue
+		}
+		refKind := kind.References[field]
+		parentID := ""
+		if parent := a.kinds[refKind].Parent; parent != "" {
+			parentID, _ = resolved[parent].(string)
+		}
+		id, err := a.resolve(ctx, refKind, parentID, value)
+		if err != nil {
+			return nil, fmt.Errorf("spec.%s: %w", field, err)
+		}
+		resolved[field] = id
+	}
+	return resolved, nil
+}
+
+// resolve returns the ID of the resource of the kind referred by value, which is either its ID or its name. Names are
+// looked up among the resources applied first, then among the existing ones
+func (a *Applier) resolve(ctx context.Context, kind string, parentID string, value string) (string, error) {
+	if idRegexp.MatchString(value) {
+		return value, nil
+	}
+
+	ids := make([]string, 0)
+	for key, id := range a.applied {
+		parts := strings.SplitN(key, "/", 4)
+		if parts[0] == kind && (parentID == "" || parts[1] == parentID) && parts[3] == value {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		if a.kinds[kind].Parent != "" && parentID == "" {
+			return "", fmt.Errorf(
+				"%s %q can only be looked up along with its spec.%s",
+				kind,
+				value,
+				a.kinds[kind].Parent,
+			)
+		}
+		objects, err := a.list(ctx, kind, parentID)
+		if err != nil {
+			return "", err
+		}
+		for _, o := range objects {
+			if o.Name() == value {
+				ids = append(ids, o.ID())
+			}
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("%s %q not found", kind, value)
+	case 1:
+		return ids[0], nil
+	}
+	sort.Strings(ids)
+	return "", fmt.Errorf("%s name %q is ambiguous: %s", kind, value, strings.Join(ids, ", "))
+}
+
+// match returns the existing resource described by the manifest, if any: the one with the label given by
+// metadata.match_label or, by default, the one with its name and identity fields
+func (a *Applier) match(ctx context.Context, m *Manifest, parentID string) (Object, error) {
+	objects, err := a.list(ctx, m.Kind, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	labelID := ""
+	if m.Metadata.MatchLabel != "" {
+		if err := a.loadLabels(ctx); err != nil {
+			return nil, err
+		}
+		if labelID = a.labelIDsByName[m.Metadata.MatchLabel]; labelID == "" {
+			return nil, nil
+		}
+	}
+
+	kind := a.kinds[m.Kind]
+	identity := kind.identity(m.Spec)
+	matches := make([]Object, 0)
+	for _, o := range objects {
+		named := o.Name() == m.Metadata.Name && kind.identity(o) == identity
+		if labelID == "" && named || labelID != "" && utils.Contains(o.LabelIDs(), labelID) {
+			matches = append(matches, o)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return matches[0], nil
+	}
+	ids := make([]string, 0)
+	for _, o := range matches {
+		ids = append(ids, o.ID())
+	}
+	return nil, fmt.Errorf("%d existing resources match it: %s", len(matches), strings.Join(ids, ", "))
+}
+
+// list returns the existing resources of the kind. Resources of parents which would be created have none
+func (a *Applier) list(ctx context.Context, kind string, parentID string) ([]Object, error) {
+	if a.planned[parentID] {
+		return nil, nil
+	}
+	return a.inventory.list(ctx, kind, parentID)
+}
+
+// labelIDs returns the IDs of the named labels, creating those which don't exist yet
+func (a *Applier) labelIDs(ctx context.Context, names []string) ([]string, error) {
+	if err := a.loadLabels(ctx); err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0)
+	for _, name := range names {
+		if a.labelIDsByName[name] == "" {
+			label, err := a.labels.CreateLabelContext(ctx, &map[string]interface{}{"name": name})
+			if err != nil {
+				return nil, err
+			}
+			a.labelIDsByName[name] = label.ID
+		}
+		ids = append(ids, a.labelIDsByName[name])
+	}
+	return ids, nil
+}
+
+// missingLabels returns the labels of the manifest the resource lacks
+func (a *Applier) missingLabels(ctx context.Context, m *Manifest, current Object) ([]string, error) {
+	if len(m.Metadata.Labels) == 0 {
+		return nil, nil
+	}
+	if err := a.loadLabels(ctx); err != nil {
+		return nil, err
+	}
+	missing := make([]string, 0)
+	for _, name := range m.Metadata.Labels {
+		if id := a.labelIDsByName[name]; id == "" || !utils.Contains(current.LabelIDs(), id) {
+			missing = append(missing, name)
+		}
+	}
+	return missing, nil
+}
+
+// addLabel adds the named label, created if needed, to the resource
+func (a *Applier) addLabel(ctx context.Context, name string, resourceType string, resourceID string) error {
+	ids, err := a.labelIDs(ctx, []string{name})
+	if err != nil {
+		return err
+	}
+	params := map[string]interface{}{
+		"resources": []interface{}{map[string]string{"id": resourceID, "resource_type": resourceType}},
+	}
+	_, err = a.labels.AddLabelContext(ctx, ids[0], &params)
+	return err
+}
+
+// prune deletes, in reverse dependency order, the resources with the prune label which weren't applied
+func (a *Applier) prune(ctx context.Context) ([]*Result, error) {
+	if err := a.loadLabels(ctx); err != nil {
+		return nil, err
+	}
+	labelID := a.labelIDsByName[a.PruneLabel]
+	if labelID == "" {
+		return nil, nil
+	}
+	kept := make(map[string]bool)
+	for _, id := range a.applied {
+		kept[id] = true
+	}
+
+	results := make([]*Result, 0)
+	for i := len(KindOrder) - 1; i >= 0; i-- {
+		kind, ok := a.kinds[KindOrder[i]]
+		if !ok || kind.ResourceType == "" || kind.Delete == nil {
+			continue
+		}
+		objects, err := a.list(ctx, KindOrder[i], "")
+		if err != nil {
+			return results, err
+		}
+		for _, o := range objects {
+			if kept[o.ID()] || !utils.Contains(o.LabelIDs(), labelID) {
+				continue
+			}
+			if !a.dryRun {
+				if err := kind.Delete(ctx, o.ID()); err != nil {
+					return results, fmt.Errorf("couldn't delete %s %q: %w", KindOrder[i], o.Name(), err)
+				}
+			}
+			results = append(results, &Result{Kind: KindOrder[i], Name: o.Name(), ID: o.ID(), Action: ActionDelete})
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,403 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ingrammicro/cio/api/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func createFake(ctx context.Context, parentID string, params *map[string]interface{}) (Object, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// fakeAPI keeps resources in memory, by kind, recording the calls changing them
+type fakeAPI struct {
+	objects map[string][]Object
+	labels  []*types.Label
+	calls   []string
+	lastID  int
+}
+
+func newFakeAPI() *fakeAPI {
+	return &fakeAPI{objects: make(map[string][]Object)}
+}
+
+func (f *fakeAPI) nextID() string {
+	f.lastID++
+	return fmt.Sprintf("%024x", f.lastID)
+}
+
+func (f *fakeAPI) add(kind string, o Object) Object {
+	if o.ID() == "" {
+		o["id"] = f.nextID()
+	}
+	f.objects[kind] = append(f.objects[kind], o)
+	return o
+}
+
+// kind returns a kind of resources kept by the fake. Only resources without parent are labelable
+func (f *fakeAPI) kind(name string, parent string, references map[string]string, immutable ...string) *Kind {
+	resourceType := name
+	if parent != "" {
+		resourceType = ""
+	}
+	return &Kind{
+		Parent:       parent,
+		References:   references,
+		Immutable:    immutable,
+		ResourceType: resourceType,
+		List: func(ctx context.Context, parentID string) ([]Object, error) {
+			objects := make([]Object, 0)
+			for _, o := range f.objects[name] {
+				if parent == "" || o[parent] == parentID {
+					objects = append(objects, o)
+				}
+			}
+			return objects, nil
+		},
+		Create: func(ctx context.Context, parentID string, params *map[string]interface{}) (Object, error) {
+			o := Object{}
+			for k, v := range *params {
+				o[k] = v
+			}
+			if parent != "" {
+				o[parent] = parentID
+			}
+			f.calls = append(f.calls, fmt.Sprintf("create %s %s", name, o.Name()))
+			// resources are kept as decoded from JSON, as returned by the API
+			o, err := toObject(o)
+			if err != nil {
+				return nil, err
+			}
+			return f.add(name, o), nil
+		},
+		Update: func(ctx context.Context, id string, params *map[string]interface{}) (Object, error) {
+			for _, o := range f.objects[name] {
+				if o.ID() == id {
+					for k, v := range *params {
+						o[k] = v
+					}
+					f.calls = append(f.calls, fmt.Sprintf("update %s %s", name, o.Name()))
+					return o, nil
+				}
+			}
+			return nil, fmt.Errorf("%s not found", id)
+		},
+		Delete: func(ctx context.Context, id string) error {
+			for i, o := range f.objects[name] {
+				if o.ID() == id {
+					f.objects[name] = append(f.objects[name][:i], f.objects[name][i+1:]...)
+					f.calls = append(f.calls, fmt.Sprintf("delete %s %s", name, o.Name()))
+					return nil
+				}
+			}
+			return fmt.Errorf("%s not found", id)
+		},
+	}
+}
+
+func (f *fakeAPI) kinds() map[string]*Kind {
+	kinds := map[string]*Kind{
+		KindSSHProfile: f.kind(KindSSHProfile, "", nil),
+		KindVPC:        f.kind(KindVPC, "", nil, "cidr"),
+		KindSubnet:     f.kind(KindSubnet, "vpc_id", map[string]string{"vpc_id": KindVPC}),
+		KindServer: f.kind(KindServer, "", map[string]string{
+			"ssh_profile_id": KindSSHProfile,
+			"vpc_id":         KindVPC,
+			"subnet_id":      KindSubnet,
+		}),
+	}
+	kinds[KindSSHProfile].Fields = []string{"public_key"}
+	kinds[KindVPC].Fields = []string{"cidr"}
+	kinds[KindSubnet].Fields = []string{"vpc_id", "cidr"}
+	kinds[KindServer].Fields = []string{"ssh_profile_id", "vpc_id", "subnet_id", "server_plan_id"}
+	return kinds
+}
+
+func (f *fakeAPI) ListLabelsContext(ctx context.Context) ([]*types.Label, error) {
+	return f.labels, nil
+}
+
+func (f *fakeAPI) CreateLabelContext(ctx context.Context, params *map[string]interface{}) (*types.Label, error) {
+	label := &types.Label{ID: f.nextID(), Name: (*params)["name"].(string)}
+	f.labels = append(f.labels, label)
+	f.calls = append(f.calls, fmt.Sprintf("create label %s", label.Name))
+	return label, nil
+}
+
+func (f *fakeAPI) AddLabelContext(
+	ctx context.Context,
+	labelID string,
+	params *map[string]interface{},
+) ([]*types.LabeledResource, error) {
+	resource := (*params)["resources"].([]interface{})[0].(map[string]string)
+	f.calls = append(f.calls, fmt.Sprintf("add label %s to %s %s", labelID, resource["resource_type"], resource["id"]))
+	return nil, nil
+}
+
+func testServerManifests() []*Manifest {
+	return []*Manifest{
+		{
+			Kind:     KindServer,
+			Metadata: Metadata{Name: "web-1", Labels: []string{"web"}},
+			Spec: map[string]interface{}{
+				"ssh_profile_id": "web",
+				"vpc_id":         "main",
+				"subnet_id":      "front",
+				"server_plan_id": "5f0a1b2c3d4e5f6a7b8c9d0e",
+			},
+		},
+		{Kind: KindSubnet, Metadata: Metadata{Name: "front"}, Spec: map[string]interface{}{"vpc_id": "main"}},
+		{Kind: KindVPC, Metadata: Metadata{Name: "main"}, Spec: map[string]interface{}{"cidr": "10.0.0.0/16"}},
+		{Kind: KindSSHProfile, Metadata: Metadata{Name: "web"}, Spec: map[string]interface{}{"public_key": "ssh-rsa A"}},
+	}
+}
+
+func TestApplyCreatesInDependencyOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	api := newFakeAPI()
+	results, err := NewApplier(api.kinds(), api).Apply(context.Background(), testServerManifests())
+	assert.Nil(err, "Manifests should be applied")
+	assert.Len(results, 4)
+	for _, r := range results {
+		assert.Equal(ActionCreate, r.Action, "All resources should be created")
+	}
+	assert.Equal([]string{
+		"create SSHProfile web",
+		"create VPC main",
+		"create Subnet front",
+		"create label web",
+		"create Server web-1",
+	}, api.calls, "Resources should be created in dependency order")
+
+	server := api.objects[KindServer][0]
+	assert.Equal(api.objects[KindSSHProfile][0].ID(), server["ssh_profile_id"], "References should be resolved")
+	assert.Equal(api.objects[KindVPC][0].ID(), server["vpc_id"], "References should be resolved")
+	assert.Equal(api.objects[KindSubnet][0].ID(), server["subnet_id"], "References should be resolved")
+	assert.Equal(api.objects[KindVPC][0].ID(), api.objects[KindSubnet][0]["vpc_id"], "Parents should be resolved")
+	assert.Equal([]string{api.labels[0].ID}, server.LabelIDs(), "Labels should be assigned on creation")
+	_, ok := api.objects[KindSubnet][0]["name"]
+	assert.True(ok, "Names should be given")
+}
+
+func TestApplyIsIdempotent(t *testing.T) {
+	assert := assert.New(t)
+
+	api := newFakeAPI()
+	_, err := NewApplier(api.kinds(), api).Apply(context.Background(), testServerManifests())
+	assert.Nil(err)
+	api.calls = nil
+
+	results, err := NewApplier(api.kinds(), api).Apply(context.Background(), testServerManifests())
+	assert.Nil(err, "Manifests should be applied again")
+	for _, r := range results {
+		assert.Equal(ActionUnchanged, r.Action, "Resources should be left unchanged")
+	}
+	assert.Empty(api.calls, "Nothing should be changed")
+}
+
+func TestApplyUpdatesChangedFields(t *testing.T) {
+	assert := assert.New(t)
+
+	api := newFakeAPI()
+	api.add(KindSSHProfile, Object{"name": "web", "public_key": "ssh-rsa OLD"})
+	api.add(KindVPC, Object{"name": "renamed", "cidr": "10.0.0.0/16", "label_ids": []interface{}{"l1"}})
+	api.labels = []*types.Label{{ID: "l1", Name: "main-vpc"}, {ID: "l2", Name: "prod"}}
+
+	manifests := []*Manifest{
+		{Kind: KindSSHProfile, Metadata: Metadata{Name: "web", Labels: []string{"prod"}}, Spec: map[string]interface{}{
+			"public_key": "ssh-rsa NEW",
+		}},
+		{Kind: KindVPC, Metadata: Metadata{Name: "main", MatchLabel: "main-vpc"}, Spec: map[string]interface{}{
+			"cidr": "10.0.0.0/16",
+		}},
+	}
+	results, err := NewApplier(api.kinds(), api).Apply(context.Background(), manifests)
+	assert.Nil(err, "Manifests should be applied")
+	assert.Equal(ActionUpdate, results[0].Action)
+	assert.Equal([]string{"public_key", "labels"}, results[0].Changes.Fields(), "Changed fields should be told")
+	assert.Equal(`public_key: "ssh-rsa OLD" -> "ssh-rsa NEW", labels: [] -> ["prod"]`, results[0].Changes.String())
+	assert.Equal(ActionUpdate, results[1].Action)
+	assert.Equal([]string{"name"}, results[1].Changes.Fields(), "Resources matched by label should be renamed")
+	assert.Equal([]string{
+		"update SSHProfile web",
+		fmt.Sprintf("add label l2 to SSHProfile %s", api.objects[KindSSHProfile][0].ID()),
+		"update VPC main",
+	}, api.calls)
+	assert.Equal("ssh-rsa NEW", api.objects[KindSSHProfile][0]["public_key"])
+}
+
+func TestPlan(t *testing.T) {
+	assert := assert.New(t)
+
+	api := newFakeAPI()
+	api.add(KindSSHProfile, Object{"name": "web", "public_key": "ssh-rsa OLD"})
+	results, err := NewApplier(api.kinds(), api).Plan(context.Background(), testServerManifests())
+	assert.Nil(err, "Manifests should be planned")
+	assert.Empty(api.calls, "Nothing should be changed")
+	assert.True(Drifted(results))
+
+	actions := make([]string, 0)
+	for _, r := range results {
+		actions = append(actions, fmt.Sprintf("%s %s %s", r.Action, r.Kind, r.Name))
+	}
+	assert.Equal([]string{
+		"update SSHProfile web",
+		"create VPC main",
+		"create Subnet front",
+		"create Server web-1",
+	}, actions, "Changes should be planned in dependency order")
+	assert.Equal(`public_key: "ssh-rsa OLD" -> "ssh-rsa A"`, results[0].Changes.String(), "Changes should be detailed")
+	assert.Empty(results[1].ID, "Resources to create have no ID yet")
+	assert.Contains(
+		results[3].Changes.String(),
+		`subnet_id: "(new Subnet front)"`,
+		"References to resources to create should be told",
+	)
+	assert.Contains(results[3].Changes.String(), fmt.Sprintf(`ssh_profile_id: "%s"`, api.objects[KindSSHProfile][0].ID()))
+
+	_, err = NewApplier(api.kinds(), api).Apply(context.Background(), testServerManifests())
+	assert.Nil(err)
+	results, err = NewApplier(api.kinds(), api).Plan(context.Background(), testServerManifests())
+	assert.Nil(err)
+	assert.False(Drifted(results), "Nothing should be planned once applied")
+}
+
+func TestApplyMatchesByIdentity(t *testing.T) {
+	assert := assert.New(t)
+
+	api := newFakeAPI()
+	kinds := api.kinds()
+	kinds[KindDNSDomain] = api.kind(KindDNSDomain, "", nil)
+	kinds[KindDNSRecord] = api.kind(KindDNSRecord, "domain_id", map[string]string{"domain_id": KindDNSDomain}, "type")
+	kinds[KindDNSRecord].Identity = []string{"type"}
+	domain := api.add(KindDNSDomain, Object{"name": "example.com"})
+	api.add(KindDNSRecord, Object{"name": "www", "domain_id": domain.ID(), "type": "TXT", "content": "old"})
+
+	manifests := []*Manifest{
+		{Kind: KindDNSRecord, Metadata: Metadata{Name: "www"}, Spec: map[string]interface{}{
+			"domain_id": "example.com", "type": "A", "content": "10.0.0.1",
+		}},
+		{Kind: KindDNSRecord, Metadata: Metadata{Name: "www"}, Spec: map[string]interface{}{
+			"domain_id": "example.com", "type": "TXT", "content": "new",
+		}},
+	}
+	results, err := NewApplier(kinds, api).Apply(context.Background(), manifests)
+	assert.Nil(err, "Records sharing a name with different types should be applied")
+	if assert.Len(results, 2) {
+		assert.Equal(ActionCreate, results[0].Action, "The A record should be created")
+		assert.Equal(ActionUpdate, results[1].Action, "The TXT record should be matched by its type")
+		assert.Equal([]string{"content"}, results[1].Changes.Fields())
+	}
+}
+
+func TestDiffUnorderedFields(t *testing.T) {
+	assert := assert.New(t)
+
+	kind := &Kind{Unordered: []string{"rules"}}
+	current := Object{"name": "web", "rules": []interface{}{
+		map[string]interface{}{"protocol": "tcp", "min_port": float64(443)},
+		map[string]interface{}{"protocol": "tcp", "min_port": float64(80)},
+	}}
+	changes, err := Diff(kind, current, "web", map[string]interface{}{"rules": []interface{}{
+		map[string]interface{}{"protocol": "tcp", "min_port": 80},
+		map[string]interface{}{"protocol": "tcp", "min_port": 443},
+	}})
+	assert.Nil(err)
+	assert.Empty(changes, "Reordered rules shouldn't be a change")
+
+	changes, err = Diff(kind, current, "web", map[string]interface{}{"rules": []interface{}{
+		map[string]interface{}{"protocol": "tcp", "min_port": 80},
+	}})
+	assert.Nil(err)
+	assert.Equal([]string{"rules"}, changes.Fields(), "Removed rules should be a change")
+}
+
+func TestPrune(t *testing.T) {
+	assert := assert.New(t)
+
+	api := newFakeAPI()
+	api.labels = []*types.Label{{ID: "l1", Name: "managed"}}
+	api.add(KindVPC, Object{"name": "main", "cidr": "10.0.0.0/16", "label_ids": []interface{}{"l1"}})
+	api.add(KindVPC, Object{"name": "old", "cidr": "10.1.0.0/16", "label_ids": []interface{}{"l1"}})
+	api.add(KindVPC, Object{"name": "unmanaged", "cidr": "10.2.0.0/16"})
+	api.add(KindServer, Object{"name": "old-1", "label_ids": []interface{}{"l1"}})
+	manifests := []*Manifest{
+		{Kind: KindVPC, Metadata: Metadata{Name: "main"}, Spec: map[string]interface{}{"cidr": "10.0.0.0/16"}},
+	}
+
+	applier := NewApplier(api.kinds(), api)
+	applier.PruneLabel = "managed"
+	results, err := applier.Plan(context.Background(), manifests)
+	assert.Nil(err, "Manifests should be planned")
+	assert.Empty(api.calls, "Nothing should be deleted when planning")
+	if assert.Len(results, 3) {
+		assert.Equal(ActionUnchanged, results[0].Action)
+		assert.Equal(&Result{Kind: KindServer, Name: "old-1", ID: api.objects[KindServer][0].ID(), Action: ActionDelete},
+			results[1], "Resources should be deleted in reverse dependency order")
+		assert.Equal(ActionDelete, results[2].Action)
+		assert.Equal("old", results[2].Name)
+	}
+
+	applier = NewApplier(api.kinds(), api)
+	applier.PruneLabel = "managed"
+	_, err = applier.Apply(context.Background(), manifests)
+	assert.Nil(err, "Manifests should be applied")
+	assert.Equal([]string{"delete Server old-1", "delete VPC old"}, api.calls, "Labelled resources should be pruned")
+}
+
+func TestApplyFailures(t *testing.T) {
+	api := newFakeAPI()
+	api.add(KindVPC, Object{"name": "main", "cidr": "10.0.0.0/16"})
+	api.add(KindSSHProfile, Object{"name": "dup"})
+	api.add(KindSSHProfile, Object{"name": "dup"})
+
+	tests := map[string]struct {
+		manifest *Manifest
+		err      string
+	}{
+		"immutable": {
+			manifest: &Manifest{Kind: KindVPC, Metadata: Metadata{Name: "main"}, Spec: map[string]interface{}{
+				"cidr": "10.1.0.0/16",
+			}},
+			err: "cidr can't be changed from 10.0.0.0/16 to 10.1.0.0/16",
+		},
+		"ambiguous match": {
+			manifest: &Manifest{Kind: KindSSHProfile, Metadata: Metadata{Name: "dup"}},
+			err:      "2 existing resources match it",
+		},
+		"ambiguous reference": {
+			manifest: &Manifest{Kind: KindServer, Metadata: Metadata{Name: "s"}, Spec: map[string]interface{}{
+				"ssh_profile_id": "dup",
+			}},
+			err: `spec.ssh_profile_id: SSHProfile name "dup" is ambiguous`,
+		},
+		"missing reference": {
+			manifest: &Manifest{Kind: KindServer, Metadata: Metadata{Name: "s"}, Spec: map[string]interface{}{
+				"vpc_id": "nope",
+			}},
+			err: `spec.vpc_id: VPC "nope" not found`,
+		},
+		"reference without parent": {
+			manifest: &Manifest{Kind: KindServer, Metadata: Metadata{Name: "s"}, Spec: map[string]interface{}{
+				"subnet_id": "front",
+			}},
+			err: `Subnet "front" can only be looked up along with its spec.vpc_id`,
+		},
+	}
+	for name, test := range tests {
+		test.manifest.Source = "test.yaml, document 1"
+		_, err := NewApplier(api.kinds(), api).Apply(context.Background(), []*Manifest{test.manifest})
+		if assert.NotNil(t, err, name) {
+			assert.Contains(t, err.Error(), test.err, name)
+			assert.Contains(t, err.Error(), "test.yaml, document 1", name)
+		}
+	}
+	assert.Empty(t, api.calls, "Nothing should be changed")
+}
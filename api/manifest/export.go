@@ -0,0 +1,237 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/ingrammicro/cio/utils"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// Exporter describes existing resources as manifests, which can be applied to recreate them
+type Exporter struct {
+	*inventory
+}
+
+// NewExporter returns an exporter of the existing resources of the given kinds
+func NewExporter(kinds map[string]*Kind, labels LabelService) *Exporter {
+	return &Exporter{inventory: newInventory(kinds, labels)}
+}
+
+// Export returns the manifests of the existing resources of the given kinds, in dependency order. Given labels,
+// labelable resources are only exported if they have all of them, and the rest if any of the resources they belong
+// to, or refer to, has them: subnets along with their VPCs and DNS records along with their servers. Only the fields
+// which can be given when creating resources are exported, where references take names instead of IDs, unless
+// ambiguous
+func (e *Exporter) Export(ctx context.Context, kinds []string, labels []string) ([]*Manifest, error) {
+	log.Debug("Export")
+
+	labelIDs := make([]string, 0)
+	if err := e.loadLabels(ctx); err != nil {
+		return nil, err
+	}
+	for _, name := range labels {
+		id, ok := e.labelIDsByName[name]
+		if !ok {
+			return nil, fmt.Errorf("label %q not found", name)
+		}
+		labelIDs = append(labelIDs, id)
+	}
+
+	manifests := make([]*Manifest, 0)
+	exported := make(map[string]int)
+	for _, kindName := range KindOrder {
+		kind, ok := e.kinds[kindName]
+		if !ok || kind.Create == nil || !utils.Contains(kinds, kindName) {
+			continue
+		}
+		objects, err := e.listAll(ctx, kindName)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't list %s resources: %w", kindName, err)
+		}
+		for _, o := range objects {
+			selected, err := e.hasLabels(ctx, kindName, o, labelIDs)
+			if err != nil {
+				return nil, err
+			}
+			if !selected {
+				continue
+			}
+			m, err := e.manifest(ctx, kindName, o)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't export %s %q: %w", kindName, o.Name(), err)
+			}
+			manifests = append(manifests, m)
+
+			parentID := ""
+			if kind.Parent != "" {
+				parentID, _ = o[kind.Parent].(string)
+			}
+			key := appliedKey(kindName, parentID, kind.identity(m.Spec), m.Metadata.Name)
+			if exported[key]++; exported[key] == 2 {
+				log.Warnf("Several %s resources are named %q: rename them before applying their manifests", kindName, o.Name())
+			}
+		}
+	}
+	return manifests, nil
+}
+
+// listAll returns the existing resources of the kind, those of every parent for kinds with parent
+func (e *Exporter) listAll(ctx context.Context, kind string) ([]Object, error) {
+	parentField := e.kinds[kind].Parent
+	if parentField == "" {
+		return e.list(ctx, kind, "")
+	}
+	parents, err := e.list(ctx, e.kinds[kind].References[parentField], "")
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]Object, 0)
+	for _, parent := range parents {
+		children, err := e.list(ctx, kind, parent.ID())
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, children...)
+	}
+	return objects, nil
+}
+
+// hasLabels tells whether the resource has all the labels or, if not labelable, whether any resource it refers to has
+func (e *Exporter) hasLabels(ctx context.Context, kind string, o Object, labelIDs []string) (bool, error) {
+	if len(labelIDs) == 0 {
+		return true, nil
+	}
+	if e.kinds[kind].ResourceType != "" {
+		for _, id := range labelIDs {
+			if !utils.Contains(o.LabelIDs(), id) {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+
+	for field, refKind := range e.kinds[kind].References {
+		ref, err := e.referred(ctx, refKind, o, field)
+		if err != nil {
+			return false, err
+		}
+		if ref == nil {
+			continue
+		}
+		selected, err := e.hasLabels(ctx, refKind, ref, labelIDs)
+		if err != nil || selected {
+			return selected, err
+		}
+	}
+	return false, nil
+}
+
+// referred returns the resource of the kind the field of the resource refers to, if any
+func (e *Exporter) referred(ctx context.Context, kind string, o Object, field string) (Object, error) {
+	id, _ := o[field].(string)
+	if id == "" {
+		return nil, nil
+	}
+	candidates, err := e.candidates(ctx, kind, o)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range candidates {
+		if c.ID() == id {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+// candidates returns the resources of the kind a resource can refer to: those with the same parent as it for kinds
+// with parent
+func (e *Exporter) candidates(ctx context.Context, kind string, o Object) ([]Object, error) {
+	parentID := ""
+	if parent := e.kinds[kind].Parent; parent != "" {
+		if parentID, _ = o[parent].(string); parentID == "" {
+			return nil, nil
+		}
+	}
+	return e.list(ctx, kind, parentID)
+}
+
+// manifest returns the manifest describing the resource
+func (e *Exporter) manifest(ctx context.Context, kindName string, o Object) (*Manifest, error) {
+	kind := e.kinds[kindName]
+	m := &Manifest{Kind: kindName, Metadata: Metadata{Name: o.Name()}, Spec: make(map[string]interface{})}
+	if kind.ResourceType != "" && len(o.LabelIDs()) > 0 {
+		m.Metadata.Labels = e.labelNames(o.LabelIDs())
+	}
+
+	for _, field := range kind.Fields {
+		value := o[field]
+		if isEmpty(value) {
+			continue
+		}
+		if refKind, ok := kind.References[field]; ok {
+			name, err := e.referenceName(ctx, refKind, o, field)
+			if err != nil {
+				return nil, err
+			}
+			if name != "" {
+				value = name
+			}
+		}
+		m.Spec[field] = value
+	}
+	return m, nil
+}
+
+// referenceName returns the name of the resource referred by the field, or empty if it is not found or its name is
+// shared with another resource which could be referred, or looks like an ID
+func (e *Exporter) referenceName(ctx context.Context, kind string, o Object, field string) (string, error) {
+	ref, err := e.referred(ctx, kind, o, field)
+	if err != nil || ref == nil || ref.Name() == "" || idRegexp.MatchString(ref.Name()) {
+		return "", err
+	}
+	candidates, err := e.candidates(ctx, kind, o)
+	if err != nil {
+		return "", err
+	}
+	for _, c := range candidates {
+		if c.Name() == ref.Name() && c.ID() != ref.ID() {
+			return "", nil
+		}
+	}
+	return ref.Name(), nil
+}
+
+// isEmpty tells whether a value decoded from JSON is unset: null, zero or empty
+func isEmpty(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+	return v.IsZero()
+}
+
+// Encode writes the manifests as a multi-document YAML stream, which is empty if there are none
+func Encode(w io.Writer, manifests []*Manifest) error {
+	if len(manifests) == 0 {
+		return nil
+	}
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	for _, m := range manifests {
+		if err := encoder.Encode(m); err != nil {
+			return err
+		}
+	}
+	return encoder.Close()
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package manifest
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ingrammicro/cio/api/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testExportAPI() *fakeAPI {
+	api := newFakeAPI()
+	api.labels = []*types.Label{{ID: "l1", Name: "prod"}, {ID: "l2", Name: "web"}}
+	ssh := api.add(KindSSHProfile, Object{"name": "web", "public_key": "ssh-rsa A", "private_key": "secret"})
+	main := api.add(KindVPC, Object{"name": "main", "cidr": "10.0.0.0/16", "state": "available",
+		"label_ids": []interface{}{"l1"}})
+	other := api.add(KindVPC, Object{"name": "other", "cidr": "10.1.0.0/16"})
+	front := api.add(KindSubnet, Object{"name": "front", "cidr": "10.0.1.0/24", "vpc_id": main.ID()})
+	api.add(KindSubnet, Object{"name": "front", "cidr": "10.1.1.0/24", "vpc_id": other.ID()})
+	api.add(KindServer, Object{
+		"name":           "web-1",
+		"state":          "operational",
+		"public_ip":      "",
+		"ssh_profile_id": ssh.ID(),
+		"vpc_id":         main.ID(),
+		"subnet_id":      front.ID(),
+		"server_plan_id": "5f0a1b2c3d4e5f6a7b8c9d0e",
+		"label_ids":      []interface{}{"l1", "l2"},
+	})
+	api.add(KindServer, Object{"name": "db-1", "label_ids": []interface{}{"l2"}})
+	return api
+}
+
+func TestExport(t *testing.T) {
+	assert := assert.New(t)
+
+	api := testExportAPI()
+	manifests, err := NewExporter(api.kinds(), api).Export(context.Background(), KindOrder, []string{"prod"})
+	assert.Nil(err, "Resources should be exported")
+	if assert.Len(manifests, 3, "Only resources with the labels, and those belonging to them, should be exported") {
+		assert.Equal(&Manifest{
+			Kind:     KindVPC,
+			Metadata: Metadata{Name: "main", Labels: []string{"prod"}},
+			Spec:     map[string]interface{}{"cidr": "10.0.0.0/16"},
+		}, manifests[0], "Read-only fields should be stripped")
+		assert.Equal(&Manifest{
+			Kind:     KindSubnet,
+			Metadata: Metadata{Name: "front"},
+			Spec:     map[string]interface{}{"vpc_id": "main", "cidr": "10.0.1.0/24"},
+		}, manifests[1], "Parents should be referred by name")
+		assert.Equal(&Manifest{
+			Kind:     KindServer,
+			Metadata: Metadata{Name: "web-1", Labels: []string{"prod", "web"}},
+			Spec: map[string]interface{}{
+				"ssh_profile_id": "web",
+				"vpc_id":         "main",
+				"subnet_id":      "front",
+				"server_plan_id": "5f0a1b2c3d4e5f6a7b8c9d0e",
+			},
+		}, manifests[2], "References should be rewritten to names")
+	}
+
+	manifests, err = NewExporter(api.kinds(), api).Export(context.Background(), []string{KindSSHProfile}, nil)
+	assert.Nil(err)
+	if assert.Len(manifests, 1, "Only resources of the given kinds should be exported") {
+		assert.Equal(map[string]interface{}{"public_key": "ssh-rsa A"}, manifests[0].Spec, "Secrets should be stripped")
+	}
+
+	_, err = NewExporter(api.kinds(), api).Export(context.Background(), KindOrder, []string{"nope"})
+	assert.NotNil(err, "Unknown labels should fail")
+}
+
+func TestExportAmbiguousReferences(t *testing.T) {
+	assert := assert.New(t)
+
+	api := testExportAPI()
+	dup := api.add(KindSSHProfile, Object{"name": "web", "public_key": "ssh-rsa B"})
+	api.objects[KindServer][0]["ssh_profile_id"] = dup.ID()
+	manifests, err := NewExporter(api.kinds(), api).Export(context.Background(), []string{KindServer}, []string{"prod"})
+	assert.Nil(err)
+	if assert.Len(manifests, 1) {
+		assert.Equal(dup.ID(), manifests[0].Spec["ssh_profile_id"], "Ambiguous references should keep IDs")
+	}
+}
+
+func TestExportReapplies(t *testing.T) {
+	assert := assert.New(t)
+
+	api := testExportAPI()
+	manifests, err := NewExporter(api.kinds(), api).Export(context.Background(), KindOrder, []string{"prod"})
+	assert.Nil(err)
+	var buf bytes.Buffer
+	assert.Nil(Encode(&buf, manifests), "Manifests should be encoded")
+	decoded, err := Decode(&buf, "export.yaml")
+	assert.Nil(err, "Exported manifests should be decoded")
+
+	results, err := NewApplier(api.kinds(), api).Plan(context.Background(), decoded)
+	assert.Nil(err, "Exported manifests should be applicable")
+	assert.False(Drifted(results), "Exported manifests should match the resources they were exported from")
+}
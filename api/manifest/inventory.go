@@ -0,0 +1,72 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package manifest
+
+import (
+	"context"
+	"fmt"
+)
+
+// inventory lists the existing resources and labels, once, as needed to apply or export manifests
+type inventory struct {
+	kinds  map[string]*Kind
+	labels LabelService
+
+	// listings of the existing resources by kind and parent
+	existing map[string][]Object
+
+	labelIDsByName map[string]string
+}
+
+func newInventory(kinds map[string]*Kind, labels LabelService) *inventory {
+	return &inventory{
+		kinds:    kinds,
+		labels:   labels,
+		existing: make(map[string][]Object),
+	}
+}
+
+// list returns the existing resources of the kind, listing them once
+func (inv *inventory) list(ctx context.Context, kind string, parentID string) ([]Object, error) {
+	listing := fmt.Sprintf("%s/%s", kind, parentID)
+	if objects, ok := inv.existing[listing]; ok {
+		return objects, nil
+	}
+	objects, err := inv.kinds[kind].List(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+	inv.existing[listing] = objects
+	return objects, nil
+}
+
+func (inv *inventory) loadLabels(ctx context.Context) error {
+	if inv.labelIDsByName != nil {
+		return nil
+	}
+	labels, err := inv.labels.ListLabelsContext(ctx)
+	if err != nil {
+		return err
+	}
+	inv.labelIDsByName = make(map[string]string)
+	for _, label := range labels {
+		inv.labelIDsByName[label.Name] = label.ID
+	}
+	return nil
+}
+
+// labelNames returns the names of the labels with the given IDs, or the IDs of those unknown
+func (inv *inventory) labelNames(ids []string) []string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		name := id
+		for n, labelID := range inv.labelIDsByName {
+			if labelID == id {
+				name = n
+				break
+			}
+		}
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,316 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ingrammicro/cio/api/blueprint"
+	"github.com/ingrammicro/cio/api/cloud"
+	"github.com/ingrammicro/cio/api/network"
+	"github.com/ingrammicro/cio/utils"
+)
+
+// Object is a resource as returned by the API, decoded as its JSON representation
+type Object map[string]interface{}
+
+// ID returns the identifier of the resource
+func (o Object) ID() string {
+	id, _ := o["id"].(string)
+	return id
+}
+
+// Name returns the name of the resource
+func (o Object) Name() string {
+	name, _ := o["name"].(string)
+	return name
+}
+
+// LabelIDs returns the identifiers of the labels of the resource
+func (o Object) LabelIDs() []string {
+	ids := make([]string, 0)
+	values, _ := o["label_ids"].([]interface{})
+	for _, v := range values {
+		if id, ok := v.(string); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// toObject converts a resource returned by a service into an Object
+func toObject(resource interface{}) (Object, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	o := Object{}
+	if err = json.Unmarshal(data, &o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// toObjects converts the resources returned by a service listing into Objects
+func toObjects(resources interface{}) ([]Object, error) {
+	data, err := json.Marshal(resources)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]Object, 0)
+	if err = json.Unmarshal(data, &objects); err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// Kind tells how the resources of a kind are listed, created and updated, and how they refer to other resources
+type Kind struct {
+	// Parent is the spec field referring to the resource those of this kind belong to, such as the VPC of subnets
+	Parent string
+	// References maps the spec fields referring to other resources, the parent one included, to their kinds
+	References map[string]string
+	// Immutable are the spec fields which can only be set on creation
+	Immutable []string
+	// Identity are the spec fields telling apart resources sharing a name, such as the type of DNS records
+	Identity []string
+	// Unordered are the spec fields holding lists whose order doesn't matter, such as the rules of firewall profiles
+	Unordered []string
+	// ResourceType is the type labels are added to, or empty if the kind is not labelable
+	ResourceType string
+	// Fields are the spec fields resources are exported with: those which can be given when creating them, but secrets
+	Fields []string
+
+	List   func(ctx context.Context, parentID string) ([]Object, error)
+	Create func(ctx context.Context, parentID string, params *map[string]interface{}) (Object, error)
+	Update func(ctx context.Context, id string, params *map[string]interface{}) (Object, error)
+	Delete func(ctx context.Context, id string) error
+}
+
+// identity returns the values of the identity fields of a spec or resource, which along with its name tell it apart
+func (k *Kind) identity(fields map[string]interface{}) string {
+	values := make([]string, 0, len(k.Identity))
+	for _, field := range k.Identity {
+		if v, ok := fields[field]; ok && v != nil {
+			values = append(values, fmt.Sprint(v))
+		} else {
+			values = append(values, "")
+		}
+	}
+	return strings.Join(values, ",")
+}
+
+// NewKinds returns the kinds of resources manifests can describe, managed through the given service
+func NewKinds(concertoService utils.ConcertoService) (map[string]*Kind, error) {
+	sshProfileSvc, err := cloud.NewSSHProfileService(concertoService)
+	if err != nil {
+		return nil, err
+	}
+	firewallProfileSvc, err := network.NewFirewallProfileService(concertoService)
+	if err != nil {
+		return nil, err
+	}
+	templateSvc, err := blueprint.NewTemplateService(concertoService)
+	if err != nil {
+		return nil, err
+	}
+	vpcSvc, err := network.NewVPCService(concertoService)
+	if err != nil {
+		return nil, err
+	}
+	subnetSvc, err := network.NewSubnetService(concertoService)
+	if err != nil {
+		return nil, err
+	}
+	serverSvc, err := cloud.NewServerService(concertoService)
+	if err != nil {
+		return nil, err
+	}
+	domainSvc, err := network.NewDomainService(concertoService)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]*Kind{
+		KindSSHProfile: {
+			ResourceType: "ssh_profile",
+			Fields:       []string{"public_key"},
+			List: func(ctx context.Context, _ string) ([]Object, error) {
+				return listObjects(sshProfileSvc.ListSSHProfilesContext(ctx))
+			},
+			Create: func(ctx context.Context, _ string, params *map[string]interface{}) (Object, error) {
+				return object(sshProfileSvc.CreateSSHProfileContext(ctx, params))
+			},
+			Update: func(ctx context.Context, id string, params *map[string]interface{}) (Object, error) {
+				return object(sshProfileSvc.UpdateSSHProfileContext(ctx, id, params))
+			},
+			Delete: func(ctx context.Context, id string) error {
+				return sshProfileSvc.DeleteSSHProfileContext(ctx, id)
+			},
+		},
+		KindFirewallProfile: {
+			ResourceType: "firewall_profile",
+			Unordered:    []string{"rules"},
+			Fields:       []string{"description", "rules"},
+			List: func(ctx context.Context, _ string) ([]Object, error) {
+				return listObjects(firewallProfileSvc.ListFirewallProfilesContext(ctx))
+			},
+			Create: func(ctx context.Context, _ string, params *map[string]interface{}) (Object, error) {
+				return object(firewallProfileSvc.CreateFirewallProfileContext(ctx, params))
+			},
+			Update: func(ctx context.Context, id string, params *map[string]interface{}) (Object, error) {
+				return object(firewallProfileSvc.UpdateFirewallProfileContext(ctx, id, params))
+			},
+			Delete: func(ctx context.Context, id string) error {
+				return firewallProfileSvc.DeleteFirewallProfileContext(ctx, id)
+			},
+		},
+		KindTemplate: {
+			Immutable:    []string{"generic_image_id"},
+			ResourceType: "template",
+			Fields:       []string{"generic_image_id", "run_list", "configuration_attributes", "cookbook_versions"},
+			List: func(ctx context.Context, _ string) ([]Object, error) {
+				return listObjects(templateSvc.ListTemplatesContext(ctx))
+			},
+			Create: func(ctx context.Context, _ string, params *map[string]interface{}) (Object, error) {
+				return object(templateSvc.CreateTemplateContext(ctx, params))
+			},
+			Update: func(ctx context.Context, id string, params *map[string]interface{}) (Object, error) {
+				return object(templateSvc.UpdateTemplateContext(ctx, id, params))
+			},
+			Delete: func(ctx context.Context, id string) error {
+				return templateSvc.DeleteTemplateContext(ctx, id)
+			},
+		},
+		KindVPC: {
+			Immutable:    []string{"cidr", "cloud_account_id", "realm_provider_name"},
+			ResourceType: "vpc",
+			Fields:       []string{"cidr", "cloud_account_id", "realm_provider_name"},
+			List: func(ctx context.Context, _ string) ([]Object, error) {
+				return listObjects(vpcSvc.ListVPCsContext(ctx))
+			},
+			Create: func(ctx context.Context, _ string, params *map[string]interface{}) (Object, error) {
+				return object(vpcSvc.CreateVPCContext(ctx, params))
+			},
+			Update: func(ctx context.Context, id string, params *map[string]interface{}) (Object, error) {
+				return object(vpcSvc.UpdateVPCContext(ctx, id, params))
+			},
+			Delete: func(ctx context.Context, id string) error {
+				return vpcSvc.DeleteVPCContext(ctx, id)
+			},
+		},
+		KindSubnet: {
+			Parent:     "vpc_id",
+			References: map[string]string{"vpc_id": KindVPC},
+			Immutable:  []string{"cidr", "type"},
+			Fields:     []string{"vpc_id", "cidr", "type"},
+			List: func(ctx context.Context, vpcID string) ([]Object, error) {
+				return listObjects(subnetSvc.ListSubnetsContext(ctx, vpcID))
+			},
+			Create: func(ctx context.Context, vpcID string, params *map[string]interface{}) (Object, error) {
+				return object(subnetSvc.CreateSubnetContext(ctx, vpcID, params))
+			},
+			Update: func(ctx context.Context, id string, params *map[string]interface{}) (Object, error) {
+				return object(subnetSvc.UpdateSubnetContext(ctx, id, params))
+			},
+			Delete: func(ctx context.Context, id string) error {
+				return subnetSvc.DeleteSubnetContext(ctx, id)
+			},
+		},
+		KindServer: {
+			References: map[string]string{
+				"ssh_profile_id":      KindSSHProfile,
+				"firewall_profile_id": KindFirewallProfile,
+				"template_id":         KindTemplate,
+				"vpc_id":              KindVPC,
+				"subnet_id":           KindSubnet,
+			},
+			Immutable: []string{
+				"ssh_profile_id",
+				"firewall_profile_id",
+				"template_id",
+				"server_plan_id",
+				"cloud_account_id",
+				"vpc_id",
+				"subnet_id",
+			},
+			ResourceType: "server",
+			Fields: []string{
+				"template_id",
+				"server_plan_id",
+				"cloud_account_id",
+				"ssh_profile_id",
+				"firewall_profile_id",
+				"vpc_id",
+				"subnet_id",
+			},
+			List: func(ctx context.Context, _ string) ([]Object, error) {
+				return listObjects(serverSvc.ListServersContext(ctx))
+			},
+			Create: func(ctx context.Context, _ string, params *map[string]interface{}) (Object, error) {
+				return object(serverSvc.CreateServerContext(ctx, params))
+			},
+			Update: func(ctx context.Context, id string, params *map[string]interface{}) (Object, error) {
+				return object(serverSvc.UpdateServerContext(ctx, id, params))
+			},
+			Delete: func(ctx context.Context, id string) error {
+				return serverSvc.DeleteServerContext(ctx, id)
+			},
+		},
+		KindDNSDomain: {
+			List: func(ctx context.Context, _ string) ([]Object, error) {
+				return listObjects(domainSvc.ListDomainsContext(ctx))
+			},
+		},
+		KindDNSRecord: {
+			Parent:     "domain_id",
+			References: map[string]string{"domain_id": KindDNSDomain, "instance_id": KindServer},
+			Immutable:  []string{"type"},
+			Identity:   []string{"type"},
+			Fields: []string{
+				"domain_id",
+				"type",
+				"content",
+				"ttl",
+				"instance_id",
+				"floating_ip_id",
+				"load_balancer_id",
+				"priority",
+				"weight",
+				"port",
+			},
+			List: func(ctx context.Context, domainID string) ([]Object, error) {
+				return listObjects(domainSvc.ListRecordsContext(ctx, domainID))
+			},
+			Create: func(ctx context.Context, domainID string, params *map[string]interface{}) (Object, error) {
+				return object(domainSvc.CreateRecordContext(ctx, domainID, params))
+			},
+			Update: func(ctx context.Context, id string, params *map[string]interface{}) (Object, error) {
+				return object(domainSvc.UpdateRecordContext(ctx, id, params))
+			},
+			Delete: func(ctx context.Context, id string) error {
+				_, err := domainSvc.DeleteRecordContext(ctx, id)
+				return err
+			},
+		},
+	}, nil
+}
+
+// object converts the result of a service call returning a resource
+func object(resource interface{}, err error) (Object, error) {
+	if err != nil {
+		return nil, err
+	}
+	return toObject(resource)
+}
+
+// listObjects converts the result of a service call listing resources
+func listObjects(resources interface{}, err error) ([]Object, error) {
+	if err != nil {
+		return nil, err
+	}
+	return toObjects(resources)
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Kinds of the resources described by manifests, in dependency order: resources only refer to resources of the kinds
+// preceding theirs
+const (
+	KindSSHProfile      = "SSHProfile"
+	KindFirewallProfile = "FirewallProfile"
+	KindTemplate        = "Template"
+	KindVPC             = "VPC"
+	KindSubnet          = "Subnet"
+	KindServer          = "Server"
+	KindDNSDomain       = "DNSDomain"
+	KindDNSRecord       = "DNSRecord"
+)
+
+// KindOrder lists the kinds in the order they are applied
+var KindOrder = []string{
+	KindSSHProfile,
+	KindFirewallProfile,
+	KindTemplate,
+	KindVPC,
+	KindSubnet,
+	KindServer,
+	KindDNSDomain,
+	KindDNSRecord,
+}
+
+// Manifest describes a resource as it should be. Spec holds the parameters of the API creating and updating it, where
+// the fields referring to other resources take either their IDs or their names
+type Manifest struct {
+	Kind     string                 `yaml:"kind"           json:"kind"`
+	Metadata Metadata               `yaml:"metadata"       json:"metadata"`
+	Spec     map[string]interface{} `yaml:"spec,omitempty" json:"spec,omitempty"`
+
+	// Source tells where the manifest was read from, for error messages
+	Source string `yaml:"-" json:"-"`
+}
+
+// Metadata identifies the resource described by a manifest. Resources are matched by name, or by label if MatchLabel
+// is given, so that they can be renamed
+type Metadata struct {
+	Name       string   `yaml:"name"                  json:"name"`
+	Labels     []string `yaml:"labels,omitempty"      json:"labels,omitempty"`
+	MatchLabel string   `yaml:"match_label,omitempty" json:"match_label,omitempty"`
+}
+
+func (m *Manifest) String() string {
+	return fmt.Sprintf("%s %q", m.Kind, m.Metadata.Name)
+}
+
+// Decode reads the manifests of a multi-document YAML stream. Empty documents are skipped
+func Decode(r io.Reader, source string) ([]*Manifest, error) {
+	manifests := make([]*Manifest, 0)
+	decoder := yaml.NewDecoder(r)
+	for doc := 1; ; doc++ {
+		m := new(Manifest)
+		err := decoder.Decode(m)
+		if errors.Is(err, io.EOF) {
+			return manifests, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s, document %d: %v", source, doc, err)
+		}
+		if m.Kind == "" && m.Metadata.Name == "" && len(m.Spec) == 0 {
+			continue
+		}
+		m.Source = fmt.Sprintf("%s, document %d", source, doc)
+		manifests = append(manifests, m)
+	}
+}
+
+// Sort orders the manifests by kind, as given by KindOrder, keeping the order they were given in within each kind
+func Sort(manifests []*Manifest) {
+	rank := make(map[string]int)
+	for i, kind := range KindOrder {
+		rank[kind] = i
+	}
+	sort.SliceStable(manifests, func(i, j int) bool {
+		return rank[manifests[i].Kind] < rank[manifests[j].Kind]
+	})
+}
+
+// Validate checks that manifests describe resources of the given kinds, which can be applied, that they are named
+// and that no resource is described twice
+func Validate(manifests []*Manifest, kinds map[string]*Kind) error {
+	seen := make(map[string]string)
+	for _, m := range manifests {
+		kind, ok := kinds[m.Kind]
+		if !ok {
+			return fmt.Errorf("%s: unknown kind %q", m.Source, m.Kind)
+		}
+		if kind.Create == nil {
+			return fmt.Errorf("%s: %s resources can only be referred, not applied", m.Source, m.Kind)
+		}
+		if m.Metadata.Name == "" {
+			return fmt.Errorf("%s: metadata.name is missing", m.Source)
+		}
+		if (len(m.Metadata.Labels) > 0 || m.Metadata.MatchLabel != "") && kind.ResourceType == "" {
+			return fmt.Errorf("%s: %s resources can't be labelled", m.Source, m.Kind)
+		}
+		if _, ok := m.Spec["name"]; ok {
+			return fmt.Errorf("%s: the name is given by metadata.name, not by spec.name", m.Source)
+		}
+
+		parent := ""
+		if kind.Parent != "" {
+			value, ok := m.Spec[kind.Parent].(string)
+			if !ok || value == "" {
+				return fmt.Errorf("%s: spec.%s is missing", m.Source, kind.Parent)
+			}
+			parent = value
+		}
+		key := appliedKey(m.Kind, parent, kind.identity(m.Spec), m.Metadata.Name)
+		if source, ok := seen[key]; ok {
+			return fmt.Errorf("%s: %s is already described in %s", m.Source, m, source)
+		}
+		seen[key] = m.Source
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package manifest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testManifests = `kind: Server
+metadata:
+  name: web-1
+  labels: [web]
+spec:
+  template_id: web
+  server_plan_id: 5f0a1b2c3d4e5f6a7b8c9d0e
+---
+# comments and empty documents are skipped
+---
+kind: SSHProfile
+metadata:
+  name: web
+spec:
+  public_key: ssh-rsa AAAA
+---
+kind: Template
+metadata:
+  name: web
+spec:
+  run_list: ["recipe[nginx]"]
+`
+
+func TestDecode(t *testing.T) {
+	assert := assert.New(t)
+
+	manifests, err := Decode(strings.NewReader(testManifests), "web.yaml")
+	assert.Nil(err, "Manifests should be decoded")
+	assert.Len(manifests, 3, "Empty documents should be skipped")
+
+	assert.Equal(KindServer, manifests[0].Kind)
+	assert.Equal("web-1", manifests[0].Metadata.Name)
+	assert.Equal([]string{"web"}, manifests[0].Metadata.Labels)
+	assert.Equal("web", manifests[0].Spec["template_id"])
+	assert.Equal("web.yaml, document 1", manifests[0].Source)
+	assert.Equal("web.yaml, document 4", manifests[2].Source)
+	assert.Equal([]interface{}{"recipe[nginx]"}, manifests[2].Spec["run_list"])
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := Decode(strings.NewReader("kind: Server\n---\nkind: [\n"), "bad.yaml")
+	assert.NotNil(err, "Invalid YAML should fail")
+	assert.Contains(err.Error(), "bad.yaml, document 2", "Errors should tell the failing document")
+}
+
+func TestSort(t *testing.T) {
+	assert := assert.New(t)
+
+	manifests, err := Decode(strings.NewReader(testManifests), "web.yaml")
+	assert.Nil(err)
+	manifests = append(manifests, &Manifest{Kind: KindSSHProfile, Metadata: Metadata{Name: "db"}})
+
+	Sort(manifests)
+	kinds := make([]string, 0)
+	names := make([]string, 0)
+	for _, m := range manifests {
+		kinds = append(kinds, m.Kind)
+		names = append(names, m.Metadata.Name)
+	}
+	assert.Equal([]string{KindSSHProfile, KindSSHProfile, KindTemplate, KindServer}, kinds, "Kinds should be sorted")
+	assert.Equal([]string{"web", "db", "web", "web-1"}, names, "Order within kinds should be kept")
+}
+
+func TestValidate(t *testing.T) {
+	kinds := map[string]*Kind{
+		KindVPC:       {ResourceType: "vpc", Create: createFake},
+		KindSubnet:    {Parent: "vpc_id", Create: createFake},
+		KindDNSDomain: {},
+		KindDNSRecord: {Parent: "domain_id", Identity: []string{"type"}, Create: createFake},
+	}
+
+	tests := map[string]struct {
+		manifests []*Manifest
+		err       string
+	}{
+		"valid": {
+			manifests: []*Manifest{
+				{Kind: KindVPC, Metadata: Metadata{Name: "main", Labels: []string{"prod"}}},
+				{Kind: KindSubnet, Metadata: Metadata{Name: "a"}, Spec: map[string]interface{}{"vpc_id": "main"}},
+				{Kind: KindSubnet, Metadata: Metadata{Name: "a"}, Spec: map[string]interface{}{"vpc_id": "other"}},
+				{Kind: KindDNSRecord, Metadata: Metadata{Name: "www"}, Spec: map[string]interface{}{
+					"domain_id": "example.com", "type": "A",
+				}},
+				{Kind: KindDNSRecord, Metadata: Metadata{Name: "www"}, Spec: map[string]interface{}{
+					"domain_id": "example.com", "type": "TXT",
+				}},
+			},
+		},
+		"unknown kind": {
+			manifests: []*Manifest{{Kind: "Volume", Metadata: Metadata{Name: "data"}}},
+			err:       `unknown kind "Volume"`,
+		},
+		"not applicable": {
+			manifests: []*Manifest{{Kind: KindDNSDomain, Metadata: Metadata{Name: "example.com"}}},
+			err:       "can only be referred",
+		},
+		"unnamed": {
+			manifests: []*Manifest{{Kind: KindVPC}},
+			err:       "metadata.name is missing",
+		},
+		"spec name": {
+			manifests: []*Manifest{{Kind: KindVPC, Metadata: Metadata{Name: "a"}, Spec: map[string]interface{}{"name": "b"}}},
+			err:       "not by spec.name",
+		},
+		"unlabelable": {
+			manifests: []*Manifest{
+				{Kind: KindSubnet, Metadata: Metadata{Name: "a", MatchLabel: "a"}, Spec: map[string]interface{}{"vpc_id": "main"}},
+			},
+			err: "can't be labelled",
+		},
+		"missing parent": {
+			manifests: []*Manifest{{Kind: KindSubnet, Metadata: Metadata{Name: "a"}}},
+			err:       "spec.vpc_id is missing",
+		},
+		"duplicated": {
+			manifests: []*Manifest{
+				{Kind: KindVPC, Metadata: Metadata{Name: "main"}, Source: "a.yaml, document 1"},
+				{Kind: KindVPC, Metadata: Metadata{Name: "main"}, Source: "b.yaml, document 1"},
+			},
+			err: `b.yaml, document 1: VPC "main" is already described in a.yaml, document 1`,
+		},
+	}
+	for name, test := range tests {
+		err := Validate(test.manifests, kinds)
+		if test.err == "" {
+			assert.Nil(t, err, name)
+		} else if assert.NotNil(t, err, name) {
+			assert.Contains(t, err.Error(), test.err, name)
+		}
+	}
+}
@@ -3,6 +3,7 @@
 package network
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -32,33 +33,81 @@ func NewCertificateService(concertoService utils.ConcertoService) (*CertificateS
 
 // ListCertificates returns the list of certificates in a load balancer by its ID, as an array of Certificate
 func (cs *CertificateService) ListCertificates(loadBalancerID string) (certificates []*types.Certificate, err error) {
-	log.Debug("ListCertificates")
+	return cs.ListCertificatesContext(context.Background(), loadBalancerID)
+}
 
-	data, status, err := cs.concertoService.Get(fmt.Sprintf(APIPathNetworkLoadBalancerCertificates, loadBalancerID))
+// ListCertificatesContext is like ListCertificates, but the request is cancelled as soon as ctx is done
+func (cs *CertificateService) ListCertificatesContext(
+	ctx context.Context,
+	loadBalancerID string,
+) (certificates []*types.Certificate, err error) {
+	log.Debug("ListCertificates")
 
+	certificates = []*types.Certificate{}
+	err = cs.ListCertificatesPagesContext(ctx, loadBalancerID, func(page []*types.Certificate) bool {
+		certificates = append(certificates, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return certificates, nil
+}
 
-	if err = json.Unmarshal(data, &certificates); err != nil {
-		return nil, err
-	}
+// ListCertificatesPages is like ListCertificates, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (cs *CertificateService) ListCertificatesPages(
+	loadBalancerID string,
+	fn func(certificates []*types.Certificate) bool,
+) error {
+	return cs.ListCertificatesPagesContext(context.Background(), loadBalancerID, fn)
+}
 
-	return certificates, nil
+// ListCertificatesPagesContext is like ListCertificatesPages, but the requests are cancelled as soon as ctx is done.
+// The page options carried by ctx are applied
+func (cs *CertificateService) ListCertificatesPagesContext(
+	ctx context.Context,
+	loadBalancerID string,
+	fn func(certificates []*types.Certificate) bool,
+) error {
+	log.Debug("ListCertificatesPages")
+
+	pager := utils.NewPager(
+		ctx,
+		cs.concertoService,
+		fmt.Sprintf(APIPathNetworkLoadBalancerCertificates, loadBalancerID),
+	)
+	for pager.Next() {
+		var certificates []*types.Certificate
+		if err := pager.Decode(&certificates); err != nil {
+			return err
+		}
+		if !fn(certificates) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetCertificate returns a certificate by its ID
 func (cs *CertificateService) GetCertificate(
 	loadBalancerID string,
 	certificateID string,
+) (certificate *types.Certificate, err error) {
+	return cs.GetCertificateContext(context.Background(), loadBalancerID, certificateID)
+}
+
+// GetCertificateContext is like GetCertificate, but the request is cancelled as soon as ctx is done
+func (cs *CertificateService) GetCertificateContext(
+	ctx context.Context,
+	loadBalancerID string,
+	certificateID string,
 ) (certificate *types.Certificate, err error) {
 	log.Debug("GetCertificate")
 
-	data, status, err := cs.concertoService.Get(
+	data, status, err := cs.concertoService.GetContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkLoadBalancerCertificate, loadBalancerID, certificateID),
 	)
 	if err != nil {
@@ -80,10 +129,20 @@ func (cs *CertificateService) GetCertificate(
 func (cs *CertificateService) CreateCertificate(
 	loadBalancerID string,
 	certificateParams *map[string]interface{},
+) (certificate *types.Certificate, err error) {
+	return cs.CreateCertificateContext(context.Background(), loadBalancerID, certificateParams)
+}
+
+// CreateCertificateContext is like CreateCertificate, but the request is cancelled as soon as ctx is done
+func (cs *CertificateService) CreateCertificateContext(
+	ctx context.Context,
+	loadBalancerID string,
+	certificateParams *map[string]interface{},
 ) (certificate *types.Certificate, err error) {
 	log.Debug("CreateCertificate")
 
-	data, status, err := cs.concertoService.Post(
+	data, status, err := cs.concertoService.PostContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkLoadBalancerCertificates, loadBalancerID),
 		certificateParams,
 	)
@@ -107,10 +166,21 @@ func (cs *CertificateService) UpdateCertificate(
 	loadBalancerID string,
 	certificateID string,
 	certificateParams *map[string]interface{},
+) (certificate *types.Certificate, err error) {
+	return cs.UpdateCertificateContext(context.Background(), loadBalancerID, certificateID, certificateParams)
+}
+
+// UpdateCertificateContext is like UpdateCertificate, but the request is cancelled as soon as ctx is done
+func (cs *CertificateService) UpdateCertificateContext(
+	ctx context.Context,
+	loadBalancerID string,
+	certificateID string,
+	certificateParams *map[string]interface{},
 ) (certificate *types.Certificate, err error) {
 	log.Debug("UpdateCertificate")
 
-	data, status, err := cs.concertoService.Put(
+	data, status, err := cs.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkLoadBalancerCertificate, loadBalancerID, certificateID),
 		certificateParams,
 	)
@@ -131,9 +201,19 @@ func (cs *CertificateService) UpdateCertificate(
 
 // DeleteCertificate deletes a certificate by its ID
 func (cs *CertificateService) DeleteCertificate(loadBalancerID string, certificateID string) (err error) {
+	return cs.DeleteCertificateContext(context.Background(), loadBalancerID, certificateID)
+}
+
+// DeleteCertificateContext is like DeleteCertificate, but the request is cancelled as soon as ctx is done
+func (cs *CertificateService) DeleteCertificateContext(
+	ctx context.Context,
+	loadBalancerID string,
+	certificateID string,
+) (err error) {
 	log.Debug("DeleteCertificate")
 
-	data, status, err := cs.concertoService.Delete(
+	data, status, err := cs.concertoService.DeleteContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkLoadBalancerCertificate, loadBalancerID, certificateID),
 	)
 	if err != nil {
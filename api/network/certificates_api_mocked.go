@@ -32,7 +32,11 @@ func ListCertificatesMocked(
 	assert.Nil(err, "Certificates test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkLoadBalancerCertificates, loadBalancerID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkLoadBalancerCertificates, loadBalancerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	certificatesOut, err := ds.ListCertificates(loadBalancerID)
 
 	assert.Nil(err, "Error getting certificates")
@@ -61,8 +65,11 @@ func ListCertificatesFailErrMocked(
 	assert.Nil(err, "Certificates test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkLoadBalancerCertificates, loadBalancerID)).
-		Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkLoadBalancerCertificates, loadBalancerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, fmt.Errorf("mocked error"))
 	certificatesOut, err := ds.ListCertificates(loadBalancerID)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -92,7 +99,11 @@ func ListCertificatesFailStatusMocked(
 	assert.Nil(err, "Certificates test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkLoadBalancerCertificates, loadBalancerID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkLoadBalancerCertificates, loadBalancerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 499, nil)
 	certificatesOut, err := ds.ListCertificates(loadBalancerID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -121,7 +132,11 @@ func ListCertificatesFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkLoadBalancerCertificates, loadBalancerID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkLoadBalancerCertificates, loadBalancerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	certificatesOut, err := ds.ListCertificates(loadBalancerID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
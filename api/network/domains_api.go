@@ -3,6 +3,7 @@
 package network
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -36,30 +37,58 @@ func NewDomainService(concertoService utils.ConcertoService) (*DomainService, er
 
 // ListDomains returns the list of domains as an array of Domain
 func (ds *DomainService) ListDomains() (domains []*types.Domain, err error) {
-	log.Debug("ListDomains")
+	return ds.ListDomainsContext(context.Background())
+}
 
-	data, status, err := ds.concertoService.Get(APIPathNetworkDnsDomains)
+// ListDomainsContext is like ListDomains, but the request is cancelled as soon as ctx is done
+func (ds *DomainService) ListDomainsContext(ctx context.Context) (domains []*types.Domain, err error) {
+	log.Debug("ListDomains")
 
+	domains = []*types.Domain{}
+	err = ds.ListDomainsPagesContext(ctx, func(page []*types.Domain) bool {
+		domains = append(domains, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return domains, nil
+}
 
-	if err = json.Unmarshal(data, &domains); err != nil {
-		return nil, err
-	}
+// ListDomainsPages is like ListDomains, but the list is streamed to fn one page at a time, until fn returns false
+func (ds *DomainService) ListDomainsPages(fn func(domains []*types.Domain) bool) error {
+	return ds.ListDomainsPagesContext(context.Background(), fn)
+}
 
-	return domains, nil
+// ListDomainsPagesContext is like ListDomainsPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (ds *DomainService) ListDomainsPagesContext(ctx context.Context, fn func(domains []*types.Domain) bool) error {
+	log.Debug("ListDomainsPages")
+
+	pager := utils.NewPager(ctx, ds.concertoService, APIPathNetworkDnsDomains)
+	for pager.Next() {
+		var domains []*types.Domain
+		if err := pager.Decode(&domains); err != nil {
+			return err
+		}
+		if !fn(domains) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetDomain returns a domain by its ID
 func (ds *DomainService) GetDomain(domainID string) (domain *types.Domain, err error) {
+	return ds.GetDomainContext(context.Background(), domainID)
+}
+
+// GetDomainContext is like GetDomain, but the request is cancelled as soon as ctx is done
+func (ds *DomainService) GetDomainContext(ctx context.Context, domainID string) (domain *types.Domain, err error) {
 	log.Debug("GetDomain")
 
-	data, status, err := ds.concertoService.Get(fmt.Sprintf(APIPathNetworkDnsDomain, domainID))
+	data, status, err := ds.concertoService.GetContext(ctx, fmt.Sprintf(APIPathNetworkDnsDomain, domainID))
 	if err != nil {
 		return nil, err
 	}
@@ -77,9 +106,17 @@ func (ds *DomainService) GetDomain(domainID string) (domain *types.Domain, err e
 
 // CreateDomain creates a domain
 func (ds *DomainService) CreateDomain(domainParams *map[string]interface{}) (domain *types.Domain, err error) {
+	return ds.CreateDomainContext(context.Background(), domainParams)
+}
+
+// CreateDomainContext is like CreateDomain, but the request is cancelled as soon as ctx is done
+func (ds *DomainService) CreateDomainContext(
+	ctx context.Context,
+	domainParams *map[string]interface{},
+) (domain *types.Domain, err error) {
 	log.Debug("CreateDomain")
 
-	data, status, err := ds.concertoService.Post(APIPathNetworkDnsDomains, domainParams)
+	data, status, err := ds.concertoService.PostContext(ctx, APIPathNetworkDnsDomains, domainParams)
 	if err != nil {
 		return nil, err
 	}
@@ -97,9 +134,14 @@ func (ds *DomainService) CreateDomain(domainParams *map[string]interface{}) (dom
 
 // DeleteDomain deletes a domain by its ID
 func (ds *DomainService) DeleteDomain(domainID string) (domain *types.Domain, err error) {
+	return ds.DeleteDomainContext(context.Background(), domainID)
+}
+
+// DeleteDomainContext is like DeleteDomain, but the request is cancelled as soon as ctx is done
+func (ds *DomainService) DeleteDomainContext(ctx context.Context, domainID string) (domain *types.Domain, err error) {
 	log.Debug("DeleteDomain")
 
-	data, status, err := ds.concertoService.Delete(fmt.Sprintf(APIPathNetworkDnsDomain, domainID))
+	data, status, err := ds.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathNetworkDnsDomain, domainID))
 	if err != nil {
 		return nil, err
 	}
@@ -117,10 +159,19 @@ func (ds *DomainService) DeleteDomain(domainID string) (domain *types.Domain, er
 
 // RetryDomain retries a domain by its ID
 func (ds *DomainService) RetryDomain(domainID string) (domain *types.Domain, err error) {
+	return ds.RetryDomainContext(context.Background(), domainID)
+}
+
+// RetryDomainContext is like RetryDomain, but the request is cancelled as soon as ctx is done
+func (ds *DomainService) RetryDomainContext(ctx context.Context, domainID string) (domain *types.Domain, err error) {
 	log.Debug("RetryDomain")
 
 	domainParams := new(map[string]interface{})
-	data, status, err := ds.concertoService.Put(fmt.Sprintf(APIPathNetworkDnsDomainRetry, domainID), domainParams)
+	data, status, err := ds.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathNetworkDnsDomainRetry, domainID),
+		domainParams,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -138,30 +189,62 @@ func (ds *DomainService) RetryDomain(domainID string) (domain *types.Domain, err
 
 // ListRecords returns the list of records as an array of Record for given domain
 func (ds *DomainService) ListRecords(domainID string) (records []*types.Record, err error) {
-	log.Debug("ListRecords")
+	return ds.ListRecordsContext(context.Background(), domainID)
+}
 
-	data, status, err := ds.concertoService.Get(fmt.Sprintf(APIPathNetworkDnsDomainRecords, domainID))
+// ListRecordsContext is like ListRecords, but the request is cancelled as soon as ctx is done
+func (ds *DomainService) ListRecordsContext(ctx context.Context, domainID string) (records []*types.Record, err error) {
+	log.Debug("ListRecords")
 
+	records = []*types.Record{}
+	err = ds.ListRecordsPagesContext(ctx, domainID, func(page []*types.Record) bool {
+		records = append(records, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return records, nil
+}
 
-	if err = json.Unmarshal(data, &records); err != nil {
-		return nil, err
-	}
+// ListRecordsPages is like ListRecords, but the list is streamed to fn one page at a time, until fn returns false
+func (ds *DomainService) ListRecordsPages(domainID string, fn func(records []*types.Record) bool) error {
+	return ds.ListRecordsPagesContext(context.Background(), domainID, fn)
+}
 
-	return records, nil
+// ListRecordsPagesContext is like ListRecordsPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (ds *DomainService) ListRecordsPagesContext(
+	ctx context.Context,
+	domainID string,
+	fn func(records []*types.Record) bool,
+) error {
+	log.Debug("ListRecordsPages")
+
+	pager := utils.NewPager(ctx, ds.concertoService, fmt.Sprintf(APIPathNetworkDnsDomainRecords, domainID))
+	for pager.Next() {
+		var records []*types.Record
+		if err := pager.Decode(&records); err != nil {
+			return err
+		}
+		if !fn(records) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetRecord returns a record by its ID
 func (ds *DomainService) GetRecord(recordID string) (record *types.Record, err error) {
+	return ds.GetRecordContext(context.Background(), recordID)
+}
+
+// GetRecordContext is like GetRecord, but the request is cancelled as soon as ctx is done
+func (ds *DomainService) GetRecordContext(ctx context.Context, recordID string) (record *types.Record, err error) {
 	log.Debug("GetRecord")
 
-	data, status, err := ds.concertoService.Get(fmt.Sprintf(APIPathNetworkDnsRecord, recordID))
+	data, status, err := ds.concertoService.GetContext(ctx, fmt.Sprintf(APIPathNetworkDnsRecord, recordID))
 	if err != nil {
 		return nil, err
 	}
@@ -181,10 +264,23 @@ func (ds *DomainService) GetRecord(recordID string) (record *types.Record, err e
 func (ds *DomainService) CreateRecord(
 	domainID string,
 	recordParams *map[string]interface{},
+) (record *types.Record, err error) {
+	return ds.CreateRecordContext(context.Background(), domainID, recordParams)
+}
+
+// CreateRecordContext is like CreateRecord, but the request is cancelled as soon as ctx is done
+func (ds *DomainService) CreateRecordContext(
+	ctx context.Context,
+	domainID string,
+	recordParams *map[string]interface{},
 ) (record *types.Record, err error) {
 	log.Debug("CreateRecord")
 
-	data, status, err := ds.concertoService.Post(fmt.Sprintf(APIPathNetworkDnsDomainRecords, domainID), recordParams)
+	data, status, err := ds.concertoService.PostContext(
+		ctx,
+		fmt.Sprintf(APIPathNetworkDnsDomainRecords, domainID),
+		recordParams,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -204,10 +300,23 @@ func (ds *DomainService) CreateRecord(
 func (ds *DomainService) UpdateRecord(
 	recordID string,
 	recordParams *map[string]interface{},
+) (record *types.Record, err error) {
+	return ds.UpdateRecordContext(context.Background(), recordID, recordParams)
+}
+
+// UpdateRecordContext is like UpdateRecord, but the request is cancelled as soon as ctx is done
+func (ds *DomainService) UpdateRecordContext(
+	ctx context.Context,
+	recordID string,
+	recordParams *map[string]interface{},
 ) (record *types.Record, err error) {
 	log.Debug("UpdateRecord")
 
-	data, status, err := ds.concertoService.Put(fmt.Sprintf(APIPathNetworkDnsRecord, recordID), recordParams)
+	data, status, err := ds.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathNetworkDnsRecord, recordID),
+		recordParams,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -225,9 +334,14 @@ func (ds *DomainService) UpdateRecord(
 
 // DeleteRecord deletes a record by its ID
 func (ds *DomainService) DeleteRecord(recordID string) (record *types.Record, err error) {
+	return ds.DeleteRecordContext(context.Background(), recordID)
+}
+
+// DeleteRecordContext is like DeleteRecord, but the request is cancelled as soon as ctx is done
+func (ds *DomainService) DeleteRecordContext(ctx context.Context, recordID string) (record *types.Record, err error) {
 	log.Debug("DeleteRecord")
 
-	data, status, err := ds.concertoService.Delete(fmt.Sprintf(APIPathNetworkDnsRecord, recordID))
+	data, status, err := ds.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathNetworkDnsRecord, recordID))
 	if err != nil {
 		return nil, err
 	}
@@ -245,10 +359,19 @@ func (ds *DomainService) DeleteRecord(recordID string) (record *types.Record, er
 
 // RetryRecord retries a record by its ID
 func (ds *DomainService) RetryRecord(recordID string) (record *types.Record, err error) {
+	return ds.RetryRecordContext(context.Background(), recordID)
+}
+
+// RetryRecordContext is like RetryRecord, but the request is cancelled as soon as ctx is done
+func (ds *DomainService) RetryRecordContext(ctx context.Context, recordID string) (record *types.Record, err error) {
 	log.Debug("RetryRecord")
 
 	recordParams := new(map[string]interface{})
-	data, status, err := ds.concertoService.Put(fmt.Sprintf(APIPathNetworkDnsRecordRetry, recordID), recordParams)
+	data, status, err := ds.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathNetworkDnsRecordRetry, recordID),
+		recordParams,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -28,7 +28,7 @@ func ListDomainsMocked(t *testing.T, domainsIn []*types.Domain) []*types.Domain
 	assert.Nil(err, "Domains test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathNetworkDnsDomains).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathNetworkDnsDomains, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	domainsOut, err := ds.ListDomains()
 
 	assert.Nil(err, "Error getting domains")
@@ -53,7 +53,8 @@ func ListDomainsFailErrMocked(t *testing.T, domainsIn []*types.Domain) []*types.
 	assert.Nil(err, "Domains test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathNetworkDnsDomains).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathNetworkDnsDomains, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	domainsOut, err := ds.ListDomains()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -79,7 +80,7 @@ func ListDomainsFailStatusMocked(t *testing.T, domainsIn []*types.Domain) []*typ
 	assert.Nil(err, "Domains test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathNetworkDnsDomains).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathNetworkDnsDomains, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	domainsOut, err := ds.ListDomains()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -104,7 +105,7 @@ func ListDomainsFailJSONMocked(t *testing.T, domainsIn []*types.Domain) []*types
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathNetworkDnsDomains).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathNetworkDnsDomains, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	domainsOut, err := ds.ListDomains()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -556,7 +557,8 @@ func ListRecordsMocked(t *testing.T, domainID string, recordsIn []*types.Record)
 	assert.Nil(err, "Records test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkDnsDomainRecords, domainID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathNetworkDnsDomainRecords, domainID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	recordsOut, err := ds.ListRecords(domainID)
 
 	assert.Nil(err, "Error getting records")
@@ -581,7 +583,8 @@ func ListRecordsFailErrMocked(t *testing.T, domainID string, recordsIn []*types.
 	assert.Nil(err, "Records test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkDnsDomainRecords, domainID)).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathNetworkDnsDomainRecords, domainID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	recordsOut, err := ds.ListRecords(domainID)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -607,7 +610,8 @@ func ListRecordsFailStatusMocked(t *testing.T, domainID string, recordsIn []*typ
 	assert.Nil(err, "Records test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkDnsDomainRecords, domainID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathNetworkDnsDomainRecords, domainID), 1, utils.DefaultPageSize)).
+		Return(dIn, 499, nil)
 	recordsOut, err := ds.ListRecords(domainID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -632,7 +636,8 @@ func ListRecordsFailJSONMocked(t *testing.T, domainID string, recordsIn []*types
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkDnsDomainRecords, domainID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathNetworkDnsDomainRecords, domainID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	recordsOut, err := ds.ListRecords(domainID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
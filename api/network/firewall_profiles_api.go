@@ -3,6 +3,7 @@
 package network
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -32,31 +33,74 @@ func NewFirewallProfileService(concertoService utils.ConcertoService) (*Firewall
 
 // ListFirewallProfiles returns the list of firewallProfiles as an array of FirewallProfile
 func (fps *FirewallProfileService) ListFirewallProfiles() (firewallProfiles []*types.FirewallProfile, err error) {
+	return fps.ListFirewallProfilesContext(context.Background())
+}
+
+// ListFirewallProfilesContext is like ListFirewallProfiles, but the request is cancelled as soon as ctx is done
+func (fps *FirewallProfileService) ListFirewallProfilesContext(
+	ctx context.Context,
+) (firewallProfiles []*types.FirewallProfile, err error) {
 	log.Debug("ListFirewallProfiles")
 
-	data, status, err := fps.concertoService.Get(APIPathNetworkFirewallProfiles)
+	firewallProfiles = []*types.FirewallProfile{}
+	err = fps.ListFirewallProfilesPagesContext(ctx, func(page []*types.FirewallProfile) bool {
+		firewallProfiles = append(firewallProfiles, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return firewallProfiles, nil
+}
 
-	if err = json.Unmarshal(data, &firewallProfiles); err != nil {
-		return nil, err
-	}
+// ListFirewallProfilesPages is like ListFirewallProfiles, but the list is streamed to fn one page at a time, until fn
+// returns false
+func (fps *FirewallProfileService) ListFirewallProfilesPages(
+	fn func(firewallProfiles []*types.FirewallProfile) bool,
+) error {
+	return fps.ListFirewallProfilesPagesContext(context.Background(), fn)
+}
 
-	return firewallProfiles, nil
+// ListFirewallProfilesPagesContext is like ListFirewallProfilesPages, but the requests are cancelled as soon as ctx is
+// done. The page options carried by ctx are applied
+func (fps *FirewallProfileService) ListFirewallProfilesPagesContext(
+	ctx context.Context,
+	fn func(firewallProfiles []*types.FirewallProfile) bool,
+) error {
+	log.Debug("ListFirewallProfilesPages")
+
+	pager := utils.NewPager(ctx, fps.concertoService, APIPathNetworkFirewallProfiles)
+	for pager.Next() {
+		var firewallProfiles []*types.FirewallProfile
+		if err := pager.Decode(&firewallProfiles); err != nil {
+			return err
+		}
+		if !fn(firewallProfiles) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetFirewallProfile returns a firewallProfile by its ID
 func (fps *FirewallProfileService) GetFirewallProfile(
 	firewallProfileID string,
+) (firewallProfile *types.FirewallProfile, err error) {
+	return fps.GetFirewallProfileContext(context.Background(), firewallProfileID)
+}
+
+// GetFirewallProfileContext is like GetFirewallProfile, but the request is cancelled as soon as ctx is done
+func (fps *FirewallProfileService) GetFirewallProfileContext(
+	ctx context.Context,
+	firewallProfileID string,
 ) (firewallProfile *types.FirewallProfile, err error) {
 	log.Debug("GetFirewallProfile")
 
-	data, status, err := fps.concertoService.Get(fmt.Sprintf(APIPathNetworkFirewallProfile, firewallProfileID))
+	data, status, err := fps.concertoService.GetContext(
+		ctx,
+		fmt.Sprintf(APIPathNetworkFirewallProfile, firewallProfileID),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -75,10 +119,18 @@ func (fps *FirewallProfileService) GetFirewallProfile(
 // CreateFirewallProfile creates a firewallProfile
 func (fps *FirewallProfileService) CreateFirewallProfile(
 	firewallProfileParams *map[string]interface{},
+) (firewallProfile *types.FirewallProfile, err error) {
+	return fps.CreateFirewallProfileContext(context.Background(), firewallProfileParams)
+}
+
+// CreateFirewallProfileContext is like CreateFirewallProfile, but the request is cancelled as soon as ctx is done
+func (fps *FirewallProfileService) CreateFirewallProfileContext(
+	ctx context.Context,
+	firewallProfileParams *map[string]interface{},
 ) (firewallProfile *types.FirewallProfile, err error) {
 	log.Debug("CreateFirewallProfile")
 
-	data, status, err := fps.concertoService.Post(APIPathNetworkFirewallProfiles, firewallProfileParams)
+	data, status, err := fps.concertoService.PostContext(ctx, APIPathNetworkFirewallProfiles, firewallProfileParams)
 
 	if err != nil {
 		return nil, err
@@ -95,14 +147,45 @@ func (fps *FirewallProfileService) CreateFirewallProfile(
 	return firewallProfile, nil
 }
 
+// CreateFirewallProfileWithInput is like CreateFirewallProfile, but takes a typed input, which is validated before
+// being sent
+func (fps *FirewallProfileService) CreateFirewallProfileWithInput(
+	input *types.FirewallProfileCreateInput,
+) (firewallProfile *types.FirewallProfile, err error) {
+	return fps.CreateFirewallProfileWithInputContext(context.Background(), input)
+}
+
+// CreateFirewallProfileWithInputContext is like CreateFirewallProfileWithInput, but the request is cancelled as soon as
+// ctx is done
+func (fps *FirewallProfileService) CreateFirewallProfileWithInputContext(
+	ctx context.Context,
+	input *types.FirewallProfileCreateInput,
+) (firewallProfile *types.FirewallProfile, err error) {
+	firewallProfileParams, err := types.InputParams(input)
+	if err != nil {
+		return nil, err
+	}
+	return fps.CreateFirewallProfileContext(ctx, firewallProfileParams)
+}
+
 // UpdateFirewallProfile updates a firewallProfile by its ID
 func (fps *FirewallProfileService) UpdateFirewallProfile(
 	firewallProfileID string,
 	firewallProfileParams *map[string]interface{},
+) (firewallProfile *types.FirewallProfile, err error) {
+	return fps.UpdateFirewallProfileContext(context.Background(), firewallProfileID, firewallProfileParams)
+}
+
+// UpdateFirewallProfileContext is like UpdateFirewallProfile, but the request is cancelled as soon as ctx is done
+func (fps *FirewallProfileService) UpdateFirewallProfileContext(
+	ctx context.Context,
+	firewallProfileID string,
+	firewallProfileParams *map[string]interface{},
 ) (firewallProfile *types.FirewallProfile, err error) {
 	log.Debug("UpdateFirewallProfile")
 
-	data, status, err := fps.concertoService.Put(
+	data, status, err := fps.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkFirewallProfile, firewallProfileID),
 		firewallProfileParams,
 	)
@@ -122,11 +205,45 @@ func (fps *FirewallProfileService) UpdateFirewallProfile(
 	return firewallProfile, nil
 }
 
+// UpdateFirewallProfileWithInput is like UpdateFirewallProfile, but takes a typed input, which is validated before
+// being sent
+func (fps *FirewallProfileService) UpdateFirewallProfileWithInput(
+	firewallProfileID string,
+	input *types.FirewallProfileUpdateInput,
+) (firewallProfile *types.FirewallProfile, err error) {
+	return fps.UpdateFirewallProfileWithInputContext(context.Background(), firewallProfileID, input)
+}
+
+// UpdateFirewallProfileWithInputContext is like UpdateFirewallProfileWithInput, but the request is cancelled as soon as
+// ctx is done
+func (fps *FirewallProfileService) UpdateFirewallProfileWithInputContext(
+	ctx context.Context,
+	firewallProfileID string,
+	input *types.FirewallProfileUpdateInput,
+) (firewallProfile *types.FirewallProfile, err error) {
+	firewallProfileParams, err := types.InputParams(input)
+	if err != nil {
+		return nil, err
+	}
+	return fps.UpdateFirewallProfileContext(ctx, firewallProfileID, firewallProfileParams)
+}
+
 // DeleteFirewallProfile deletes a firewallProfile by its ID
 func (fps *FirewallProfileService) DeleteFirewallProfile(firewallProfileID string) (err error) {
+	return fps.DeleteFirewallProfileContext(context.Background(), firewallProfileID)
+}
+
+// DeleteFirewallProfileContext is like DeleteFirewallProfile, but the request is cancelled as soon as ctx is done
+func (fps *FirewallProfileService) DeleteFirewallProfileContext(
+	ctx context.Context,
+	firewallProfileID string,
+) (err error) {
 	log.Debug("DeleteFirewallProfile")
 
-	data, status, err := fps.concertoService.Delete(fmt.Sprintf(APIPathNetworkFirewallProfile, firewallProfileID))
+	data, status, err := fps.concertoService.DeleteContext(
+		ctx,
+		fmt.Sprintf(APIPathNetworkFirewallProfile, firewallProfileID),
+	)
 	if err != nil {
 		return err
 	}
@@ -30,7 +30,7 @@ func ListFirewallProfilesMocked(t *testing.T, firewallProfilesIn []*types.Firewa
 	assert.Nil(err, "FirewallProfile test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathNetworkFirewallProfiles).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathNetworkFirewallProfiles, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	firewallProfilesOut, err := ds.ListFirewallProfiles()
 	assert.Nil(err, "Error getting firewallProfile list")
 	assert.Equal(firewallProfilesIn, firewallProfilesOut, "ListFirewallProfiles returned different firewallProfiles")
@@ -57,7 +57,8 @@ func ListFirewallProfilesFailErrMocked(
 	assert.Nil(err, "FirewallProfile test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathNetworkFirewallProfiles).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathNetworkFirewallProfiles, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	firewallProfilesOut, err := ds.ListFirewallProfiles()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -86,7 +87,7 @@ func ListFirewallProfilesFailStatusMocked(
 	assert.Nil(err, "FirewallProfile test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathNetworkFirewallProfiles).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathNetworkFirewallProfiles, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	firewallProfilesOut, err := ds.ListFirewallProfiles()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -114,7 +115,7 @@ func ListFirewallProfilesFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathNetworkFirewallProfiles).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathNetworkFirewallProfiles, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	firewallProfilesOut, err := ds.ListFirewallProfiles()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
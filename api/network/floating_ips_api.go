@@ -3,6 +3,7 @@
 package network
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -35,35 +36,77 @@ func NewFloatingIPService(concertoService utils.ConcertoService) (*FloatingIPSer
 
 // ListFloatingIPs returns the list of FloatingIPs as an array of FloatingIP
 func (fips *FloatingIPService) ListFloatingIPs(serverID string) (floatingIPs []*types.FloatingIP, err error) {
-	log.Debug("ListFloatingIPs")
-
-	path := APIPathNetworkFloatingIPs
-	if serverID != "" {
-		path = fmt.Sprintf(APIPathCloudServerFloatingIPs, serverID)
+	return fips.ListFloatingIPsContext(context.Background(), serverID)
+}
 
-	}
-	data, status, err := fips.concertoService.Get(path)
+// ListFloatingIPsContext is like ListFloatingIPs, but the request is cancelled as soon as ctx is done
+func (fips *FloatingIPService) ListFloatingIPsContext(
+	ctx context.Context,
+	serverID string,
+) (floatingIPs []*types.FloatingIP, err error) {
+	log.Debug("ListFloatingIPs")
 
+	floatingIPs = []*types.FloatingIP{}
+	err = fips.ListFloatingIPsPagesContext(ctx, serverID, func(page []*types.FloatingIP) bool {
+		floatingIPs = append(floatingIPs, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return floatingIPs, nil
+}
 
-	if err = json.Unmarshal(data, &floatingIPs); err != nil {
-		return nil, err
+// ListFloatingIPsPages is like ListFloatingIPs, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (fips *FloatingIPService) ListFloatingIPsPages(
+	serverID string,
+	fn func(floatingIPs []*types.FloatingIP) bool,
+) error {
+	return fips.ListFloatingIPsPagesContext(context.Background(), serverID, fn)
+}
+
+// ListFloatingIPsPagesContext is like ListFloatingIPsPages, but the requests are cancelled as soon as ctx is done. The
+// page options carried by ctx are applied
+func (fips *FloatingIPService) ListFloatingIPsPagesContext(
+	ctx context.Context,
+	serverID string,
+	fn func(floatingIPs []*types.FloatingIP) bool,
+) error {
+	log.Debug("ListFloatingIPsPages")
+
+	path := APIPathNetworkFloatingIPs
+	if serverID != "" {
+		path = fmt.Sprintf(APIPathCloudServerFloatingIPs, serverID)
 	}
 
-	return floatingIPs, nil
+	pager := utils.NewPager(ctx, fips.concertoService, path)
+	for pager.Next() {
+		var floatingIPs []*types.FloatingIP
+		if err := pager.Decode(&floatingIPs); err != nil {
+			return err
+		}
+		if !fn(floatingIPs) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetFloatingIP returns a FloatingIP by its ID
 func (fips *FloatingIPService) GetFloatingIP(floatingIPID string) (floatingIP *types.FloatingIP, err error) {
+	return fips.GetFloatingIPContext(context.Background(), floatingIPID)
+}
+
+// GetFloatingIPContext is like GetFloatingIP, but the request is cancelled as soon as ctx is done
+func (fips *FloatingIPService) GetFloatingIPContext(
+	ctx context.Context,
+	floatingIPID string,
+) (floatingIP *types.FloatingIP, err error) {
 	log.Debug("GetFloatingIP")
 
-	data, status, err := fips.concertoService.Get(fmt.Sprintf(APIPathNetworkFloatingIP, floatingIPID))
+	data, status, err := fips.concertoService.GetContext(ctx, fmt.Sprintf(APIPathNetworkFloatingIP, floatingIPID))
 	if err != nil {
 		return nil, err
 	}
@@ -82,10 +125,18 @@ func (fips *FloatingIPService) GetFloatingIP(floatingIPID string) (floatingIP *t
 // CreateFloatingIP creates a FloatingIP
 func (fips *FloatingIPService) CreateFloatingIP(
 	floatingIPParams *map[string]interface{},
+) (floatingIP *types.FloatingIP, err error) {
+	return fips.CreateFloatingIPContext(context.Background(), floatingIPParams)
+}
+
+// CreateFloatingIPContext is like CreateFloatingIP, but the request is cancelled as soon as ctx is done
+func (fips *FloatingIPService) CreateFloatingIPContext(
+	ctx context.Context,
+	floatingIPParams *map[string]interface{},
 ) (floatingIP *types.FloatingIP, err error) {
 	log.Debug("CreateFloatingIP")
 
-	data, status, err := fips.concertoService.Post(APIPathNetworkFloatingIPs, floatingIPParams)
+	data, status, err := fips.concertoService.PostContext(ctx, APIPathNetworkFloatingIPs, floatingIPParams)
 
 	if err != nil {
 		return nil, err
@@ -102,14 +153,44 @@ func (fips *FloatingIPService) CreateFloatingIP(
 	return floatingIP, nil
 }
 
+// CreateFloatingIPWithInput is like CreateFloatingIP, but takes a typed input, which is validated before being sent
+func (fips *FloatingIPService) CreateFloatingIPWithInput(
+	input *types.FloatingIPCreateInput,
+) (floatingIP *types.FloatingIP, err error) {
+	return fips.CreateFloatingIPWithInputContext(context.Background(), input)
+}
+
+// CreateFloatingIPWithInputContext is like CreateFloatingIPWithInput, but the request is cancelled as soon as ctx is
+// done
+func (fips *FloatingIPService) CreateFloatingIPWithInputContext(
+	ctx context.Context,
+	input *types.FloatingIPCreateInput,
+) (floatingIP *types.FloatingIP, err error) {
+	floatingIPParams, err := types.InputParams(input)
+	if err != nil {
+		return nil, err
+	}
+	return fips.CreateFloatingIPContext(ctx, floatingIPParams)
+}
+
 // UpdateFloatingIP updates a FloatingIP by its ID
 func (fips *FloatingIPService) UpdateFloatingIP(
 	floatingIPID string,
 	floatingIPParams *map[string]interface{},
+) (floatingIP *types.FloatingIP, err error) {
+	return fips.UpdateFloatingIPContext(context.Background(), floatingIPID, floatingIPParams)
+}
+
+// UpdateFloatingIPContext is like UpdateFloatingIP, but the request is cancelled as soon as ctx is done
+func (fips *FloatingIPService) UpdateFloatingIPContext(
+	ctx context.Context,
+	floatingIPID string,
+	floatingIPParams *map[string]interface{},
 ) (floatingIP *types.FloatingIP, err error) {
 	log.Debug("UpdateFloatingIP")
 
-	data, status, err := fips.concertoService.Put(
+	data, status, err := fips.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkFloatingIP, floatingIPID),
 		floatingIPParams,
 	)
@@ -129,14 +210,46 @@ func (fips *FloatingIPService) UpdateFloatingIP(
 	return floatingIP, nil
 }
 
+// UpdateFloatingIPWithInput is like UpdateFloatingIP, but takes a typed input, which is validated before being sent
+func (fips *FloatingIPService) UpdateFloatingIPWithInput(
+	floatingIPID string,
+	input *types.FloatingIPUpdateInput,
+) (floatingIP *types.FloatingIP, err error) {
+	return fips.UpdateFloatingIPWithInputContext(context.Background(), floatingIPID, input)
+}
+
+// UpdateFloatingIPWithInputContext is like UpdateFloatingIPWithInput, but the request is cancelled as soon as ctx is
+// done
+func (fips *FloatingIPService) UpdateFloatingIPWithInputContext(
+	ctx context.Context,
+	floatingIPID string,
+	input *types.FloatingIPUpdateInput,
+) (floatingIP *types.FloatingIP, err error) {
+	floatingIPParams, err := types.InputParams(input)
+	if err != nil {
+		return nil, err
+	}
+	return fips.UpdateFloatingIPContext(ctx, floatingIPID, floatingIPParams)
+}
+
 // AttachFloatingIP attaches a FloatingIP by its ID
 func (fips *FloatingIPService) AttachFloatingIP(
 	floatingIPID string,
 	floatingIPParams *map[string]interface{},
+) (server *types.Server, err error) {
+	return fips.AttachFloatingIPContext(context.Background(), floatingIPID, floatingIPParams)
+}
+
+// AttachFloatingIPContext is like AttachFloatingIP, but the request is cancelled as soon as ctx is done
+func (fips *FloatingIPService) AttachFloatingIPContext(
+	ctx context.Context,
+	floatingIPID string,
+	floatingIPParams *map[string]interface{},
 ) (server *types.Server, err error) {
 	log.Debug("AttachFloatingIP")
 
-	data, status, err := fips.concertoService.Post(
+	data, status, err := fips.concertoService.PostContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkFloatingIPAttachedServer, floatingIPID),
 		floatingIPParams,
 	)
@@ -158,9 +271,15 @@ func (fips *FloatingIPService) AttachFloatingIP(
 
 // DetachFloatingIP detaches a FloatingIP by its ID
 func (fips *FloatingIPService) DetachFloatingIP(floatingIPID string) (err error) {
+	return fips.DetachFloatingIPContext(context.Background(), floatingIPID)
+}
+
+// DetachFloatingIPContext is like DetachFloatingIP, but the request is cancelled as soon as ctx is done
+func (fips *FloatingIPService) DetachFloatingIPContext(ctx context.Context, floatingIPID string) (err error) {
 	log.Debug("DetachFloatingIP")
 
-	data, status, err := fips.concertoService.Delete(
+	data, status, err := fips.concertoService.DeleteContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkFloatingIPAttachedServer, floatingIPID),
 	)
 	if err != nil {
@@ -176,9 +295,14 @@ func (fips *FloatingIPService) DetachFloatingIP(floatingIPID string) (err error)
 
 // DeleteFloatingIP deletes a FloatingIP by its ID
 func (fips *FloatingIPService) DeleteFloatingIP(floatingIPID string) (err error) {
+	return fips.DeleteFloatingIPContext(context.Background(), floatingIPID)
+}
+
+// DeleteFloatingIPContext is like DeleteFloatingIP, but the request is cancelled as soon as ctx is done
+func (fips *FloatingIPService) DeleteFloatingIPContext(ctx context.Context, floatingIPID string) (err error) {
 	log.Debug("DeleteFloatingIP")
 
-	data, status, err := fips.concertoService.Delete(fmt.Sprintf(APIPathNetworkFloatingIP, floatingIPID))
+	data, status, err := fips.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathNetworkFloatingIP, floatingIPID))
 	if err != nil {
 		return err
 	}
@@ -192,9 +316,17 @@ func (fips *FloatingIPService) DeleteFloatingIP(floatingIPID string) (err error)
 
 // DiscardFloatingIP discards a FloatingIP by its ID
 func (fips *FloatingIPService) DiscardFloatingIP(floatingIPID string) (err error) {
+	return fips.DiscardFloatingIPContext(context.Background(), floatingIPID)
+}
+
+// DiscardFloatingIPContext is like DiscardFloatingIP, but the request is cancelled as soon as ctx is done
+func (fips *FloatingIPService) DiscardFloatingIPContext(ctx context.Context, floatingIPID string) (err error) {
 	log.Debug("DiscardFloatingIP")
 
-	data, status, err := fips.concertoService.Delete(fmt.Sprintf(APIPathNetworkFloatingIPDiscard, floatingIPID))
+	data, status, err := fips.concertoService.DeleteContext(
+		ctx,
+		fmt.Sprintf(APIPathNetworkFloatingIPDiscard, floatingIPID),
+	)
 	if err != nil {
 		return err
 	}
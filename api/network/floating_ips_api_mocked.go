@@ -30,7 +30,7 @@ func ListFloatingIPsMocked(t *testing.T, floatingIPIn []*types.FloatingIP) []*ty
 	assert.Nil(err, "FloatingIP test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathNetworkFloatingIPs).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathNetworkFloatingIPs, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	floatingIPOut, err := ds.ListFloatingIPs("")
 	assert.Nil(err, "Error getting floating IP list")
 	assert.Equal(floatingIPIn, floatingIPOut, "ListFloatingIPs returned different floating IPs")
@@ -53,7 +53,11 @@ func ListFloatingIPsMockedFilteredByServer(t *testing.T, floatingIPIn []*types.F
 	assert.Nil(err, "FloatingIP test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerFloatingIPs, floatingIPIn[0].AttachedServerID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathCloudServerFloatingIPs, floatingIPIn[0].AttachedServerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	floatingIPOut, err := ds.ListFloatingIPs(floatingIPIn[0].AttachedServerID)
 	assert.Nil(err, "Error getting floating IP list filtered by server")
 	assert.Equal(floatingIPIn, floatingIPOut, "ListFloatingIPs returned different floating IPs")
@@ -77,7 +81,8 @@ func ListFloatingIPsFailErrMocked(t *testing.T, floatingIPIn []*types.FloatingIP
 	assert.Nil(err, "FloatingIP test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathNetworkFloatingIPs).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathNetworkFloatingIPs, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	floatingIPOut, err := ds.ListFloatingIPs("")
 
 	assert.NotNil(err, "We are expecting an error")
@@ -103,7 +108,7 @@ func ListFloatingIPsFailStatusMocked(t *testing.T, floatingIPIn []*types.Floatin
 	assert.Nil(err, "FloatingIP test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathNetworkFloatingIPs).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathNetworkFloatingIPs, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	floatingIPOut, err := ds.ListFloatingIPs("")
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -128,7 +133,7 @@ func ListFloatingIPsFailJSONMocked(t *testing.T, floatingIPIn []*types.FloatingI
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathNetworkFloatingIPs).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathNetworkFloatingIPs, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	floatingIPOut, err := ds.ListFloatingIPs("")
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -3,6 +3,7 @@
 package network
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -35,30 +36,68 @@ func NewListenerService(concertoService utils.ConcertoService) (*ListenerService
 
 // ListListeners returns the list of listeners in a load balancer by its ID, as an array of Listener
 func (ls *ListenerService) ListListeners(loadBalancerID string) (listeners []*types.Listener, err error) {
-	log.Debug("ListListeners")
+	return ls.ListListenersContext(context.Background(), loadBalancerID)
+}
 
-	data, status, err := ls.concertoService.Get(fmt.Sprintf(APIPathNetworkLoadBalancerListeners, loadBalancerID))
+// ListListenersContext is like ListListeners, but the request is cancelled as soon as ctx is done
+func (ls *ListenerService) ListListenersContext(
+	ctx context.Context,
+	loadBalancerID string,
+) (listeners []*types.Listener, err error) {
+	log.Debug("ListListeners")
 
+	listeners = []*types.Listener{}
+	err = ls.ListListenersPagesContext(ctx, loadBalancerID, func(page []*types.Listener) bool {
+		listeners = append(listeners, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return listeners, nil
+}
 
-	if err = json.Unmarshal(data, &listeners); err != nil {
-		return nil, err
-	}
+// ListListenersPages is like ListListeners, but the list is streamed to fn one page at a time, until fn returns false
+func (ls *ListenerService) ListListenersPages(loadBalancerID string, fn func(listeners []*types.Listener) bool) error {
+	return ls.ListListenersPagesContext(context.Background(), loadBalancerID, fn)
+}
 
-	return listeners, nil
+// ListListenersPagesContext is like ListListenersPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (ls *ListenerService) ListListenersPagesContext(
+	ctx context.Context,
+	loadBalancerID string,
+	fn func(listeners []*types.Listener) bool,
+) error {
+	log.Debug("ListListenersPages")
+
+	pager := utils.NewPager(ctx, ls.concertoService, fmt.Sprintf(APIPathNetworkLoadBalancerListeners, loadBalancerID))
+	for pager.Next() {
+		var listeners []*types.Listener
+		if err := pager.Decode(&listeners); err != nil {
+			return err
+		}
+		if !fn(listeners) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetListener returns a listener by its ID
 func (ls *ListenerService) GetListener(listenerID string) (listener *types.Listener, err error) {
+	return ls.GetListenerContext(context.Background(), listenerID)
+}
+
+// GetListenerContext is like GetListener, but the request is cancelled as soon as ctx is done
+func (ls *ListenerService) GetListenerContext(
+	ctx context.Context,
+	listenerID string,
+) (listener *types.Listener, err error) {
 	log.Debug("GetListener")
 
-	data, status, err := ls.concertoService.Get(fmt.Sprintf(APIPathNetworkListener, listenerID))
+	data, status, err := ls.concertoService.GetContext(ctx, fmt.Sprintf(APIPathNetworkListener, listenerID))
 	if err != nil {
 		return nil, err
 	}
@@ -78,10 +117,20 @@ func (ls *ListenerService) GetListener(listenerID string) (listener *types.Liste
 func (ls *ListenerService) CreateListener(
 	loadBalancerID string,
 	listenerParams *map[string]interface{},
+) (listener *types.Listener, err error) {
+	return ls.CreateListenerContext(context.Background(), loadBalancerID, listenerParams)
+}
+
+// CreateListenerContext is like CreateListener, but the request is cancelled as soon as ctx is done
+func (ls *ListenerService) CreateListenerContext(
+	ctx context.Context,
+	loadBalancerID string,
+	listenerParams *map[string]interface{},
 ) (listener *types.Listener, err error) {
 	log.Debug("CreateListener")
 
-	data, status, err := ls.concertoService.Post(
+	data, status, err := ls.concertoService.PostContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkLoadBalancerListeners, loadBalancerID),
 		listenerParams,
 	)
@@ -104,10 +153,23 @@ func (ls *ListenerService) CreateListener(
 func (ls *ListenerService) UpdateListener(
 	listenerID string,
 	listenerParams *map[string]interface{},
+) (listener *types.Listener, err error) {
+	return ls.UpdateListenerContext(context.Background(), listenerID, listenerParams)
+}
+
+// UpdateListenerContext is like UpdateListener, but the request is cancelled as soon as ctx is done
+func (ls *ListenerService) UpdateListenerContext(
+	ctx context.Context,
+	listenerID string,
+	listenerParams *map[string]interface{},
 ) (listener *types.Listener, err error) {
 	log.Debug("UpdateListener")
 
-	data, status, err := ls.concertoService.Put(fmt.Sprintf(APIPathNetworkListener, listenerID), listenerParams)
+	data, status, err := ls.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathNetworkListener, listenerID),
+		listenerParams,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -125,9 +187,17 @@ func (ls *ListenerService) UpdateListener(
 
 // DeleteListener deletes a listener by its ID
 func (ls *ListenerService) DeleteListener(listenerID string) (listener *types.Listener, err error) {
+	return ls.DeleteListenerContext(context.Background(), listenerID)
+}
+
+// DeleteListenerContext is like DeleteListener, but the request is cancelled as soon as ctx is done
+func (ls *ListenerService) DeleteListenerContext(
+	ctx context.Context,
+	listenerID string,
+) (listener *types.Listener, err error) {
 	log.Debug("DeleteListener")
 
-	data, status, err := ls.concertoService.Delete(fmt.Sprintf(APIPathNetworkListener, listenerID))
+	data, status, err := ls.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathNetworkListener, listenerID))
 	if err != nil {
 		return nil, err
 	}
@@ -147,10 +217,23 @@ func (ls *ListenerService) DeleteListener(listenerID string) (listener *types.Li
 func (ls *ListenerService) RetryListener(
 	listenerID string,
 	listenerParams *map[string]interface{},
+) (listener *types.Listener, err error) {
+	return ls.RetryListenerContext(context.Background(), listenerID, listenerParams)
+}
+
+// RetryListenerContext is like RetryListener, but the request is cancelled as soon as ctx is done
+func (ls *ListenerService) RetryListenerContext(
+	ctx context.Context,
+	listenerID string,
+	listenerParams *map[string]interface{},
 ) (listener *types.Listener, err error) {
 	log.Debug("RetryListener")
 
-	data, status, err := ls.concertoService.Put(fmt.Sprintf(APIPathNetworkListenerRetry, listenerID), listenerParams)
+	data, status, err := ls.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathNetworkListenerRetry, listenerID),
+		listenerParams,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -168,32 +251,73 @@ func (ls *ListenerService) RetryListener(
 
 // ListRules returns the list of rules in a listener by its ID, as an array of ListenerRule
 func (ls *ListenerService) ListRules(listenerID string) (listenerRules []*types.ListenerRule, err error) {
+	return ls.ListRulesContext(context.Background(), listenerID)
+}
+
+// ListRulesContext is like ListRules, but the request is cancelled as soon as ctx is done
+func (ls *ListenerService) ListRulesContext(
+	ctx context.Context,
+	listenerID string,
+) (listenerRules []*types.ListenerRule, err error) {
 	log.Debug("ListRules")
 
-	data, status, err := ls.concertoService.Get(fmt.Sprintf(APIPathNetworkListenerRules, listenerID))
+	listenerRules = []*types.ListenerRule{}
+	err = ls.ListRulesPagesContext(ctx, listenerID, func(page []*types.ListenerRule) bool {
+		listenerRules = append(listenerRules, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return listenerRules, nil
+}
 
-	if err = json.Unmarshal(data, &listenerRules); err != nil {
-		return nil, err
-	}
+// ListRulesPages is like ListRules, but the list is streamed to fn one page at a time, until fn returns false
+func (ls *ListenerService) ListRulesPages(listenerID string, fn func(listenerRules []*types.ListenerRule) bool) error {
+	return ls.ListRulesPagesContext(context.Background(), listenerID, fn)
+}
 
-	return listenerRules, nil
+// ListRulesPagesContext is like ListRulesPages, but the requests are cancelled as soon as ctx is done. The page options
+// carried by ctx are applied
+func (ls *ListenerService) ListRulesPagesContext(
+	ctx context.Context,
+	listenerID string,
+	fn func(listenerRules []*types.ListenerRule) bool,
+) error {
+	log.Debug("ListRulesPages")
+
+	pager := utils.NewPager(ctx, ls.concertoService, fmt.Sprintf(APIPathNetworkListenerRules, listenerID))
+	for pager.Next() {
+		var listenerRules []*types.ListenerRule
+		if err := pager.Decode(&listenerRules); err != nil {
+			return err
+		}
+		if !fn(listenerRules) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // CreateRule creates a rule in a listener by its ID
 func (ls *ListenerService) CreateRule(
 	listenerID string,
 	listenerRuleParams *map[string]interface{},
+) (listenerRule *types.ListenerRule, err error) {
+	return ls.CreateRuleContext(context.Background(), listenerID, listenerRuleParams)
+}
+
+// CreateRuleContext is like CreateRule, but the request is cancelled as soon as ctx is done
+func (ls *ListenerService) CreateRuleContext(
+	ctx context.Context,
+	listenerID string,
+	listenerRuleParams *map[string]interface{},
 ) (listenerRule *types.ListenerRule, err error) {
 	log.Debug("CreateRule")
 
-	data, status, err := ls.concertoService.Post(
+	data, status, err := ls.concertoService.PostContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkListenerRules, listenerID),
 		listenerRuleParams,
 	)
@@ -217,10 +341,21 @@ func (ls *ListenerService) UpdateRule(
 	listenerID string,
 	listenerRuleID string,
 	listenerRuleParams *map[string]interface{},
+) (listenerRule *types.ListenerRule, err error) {
+	return ls.UpdateRuleContext(context.Background(), listenerID, listenerRuleID, listenerRuleParams)
+}
+
+// UpdateRuleContext is like UpdateRule, but the request is cancelled as soon as ctx is done
+func (ls *ListenerService) UpdateRuleContext(
+	ctx context.Context,
+	listenerID string,
+	listenerRuleID string,
+	listenerRuleParams *map[string]interface{},
 ) (listenerRule *types.ListenerRule, err error) {
 	log.Debug("UpdateRule")
 
-	data, status, err := ls.concertoService.Put(
+	data, status, err := ls.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkListenerRule, listenerID, listenerRuleID),
 		listenerRuleParams,
 	)
@@ -241,9 +376,19 @@ func (ls *ListenerService) UpdateRule(
 
 // DeleteRule deletes a rule in a listener by given IDs
 func (ls *ListenerService) DeleteRule(listenerID string, listenerRuleID string) (err error) {
+	return ls.DeleteRuleContext(context.Background(), listenerID, listenerRuleID)
+}
+
+// DeleteRuleContext is like DeleteRule, but the request is cancelled as soon as ctx is done
+func (ls *ListenerService) DeleteRuleContext(
+	ctx context.Context,
+	listenerID string,
+	listenerRuleID string,
+) (err error) {
 	log.Debug("DeleteRule")
 
-	data, status, err := ls.concertoService.Delete(
+	data, status, err := ls.concertoService.DeleteContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkListenerRule, listenerID, listenerRuleID),
 	)
 	if err != nil {
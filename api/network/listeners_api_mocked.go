@@ -28,7 +28,11 @@ func ListListenersMocked(t *testing.T, loadBalancerID string, listenersIn []*typ
 	assert.Nil(err, "Listeners test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkLoadBalancerListeners, loadBalancerID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkLoadBalancerListeners, loadBalancerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	listenersOut, err := ds.ListListeners(loadBalancerID)
 
 	assert.Nil(err, "Error getting listeners")
@@ -53,8 +57,11 @@ func ListListenersFailErrMocked(t *testing.T, loadBalancerID string, listenersIn
 	assert.Nil(err, "Listeners test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkLoadBalancerListeners, loadBalancerID)).
-		Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkLoadBalancerListeners, loadBalancerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, fmt.Errorf("mocked error"))
 	listenersOut, err := ds.ListListeners(loadBalancerID)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -84,7 +91,11 @@ func ListListenersFailStatusMocked(
 	assert.Nil(err, "Listeners test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkLoadBalancerListeners, loadBalancerID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkLoadBalancerListeners, loadBalancerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 499, nil)
 	listenersOut, err := ds.ListListeners(loadBalancerID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -109,7 +120,11 @@ func ListListenersFailJSONMocked(t *testing.T, loadBalancerID string, listenersI
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkLoadBalancerListeners, loadBalancerID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkLoadBalancerListeners, loadBalancerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	listenersOut, err := ds.ListListeners(loadBalancerID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -689,7 +704,8 @@ func ListRulesMocked(t *testing.T, listenerID string, rulesIn []*types.ListenerR
 	assert.Nil(err, "Rules test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkListenerRules, listenerID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathNetworkListenerRules, listenerID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	rulesOut, err := ds.ListRules(listenerID)
 
 	assert.Nil(err, "Error getting rules")
@@ -714,7 +730,8 @@ func ListRulesFailErrMocked(t *testing.T, listenerID string, rulesIn []*types.Li
 	assert.Nil(err, "Rules test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkListenerRules, listenerID)).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathNetworkListenerRules, listenerID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	rulesOut, err := ds.ListRules(listenerID)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -740,7 +757,8 @@ func ListRulesFailStatusMocked(t *testing.T, listenerID string, rulesIn []*types
 	assert.Nil(err, "Rules test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkListenerRules, listenerID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathNetworkListenerRules, listenerID), 1, utils.DefaultPageSize)).
+		Return(dIn, 499, nil)
 	rulesOut, err := ds.ListRules(listenerID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -765,7 +783,8 @@ func ListRulesFailJSONMocked(t *testing.T, listenerID string, rulesIn []*types.L
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkListenerRules, listenerID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathNetworkListenerRules, listenerID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	rulesOut, err := ds.ListRules(listenerID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
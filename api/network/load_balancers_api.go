@@ -3,6 +3,7 @@
 package network
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -34,30 +35,67 @@ func NewLoadBalancerService(concertoService utils.ConcertoService) (*LoadBalance
 
 // ListLoadBalancers returns the list of load balancers as an array of LoadBalancer
 func (lbs *LoadBalancerService) ListLoadBalancers() (loadBalancers []*types.LoadBalancer, err error) {
-	log.Debug("ListLoadBalancers")
+	return lbs.ListLoadBalancersContext(context.Background())
+}
 
-	data, status, err := lbs.concertoService.Get(APIPathNetworkLoadBalancers)
+// ListLoadBalancersContext is like ListLoadBalancers, but the request is cancelled as soon as ctx is done
+func (lbs *LoadBalancerService) ListLoadBalancersContext(
+	ctx context.Context,
+) (loadBalancers []*types.LoadBalancer, err error) {
+	log.Debug("ListLoadBalancers")
 
+	loadBalancers = []*types.LoadBalancer{}
+	err = lbs.ListLoadBalancersPagesContext(ctx, func(page []*types.LoadBalancer) bool {
+		loadBalancers = append(loadBalancers, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return loadBalancers, nil
+}
 
-	if err = json.Unmarshal(data, &loadBalancers); err != nil {
-		return nil, err
-	}
+// ListLoadBalancersPages is like ListLoadBalancers, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (lbs *LoadBalancerService) ListLoadBalancersPages(fn func(loadBalancers []*types.LoadBalancer) bool) error {
+	return lbs.ListLoadBalancersPagesContext(context.Background(), fn)
+}
 
-	return loadBalancers, nil
+// ListLoadBalancersPagesContext is like ListLoadBalancersPages, but the requests are cancelled as soon as ctx is done.
+// The page options carried by ctx are applied
+func (lbs *LoadBalancerService) ListLoadBalancersPagesContext(
+	ctx context.Context,
+	fn func(loadBalancers []*types.LoadBalancer) bool,
+) error {
+	log.Debug("ListLoadBalancersPages")
+
+	pager := utils.NewPager(ctx, lbs.concertoService, APIPathNetworkLoadBalancers)
+	for pager.Next() {
+		var loadBalancers []*types.LoadBalancer
+		if err := pager.Decode(&loadBalancers); err != nil {
+			return err
+		}
+		if !fn(loadBalancers) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetLoadBalancer returns a load balancer by its ID
 func (lbs *LoadBalancerService) GetLoadBalancer(loadBalancerID string) (loadBalancer *types.LoadBalancer, err error) {
+	return lbs.GetLoadBalancerContext(context.Background(), loadBalancerID)
+}
+
+// GetLoadBalancerContext is like GetLoadBalancer, but the request is cancelled as soon as ctx is done
+func (lbs *LoadBalancerService) GetLoadBalancerContext(
+	ctx context.Context,
+	loadBalancerID string,
+) (loadBalancer *types.LoadBalancer, err error) {
 	log.Debug("GetLoadBalancer")
 
-	data, status, err := lbs.concertoService.Get(fmt.Sprintf(APIPathNetworkLoadBalancer, loadBalancerID))
+	data, status, err := lbs.concertoService.GetContext(ctx, fmt.Sprintf(APIPathNetworkLoadBalancer, loadBalancerID))
 	if err != nil {
 		return nil, err
 	}
@@ -76,10 +114,18 @@ func (lbs *LoadBalancerService) GetLoadBalancer(loadBalancerID string) (loadBala
 // CreateLoadBalancer creates a load balancer
 func (lbs *LoadBalancerService) CreateLoadBalancer(
 	loadBalancerParams *map[string]interface{},
+) (loadBalancer *types.LoadBalancer, err error) {
+	return lbs.CreateLoadBalancerContext(context.Background(), loadBalancerParams)
+}
+
+// CreateLoadBalancerContext is like CreateLoadBalancer, but the request is cancelled as soon as ctx is done
+func (lbs *LoadBalancerService) CreateLoadBalancerContext(
+	ctx context.Context,
+	loadBalancerParams *map[string]interface{},
 ) (loadBalancer *types.LoadBalancer, err error) {
 	log.Debug("CreateLoadBalancer")
 
-	data, status, err := lbs.concertoService.Post(APIPathNetworkLoadBalancers, loadBalancerParams)
+	data, status, err := lbs.concertoService.PostContext(ctx, APIPathNetworkLoadBalancers, loadBalancerParams)
 	if err != nil {
 		return nil, err
 	}
@@ -99,10 +145,20 @@ func (lbs *LoadBalancerService) CreateLoadBalancer(
 func (lbs *LoadBalancerService) UpdateLoadBalancer(
 	loadBalancerID string,
 	loadBalancerParams *map[string]interface{},
+) (loadBalancer *types.LoadBalancer, err error) {
+	return lbs.UpdateLoadBalancerContext(context.Background(), loadBalancerID, loadBalancerParams)
+}
+
+// UpdateLoadBalancerContext is like UpdateLoadBalancer, but the request is cancelled as soon as ctx is done
+func (lbs *LoadBalancerService) UpdateLoadBalancerContext(
+	ctx context.Context,
+	loadBalancerID string,
+	loadBalancerParams *map[string]interface{},
 ) (loadBalancer *types.LoadBalancer, err error) {
 	log.Debug("UpdateLoadBalancer")
 
-	data, status, err := lbs.concertoService.Put(
+	data, status, err := lbs.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkLoadBalancer, loadBalancerID),
 		loadBalancerParams,
 	)
@@ -124,10 +180,18 @@ func (lbs *LoadBalancerService) UpdateLoadBalancer(
 // DeleteLoadBalancer deletes a load balancer by its ID
 func (lbs *LoadBalancerService) DeleteLoadBalancer(
 	loadBalancerID string,
+) (loadBalancer *types.LoadBalancer, err error) {
+	return lbs.DeleteLoadBalancerContext(context.Background(), loadBalancerID)
+}
+
+// DeleteLoadBalancerContext is like DeleteLoadBalancer, but the request is cancelled as soon as ctx is done
+func (lbs *LoadBalancerService) DeleteLoadBalancerContext(
+	ctx context.Context,
+	loadBalancerID string,
 ) (loadBalancer *types.LoadBalancer, err error) {
 	log.Debug("DeleteLoadBalancer")
 
-	data, status, err := lbs.concertoService.Delete(fmt.Sprintf(APIPathNetworkLoadBalancer, loadBalancerID))
+	data, status, err := lbs.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathNetworkLoadBalancer, loadBalancerID))
 	if err != nil {
 		return nil, err
 	}
@@ -147,10 +211,20 @@ func (lbs *LoadBalancerService) DeleteLoadBalancer(
 func (lbs *LoadBalancerService) RetryLoadBalancer(
 	loadBalancerID string,
 	loadBalancerParams *map[string]interface{},
+) (loadBalancer *types.LoadBalancer, err error) {
+	return lbs.RetryLoadBalancerContext(context.Background(), loadBalancerID, loadBalancerParams)
+}
+
+// RetryLoadBalancerContext is like RetryLoadBalancer, but the request is cancelled as soon as ctx is done
+func (lbs *LoadBalancerService) RetryLoadBalancerContext(
+	ctx context.Context,
+	loadBalancerID string,
+	loadBalancerParams *map[string]interface{},
 ) (loadBalancer *types.LoadBalancer, err error) {
 	log.Debug("RetryLoadBalancer")
 
-	data, status, err := lbs.concertoService.Put(
+	data, status, err := lbs.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkLoadBalancerRetry, loadBalancerID),
 		loadBalancerParams,
 	)
@@ -172,10 +246,21 @@ func (lbs *LoadBalancerService) RetryLoadBalancer(
 // GetLoadBalancerPlan returns a load balancer plan by its ID
 func (lbs *LoadBalancerService) GetLoadBalancerPlan(
 	loadBalancerPlanID string,
+) (loadBalancerPlan *types.LoadBalancerPlan, err error) {
+	return lbs.GetLoadBalancerPlanContext(context.Background(), loadBalancerPlanID)
+}
+
+// GetLoadBalancerPlanContext is like GetLoadBalancerPlan, but the request is cancelled as soon as ctx is done
+func (lbs *LoadBalancerService) GetLoadBalancerPlanContext(
+	ctx context.Context,
+	loadBalancerPlanID string,
 ) (loadBalancerPlan *types.LoadBalancerPlan, err error) {
 	log.Debug("GetLoadBalancerPlan")
 
-	data, status, err := lbs.concertoService.Get(fmt.Sprintf(APIPathNetworkLoadBalancerPlan, loadBalancerPlanID))
+	data, status, err := lbs.concertoService.GetContext(
+		ctx,
+		fmt.Sprintf(APIPathNetworkLoadBalancerPlan, loadBalancerPlanID),
+	)
 	if err != nil {
 		return nil, err
 	}
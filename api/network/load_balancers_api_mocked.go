@@ -28,7 +28,7 @@ func ListLoadBalancersMocked(t *testing.T, loadBalancersIn []*types.LoadBalancer
 	assert.Nil(err, "LoadBalancers test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathNetworkLoadBalancers).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathNetworkLoadBalancers, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	loadBalancersOut, err := ds.ListLoadBalancers()
 
 	assert.Nil(err, "Error getting load balancers")
@@ -53,7 +53,8 @@ func ListLoadBalancersFailErrMocked(t *testing.T, loadBalancersIn []*types.LoadB
 	assert.Nil(err, "LoadBalancers test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathNetworkLoadBalancers).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathNetworkLoadBalancers, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	loadBalancersOut, err := ds.ListLoadBalancers()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -79,7 +80,7 @@ func ListLoadBalancersFailStatusMocked(t *testing.T, loadBalancersIn []*types.Lo
 	assert.Nil(err, "LoadBalancers test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathNetworkLoadBalancers).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathNetworkLoadBalancers, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	loadBalancersOut, err := ds.ListLoadBalancers()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -104,7 +105,7 @@ func ListLoadBalancersFailJSONMocked(t *testing.T, loadBalancersIn []*types.Load
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathNetworkLoadBalancers).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathNetworkLoadBalancers, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	loadBalancersOut, err := ds.ListLoadBalancers()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
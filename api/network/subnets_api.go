@@ -3,6 +3,7 @@
 package network
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -34,30 +35,62 @@ func NewSubnetService(concertoService utils.ConcertoService) (*SubnetService, er
 
 // ListSubnets returns the list of Subnets of a VPC as an array of Subnet
 func (ss *SubnetService) ListSubnets(vpcID string) (subnets []*types.Subnet, err error) {
-	log.Debug("ListSubnets")
+	return ss.ListSubnetsContext(context.Background(), vpcID)
+}
 
-	data, status, err := ss.concertoService.Get(fmt.Sprintf(APIPathNetworkVpcSubnets, vpcID))
+// ListSubnetsContext is like ListSubnets, but the request is cancelled as soon as ctx is done
+func (ss *SubnetService) ListSubnetsContext(ctx context.Context, vpcID string) (subnets []*types.Subnet, err error) {
+	log.Debug("ListSubnets")
 
+	subnets = []*types.Subnet{}
+	err = ss.ListSubnetsPagesContext(ctx, vpcID, func(page []*types.Subnet) bool {
+		subnets = append(subnets, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return subnets, nil
+}
 
-	if err = json.Unmarshal(data, &subnets); err != nil {
-		return nil, err
-	}
+// ListSubnetsPages is like ListSubnets, but the list is streamed to fn one page at a time, until fn returns false
+func (ss *SubnetService) ListSubnetsPages(vpcID string, fn func(subnets []*types.Subnet) bool) error {
+	return ss.ListSubnetsPagesContext(context.Background(), vpcID, fn)
+}
 
-	return subnets, nil
+// ListSubnetsPagesContext is like ListSubnetsPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (ss *SubnetService) ListSubnetsPagesContext(
+	ctx context.Context,
+	vpcID string,
+	fn func(subnets []*types.Subnet) bool,
+) error {
+	log.Debug("ListSubnetsPages")
+
+	pager := utils.NewPager(ctx, ss.concertoService, fmt.Sprintf(APIPathNetworkVpcSubnets, vpcID))
+	for pager.Next() {
+		var subnets []*types.Subnet
+		if err := pager.Decode(&subnets); err != nil {
+			return err
+		}
+		if !fn(subnets) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetSubnet returns a Subnet by its ID
 func (ss *SubnetService) GetSubnet(subnetID string) (subnet *types.Subnet, err error) {
+	return ss.GetSubnetContext(context.Background(), subnetID)
+}
+
+// GetSubnetContext is like GetSubnet, but the request is cancelled as soon as ctx is done
+func (ss *SubnetService) GetSubnetContext(ctx context.Context, subnetID string) (subnet *types.Subnet, err error) {
 	log.Debug("GetSubnet")
 
-	data, status, err := ss.concertoService.Get(fmt.Sprintf(APIPathNetworkSubnet, subnetID))
+	data, status, err := ss.concertoService.GetContext(ctx, fmt.Sprintf(APIPathNetworkSubnet, subnetID))
 	if err != nil {
 		return nil, err
 	}
@@ -77,10 +110,19 @@ func (ss *SubnetService) GetSubnet(subnetID string) (subnet *types.Subnet, err e
 func (ss *SubnetService) CreateSubnet(
 	vpcID string,
 	subnetParams *map[string]interface{},
+) (subnet *types.Subnet, err error) {
+	return ss.CreateSubnetContext(context.Background(), vpcID, subnetParams)
+}
+
+// CreateSubnetContext is like CreateSubnet, but the request is cancelled as soon as ctx is done
+func (ss *SubnetService) CreateSubnetContext(
+	ctx context.Context,
+	vpcID string,
+	subnetParams *map[string]interface{},
 ) (subnet *types.Subnet, err error) {
 	log.Debug("CreateSubnet")
 
-	data, status, err := ss.concertoService.Post(fmt.Sprintf(APIPathNetworkVpcSubnets, vpcID), subnetParams)
+	data, status, err := ss.concertoService.PostContext(ctx, fmt.Sprintf(APIPathNetworkVpcSubnets, vpcID), subnetParams)
 
 	if err != nil {
 		return nil, err
@@ -97,14 +139,44 @@ func (ss *SubnetService) CreateSubnet(
 	return subnet, nil
 }
 
+// CreateSubnetWithInput is like CreateSubnet, but takes a typed input, which is validated before being sent
+func (ss *SubnetService) CreateSubnetWithInput(
+	vpcID string,
+	input *types.SubnetCreateInput,
+) (subnet *types.Subnet, err error) {
+	return ss.CreateSubnetWithInputContext(context.Background(), vpcID, input)
+}
+
+// CreateSubnetWithInputContext is like CreateSubnetWithInput, but the request is cancelled as soon as ctx is done
+func (ss *SubnetService) CreateSubnetWithInputContext(
+	ctx context.Context,
+	vpcID string,
+	input *types.SubnetCreateInput,
+) (subnet *types.Subnet, err error) {
+	subnetParams, err := types.InputParams(input)
+	if err != nil {
+		return nil, err
+	}
+	return ss.CreateSubnetContext(ctx, vpcID, subnetParams)
+}
+
 // UpdateSubnet updates a Subnet by its ID
 func (ss *SubnetService) UpdateSubnet(
 	subnetID string,
 	subnetParams *map[string]interface{},
+) (subnet *types.Subnet, err error) {
+	return ss.UpdateSubnetContext(context.Background(), subnetID, subnetParams)
+}
+
+// UpdateSubnetContext is like UpdateSubnet, but the request is cancelled as soon as ctx is done
+func (ss *SubnetService) UpdateSubnetContext(
+	ctx context.Context,
+	subnetID string,
+	subnetParams *map[string]interface{},
 ) (subnet *types.Subnet, err error) {
 	log.Debug("UpdateSubnet")
 
-	data, status, err := ss.concertoService.Put(fmt.Sprintf(APIPathNetworkSubnet, subnetID), subnetParams)
+	data, status, err := ss.concertoService.PutContext(ctx, fmt.Sprintf(APIPathNetworkSubnet, subnetID), subnetParams)
 
 	if err != nil {
 		return nil, err
@@ -121,11 +193,37 @@ func (ss *SubnetService) UpdateSubnet(
 	return subnet, nil
 }
 
+// UpdateSubnetWithInput is like UpdateSubnet, but takes a typed input, which is validated before being sent
+func (ss *SubnetService) UpdateSubnetWithInput(
+	subnetID string,
+	input *types.SubnetUpdateInput,
+) (subnet *types.Subnet, err error) {
+	return ss.UpdateSubnetWithInputContext(context.Background(), subnetID, input)
+}
+
+// UpdateSubnetWithInputContext is like UpdateSubnetWithInput, but the request is cancelled as soon as ctx is done
+func (ss *SubnetService) UpdateSubnetWithInputContext(
+	ctx context.Context,
+	subnetID string,
+	input *types.SubnetUpdateInput,
+) (subnet *types.Subnet, err error) {
+	subnetParams, err := types.InputParams(input)
+	if err != nil {
+		return nil, err
+	}
+	return ss.UpdateSubnetContext(ctx, subnetID, subnetParams)
+}
+
 // DeleteSubnet deletes a Subnet by its ID
 func (ss *SubnetService) DeleteSubnet(subnetID string) (err error) {
+	return ss.DeleteSubnetContext(context.Background(), subnetID)
+}
+
+// DeleteSubnetContext is like DeleteSubnet, but the request is cancelled as soon as ctx is done
+func (ss *SubnetService) DeleteSubnetContext(ctx context.Context, subnetID string) (err error) {
 	log.Debug("DeleteSubnet")
 
-	data, status, err := ss.concertoService.Delete(fmt.Sprintf(APIPathNetworkSubnet, subnetID))
+	data, status, err := ss.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathNetworkSubnet, subnetID))
 	if err != nil {
 		return err
 	}
@@ -139,42 +237,107 @@ func (ss *SubnetService) DeleteSubnet(subnetID string) (err error) {
 
 // ListSubnetServers returns the list of Servers of a Subnet as an array of Server
 func (ss *SubnetService) ListSubnetServers(subnetID string) (servers []*types.Server, err error) {
-	log.Debug("ListSubnetServers")
+	return ss.ListSubnetServersContext(context.Background(), subnetID)
+}
 
-	data, status, err := ss.concertoService.Get(fmt.Sprintf(APIPathNetworkSubnetServers, subnetID))
+// ListSubnetServersContext is like ListSubnetServers, but the request is cancelled as soon as ctx is done
+func (ss *SubnetService) ListSubnetServersContext(
+	ctx context.Context,
+	subnetID string,
+) (servers []*types.Server, err error) {
+	log.Debug("ListSubnetServers")
 
+	servers = []*types.Server{}
+	err = ss.ListSubnetServersPagesContext(ctx, subnetID, func(page []*types.Server) bool {
+		servers = append(servers, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return servers, nil
+}
 
-	if err = json.Unmarshal(data, &servers); err != nil {
-		return nil, err
-	}
+// ListSubnetServersPages is like ListSubnetServers, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (ss *SubnetService) ListSubnetServersPages(subnetID string, fn func(servers []*types.Server) bool) error {
+	return ss.ListSubnetServersPagesContext(context.Background(), subnetID, fn)
+}
 
-	return servers, nil
+// ListSubnetServersPagesContext is like ListSubnetServersPages, but the requests are cancelled as soon as ctx is done.
+// The page options carried by ctx are applied
+func (ss *SubnetService) ListSubnetServersPagesContext(
+	ctx context.Context,
+	subnetID string,
+	fn func(servers []*types.Server) bool,
+) error {
+	log.Debug("ListSubnetServersPages")
+
+	pager := utils.NewPager(ctx, ss.concertoService, fmt.Sprintf(APIPathNetworkSubnetServers, subnetID))
+	for pager.Next() {
+		var servers []*types.Server
+		if err := pager.Decode(&servers); err != nil {
+			return err
+		}
+		if !fn(servers) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // ListSubnetServerArrays returns the list of Server arrays of a Subnet as an array of ServerArray
 func (ss *SubnetService) ListSubnetServerArrays(subnetID string) (serverArrays []*types.ServerArray, err error) {
-	log.Debug("ListSubnetServerArrays")
+	return ss.ListSubnetServerArraysContext(context.Background(), subnetID)
+}
 
-	data, status, err := ss.concertoService.Get(fmt.Sprintf(APIPathNetworkSubnetServerArrays, subnetID))
+// ListSubnetServerArraysContext is like ListSubnetServerArrays, but the request is cancelled as soon as ctx is done
+func (ss *SubnetService) ListSubnetServerArraysContext(
+	ctx context.Context,
+	subnetID string,
+) (serverArrays []*types.ServerArray, err error) {
+	log.Debug("ListSubnetServerArrays")
 
+	serverArrays = []*types.ServerArray{}
+	err = ss.ListSubnetServerArraysPagesContext(ctx, subnetID, func(page []*types.ServerArray) bool {
+		serverArrays = append(serverArrays, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return serverArrays, nil
+}
 
-	if err = json.Unmarshal(data, &serverArrays); err != nil {
-		return nil, err
-	}
+// ListSubnetServerArraysPages is like ListSubnetServerArrays, but the list is streamed to fn one page at a time, until
+// fn returns false
+func (ss *SubnetService) ListSubnetServerArraysPages(
+	subnetID string,
+	fn func(serverArrays []*types.ServerArray) bool,
+) error {
+	return ss.ListSubnetServerArraysPagesContext(context.Background(), subnetID, fn)
+}
 
-	return serverArrays, nil
+// ListSubnetServerArraysPagesContext is like ListSubnetServerArraysPages, but the requests are cancelled as soon as ctx
+// is done. The page options carried by ctx are applied
+func (ss *SubnetService) ListSubnetServerArraysPagesContext(
+	ctx context.Context,
+	subnetID string,
+	fn func(serverArrays []*types.ServerArray) bool,
+) error {
+	log.Debug("ListSubnetServerArraysPages")
+
+	pager := utils.NewPager(ctx, ss.concertoService, fmt.Sprintf(APIPathNetworkSubnetServerArrays, subnetID))
+	for pager.Next() {
+		var serverArrays []*types.ServerArray
+		if err := pager.Decode(&serverArrays); err != nil {
+			return err
+		}
+		if !fn(serverArrays) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
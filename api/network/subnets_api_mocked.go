@@ -30,7 +30,8 @@ func ListSubnetsMocked(t *testing.T, subnetsIn []*types.Subnet) []*types.Subnet
 	assert.Nil(err, "Subnet test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkVpcSubnets, subnetsIn[0].VpcID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathNetworkVpcSubnets, subnetsIn[0].VpcID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	subnetsOut, err := ds.ListSubnets(subnetsIn[0].VpcID)
 	assert.Nil(err, "Error getting Subnet list")
 	assert.Equal(subnetsIn, subnetsOut, "ListSubnets returned different Subnets")
@@ -54,7 +55,7 @@ func ListSubnetsFailErrMocked(t *testing.T, subnetsIn []*types.Subnet) []*types.
 	assert.Nil(err, "Subnet test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkVpcSubnets, subnetsIn[0].VpcID)).
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathNetworkVpcSubnets, subnetsIn[0].VpcID), 1, utils.DefaultPageSize)).
 		Return(dIn, 200, fmt.Errorf("mocked error"))
 	subnetsOut, err := ds.ListSubnets(subnetsIn[0].VpcID)
 
@@ -81,7 +82,8 @@ func ListSubnetsFailStatusMocked(t *testing.T, subnetsIn []*types.Subnet) []*typ
 	assert.Nil(err, "Subnet test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkVpcSubnets, subnetsIn[0].VpcID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathNetworkVpcSubnets, subnetsIn[0].VpcID), 1, utils.DefaultPageSize)).
+		Return(dIn, 499, nil)
 	subnetsOut, err := ds.ListSubnets(subnetsIn[0].VpcID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -106,7 +108,8 @@ func ListSubnetsFailJSONMocked(t *testing.T, subnetsIn []*types.Subnet) []*types
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkVpcSubnets, subnetsIn[0].VpcID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathNetworkVpcSubnets, subnetsIn[0].VpcID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	subnetsOut, err := ds.ListSubnets(subnetsIn[0].VpcID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -534,7 +537,11 @@ func ListSubnetServersMocked(t *testing.T, serversIn []*types.Server) []*types.S
 	assert.Nil(err, "Subnet test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkSubnetServers, serversIn[0].SubnetID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkSubnetServers, serversIn[0].SubnetID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	serversOut, err := ds.ListSubnetServers(serversIn[0].SubnetID)
 	assert.Nil(err, "Error getting Subnet servers list")
 	assert.Equal(serversIn, serversOut, "ListSubnetServers returned different Servers")
@@ -558,8 +565,11 @@ func ListSubnetServersFailErrMocked(t *testing.T, serversIn []*types.Server) []*
 	assert.Nil(err, "Subnet test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkSubnetServers, serversIn[0].SubnetID)).
-		Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkSubnetServers, serversIn[0].SubnetID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, fmt.Errorf("mocked error"))
 	serversOut, err := ds.ListSubnetServers(serversIn[0].SubnetID)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -585,7 +595,11 @@ func ListSubnetServersFailStatusMocked(t *testing.T, serversIn []*types.Server)
 	assert.Nil(err, "Subnet test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkSubnetServers, serversIn[0].SubnetID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkSubnetServers, serversIn[0].SubnetID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 499, nil)
 	serversOut, err := ds.ListSubnetServers(serversIn[0].SubnetID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -610,7 +624,11 @@ func ListSubnetServersFailJSONMocked(t *testing.T, serversIn []*types.Server) []
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkSubnetServers, serversIn[0].SubnetID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkSubnetServers, serversIn[0].SubnetID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	serversOut, err := ds.ListSubnetServers(serversIn[0].SubnetID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -636,7 +654,11 @@ func ListSubnetServerArraysMocked(t *testing.T, serverArraysIn []*types.ServerAr
 	assert.Nil(err, "Subnet test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkSubnetServerArrays, serverArraysIn[0].SubnetID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkSubnetServerArrays, serverArraysIn[0].SubnetID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	serverArraysOut, err := ds.ListSubnetServerArrays(serverArraysIn[0].SubnetID)
 	assert.Nil(err, "Error getting Subnet server array list")
 	assert.Equal(serverArraysIn, serverArraysOut, "ListSubnetServerArrays returned different Server arrays")
@@ -660,8 +682,11 @@ func ListSubnetServerArraysFailErrMocked(t *testing.T, serverArraysIn []*types.S
 	assert.Nil(err, "Subnet test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkSubnetServerArrays, serverArraysIn[0].SubnetID)).
-		Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkSubnetServerArrays, serverArraysIn[0].SubnetID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, fmt.Errorf("mocked error"))
 	serverArraysOut, err := ds.ListSubnetServerArrays(serverArraysIn[0].SubnetID)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -687,7 +712,11 @@ func ListSubnetServerArraysFailStatusMocked(t *testing.T, serverArraysIn []*type
 	assert.Nil(err, "Subnet test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkSubnetServerArrays, serverArraysIn[0].SubnetID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkSubnetServerArrays, serverArraysIn[0].SubnetID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 499, nil)
 	serverArraysOut, err := ds.ListSubnetServerArrays(serverArraysIn[0].SubnetID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -712,7 +741,11 @@ func ListSubnetServerArraysFailJSONMocked(t *testing.T, serverArraysIn []*types.
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkSubnetServerArrays, serverArraysIn[0].SubnetID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkSubnetServerArrays, serverArraysIn[0].SubnetID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	serverArraysOut, err := ds.ListSubnetServerArrays(serverArraysIn[0].SubnetID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
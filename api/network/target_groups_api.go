@@ -3,6 +3,7 @@
 package network
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -35,32 +36,76 @@ func NewTargetGroupService(concertoService utils.ConcertoService) (*TargetGroupS
 
 // ListTargetGroups returns the list of target groups in a load balancer by its ID, as an array of TargetGroup
 func (tgs *TargetGroupService) ListTargetGroups(loadBalancerID string) (targetGroups []*types.TargetGroup, err error) {
-	log.Debug("ListTargetGroups")
+	return tgs.ListTargetGroupsContext(context.Background(), loadBalancerID)
+}
 
-	data, status, err := tgs.concertoService.Get(
-		fmt.Sprintf(APIPathNetworkLoadBalancerTargetGroups, loadBalancerID),
-	)
+// ListTargetGroupsContext is like ListTargetGroups, but the request is cancelled as soon as ctx is done
+func (tgs *TargetGroupService) ListTargetGroupsContext(
+	ctx context.Context,
+	loadBalancerID string,
+) (targetGroups []*types.TargetGroup, err error) {
+	log.Debug("ListTargetGroups")
 
+	targetGroups = []*types.TargetGroup{}
+	err = tgs.ListTargetGroupsPagesContext(ctx, loadBalancerID, func(page []*types.TargetGroup) bool {
+		targetGroups = append(targetGroups, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return targetGroups, nil
+}
 
-	if err = json.Unmarshal(data, &targetGroups); err != nil {
-		return nil, err
-	}
+// ListTargetGroupsPages is like ListTargetGroups, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (tgs *TargetGroupService) ListTargetGroupsPages(
+	loadBalancerID string,
+	fn func(targetGroups []*types.TargetGroup) bool,
+) error {
+	return tgs.ListTargetGroupsPagesContext(context.Background(), loadBalancerID, fn)
+}
 
-	return targetGroups, nil
+// ListTargetGroupsPagesContext is like ListTargetGroupsPages, but the requests are cancelled as soon as ctx is done.
+// The page options carried by ctx are applied
+func (tgs *TargetGroupService) ListTargetGroupsPagesContext(
+	ctx context.Context,
+	loadBalancerID string,
+	fn func(targetGroups []*types.TargetGroup) bool,
+) error {
+	log.Debug("ListTargetGroupsPages")
+
+	pager := utils.NewPager(
+		ctx,
+		tgs.concertoService,
+		fmt.Sprintf(APIPathNetworkLoadBalancerTargetGroups, loadBalancerID),
+	)
+	for pager.Next() {
+		var targetGroups []*types.TargetGroup
+		if err := pager.Decode(&targetGroups); err != nil {
+			return err
+		}
+		if !fn(targetGroups) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetTargetGroup returns a target group by its ID
 func (tgs *TargetGroupService) GetTargetGroup(targetGroupID string) (targetGroup *types.TargetGroup, err error) {
+	return tgs.GetTargetGroupContext(context.Background(), targetGroupID)
+}
+
+// GetTargetGroupContext is like GetTargetGroup, but the request is cancelled as soon as ctx is done
+func (tgs *TargetGroupService) GetTargetGroupContext(
+	ctx context.Context,
+	targetGroupID string,
+) (targetGroup *types.TargetGroup, err error) {
 	log.Debug("GetTargetGroup")
 
-	data, status, err := tgs.concertoService.Get(fmt.Sprintf(APIPathNetworkTargetGroup, targetGroupID))
+	data, status, err := tgs.concertoService.GetContext(ctx, fmt.Sprintf(APIPathNetworkTargetGroup, targetGroupID))
 	if err != nil {
 		return nil, err
 	}
@@ -80,10 +125,20 @@ func (tgs *TargetGroupService) GetTargetGroup(targetGroupID string) (targetGroup
 func (tgs *TargetGroupService) CreateTargetGroup(
 	loadBalancerID string,
 	targetGroupParams *map[string]interface{},
+) (targetGroup *types.TargetGroup, err error) {
+	return tgs.CreateTargetGroupContext(context.Background(), loadBalancerID, targetGroupParams)
+}
+
+// CreateTargetGroupContext is like CreateTargetGroup, but the request is cancelled as soon as ctx is done
+func (tgs *TargetGroupService) CreateTargetGroupContext(
+	ctx context.Context,
+	loadBalancerID string,
+	targetGroupParams *map[string]interface{},
 ) (targetGroup *types.TargetGroup, err error) {
 	log.Debug("CreateTargetGroup")
 
-	data, status, err := tgs.concertoService.Post(
+	data, status, err := tgs.concertoService.PostContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkLoadBalancerTargetGroups, loadBalancerID),
 		targetGroupParams,
 	)
@@ -106,10 +161,20 @@ func (tgs *TargetGroupService) CreateTargetGroup(
 func (tgs *TargetGroupService) UpdateTargetGroup(
 	targetGroupID string,
 	targetGroupParams *map[string]interface{},
+) (targetGroup *types.TargetGroup, err error) {
+	return tgs.UpdateTargetGroupContext(context.Background(), targetGroupID, targetGroupParams)
+}
+
+// UpdateTargetGroupContext is like UpdateTargetGroup, but the request is cancelled as soon as ctx is done
+func (tgs *TargetGroupService) UpdateTargetGroupContext(
+	ctx context.Context,
+	targetGroupID string,
+	targetGroupParams *map[string]interface{},
 ) (targetGroup *types.TargetGroup, err error) {
 	log.Debug("UpdateTargetGroup")
 
-	data, status, err := tgs.concertoService.Put(
+	data, status, err := tgs.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkTargetGroup, targetGroupID),
 		targetGroupParams,
 	)
@@ -130,9 +195,17 @@ func (tgs *TargetGroupService) UpdateTargetGroup(
 
 // DeleteTargetGroup deletes a target group by its ID
 func (tgs *TargetGroupService) DeleteTargetGroup(targetGroupID string) (targetGroup *types.TargetGroup, err error) {
+	return tgs.DeleteTargetGroupContext(context.Background(), targetGroupID)
+}
+
+// DeleteTargetGroupContext is like DeleteTargetGroup, but the request is cancelled as soon as ctx is done
+func (tgs *TargetGroupService) DeleteTargetGroupContext(
+	ctx context.Context,
+	targetGroupID string,
+) (targetGroup *types.TargetGroup, err error) {
 	log.Debug("DeleteTargetGroup")
 
-	data, status, err := tgs.concertoService.Delete(fmt.Sprintf(APIPathNetworkTargetGroup, targetGroupID))
+	data, status, err := tgs.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathNetworkTargetGroup, targetGroupID))
 	if err != nil {
 		return nil, err
 	}
@@ -152,10 +225,20 @@ func (tgs *TargetGroupService) DeleteTargetGroup(targetGroupID string) (targetGr
 func (tgs *TargetGroupService) RetryTargetGroup(
 	targetGroupID string,
 	targetGroupParams *map[string]interface{},
+) (targetGroup *types.TargetGroup, err error) {
+	return tgs.RetryTargetGroupContext(context.Background(), targetGroupID, targetGroupParams)
+}
+
+// RetryTargetGroupContext is like RetryTargetGroup, but the request is cancelled as soon as ctx is done
+func (tgs *TargetGroupService) RetryTargetGroupContext(
+	ctx context.Context,
+	targetGroupID string,
+	targetGroupParams *map[string]interface{},
 ) (targetGroup *types.TargetGroup, err error) {
 	log.Debug("RetryTargetGroup")
 
-	data, status, err := tgs.concertoService.Put(
+	data, status, err := tgs.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkTargetGroupRetry, targetGroupID),
 		targetGroupParams,
 	)
@@ -176,32 +259,73 @@ func (tgs *TargetGroupService) RetryTargetGroup(
 
 // ListTargets returns the list of targets in a target group by its ID, as an array of Target
 func (tgs *TargetGroupService) ListTargets(targetGroupID string) (targets []*types.Target, err error) {
+	return tgs.ListTargetsContext(context.Background(), targetGroupID)
+}
+
+// ListTargetsContext is like ListTargets, but the request is cancelled as soon as ctx is done
+func (tgs *TargetGroupService) ListTargetsContext(
+	ctx context.Context,
+	targetGroupID string,
+) (targets []*types.Target, err error) {
 	log.Debug("ListTargets")
 
-	data, status, err := tgs.concertoService.Get(fmt.Sprintf(APIPathNetworkTargetGroupTargets, targetGroupID))
+	targets = []*types.Target{}
+	err = tgs.ListTargetsPagesContext(ctx, targetGroupID, func(page []*types.Target) bool {
+		targets = append(targets, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return targets, nil
+}
 
-	if err = json.Unmarshal(data, &targets); err != nil {
-		return nil, err
-	}
+// ListTargetsPages is like ListTargets, but the list is streamed to fn one page at a time, until fn returns false
+func (tgs *TargetGroupService) ListTargetsPages(targetGroupID string, fn func(targets []*types.Target) bool) error {
+	return tgs.ListTargetsPagesContext(context.Background(), targetGroupID, fn)
+}
 
-	return targets, nil
+// ListTargetsPagesContext is like ListTargetsPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (tgs *TargetGroupService) ListTargetsPagesContext(
+	ctx context.Context,
+	targetGroupID string,
+	fn func(targets []*types.Target) bool,
+) error {
+	log.Debug("ListTargetsPages")
+
+	pager := utils.NewPager(ctx, tgs.concertoService, fmt.Sprintf(APIPathNetworkTargetGroupTargets, targetGroupID))
+	for pager.Next() {
+		var targets []*types.Target
+		if err := pager.Decode(&targets); err != nil {
+			return err
+		}
+		if !fn(targets) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // CreateTarget creates a target in a target group by its ID
 func (tgs *TargetGroupService) CreateTarget(
 	targetGroupID string,
 	targetParams *map[string]interface{},
+) (target *types.Target, err error) {
+	return tgs.CreateTargetContext(context.Background(), targetGroupID, targetParams)
+}
+
+// CreateTargetContext is like CreateTarget, but the request is cancelled as soon as ctx is done
+func (tgs *TargetGroupService) CreateTargetContext(
+	ctx context.Context,
+	targetGroupID string,
+	targetParams *map[string]interface{},
 ) (target *types.Target, err error) {
 	log.Debug("CreateTarget")
 
-	data, status, err := tgs.concertoService.Post(
+	data, status, err := tgs.concertoService.PostContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkTargetGroupTargets, targetGroupID),
 		targetParams,
 	)
@@ -225,10 +349,21 @@ func (tgs *TargetGroupService) DeleteTarget(
 	targetGroupID string,
 	targetResourceType string,
 	targetResourceID string,
+) (err error) {
+	return tgs.DeleteTargetContext(context.Background(), targetGroupID, targetResourceType, targetResourceID)
+}
+
+// DeleteTargetContext is like DeleteTarget, but the request is cancelled as soon as ctx is done
+func (tgs *TargetGroupService) DeleteTargetContext(
+	ctx context.Context,
+	targetGroupID string,
+	targetResourceType string,
+	targetResourceID string,
 ) (err error) {
 	log.Debug("DeleteTarget")
 
-	data, status, err := tgs.concertoService.Delete(
+	data, status, err := tgs.concertoService.DeleteContext(
+		ctx,
 		fmt.Sprintf(APIPathNetworkTargetGroupTarget, targetGroupID, targetResourceType, targetResourceID),
 	)
 	if err != nil {
@@ -32,7 +32,11 @@ func ListTargetGroupsMocked(
 	assert.Nil(err, "TargetGroups test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkLoadBalancerTargetGroups, loadBalancerID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkLoadBalancerTargetGroups, loadBalancerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	targetGroupsOut, err := ds.ListTargetGroups(loadBalancerID)
 
 	assert.Nil(err, "Error getting target groups")
@@ -61,8 +65,11 @@ func ListTargetGroupsFailErrMocked(
 	assert.Nil(err, "TargetGroups test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkLoadBalancerTargetGroups, loadBalancerID)).
-		Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkLoadBalancerTargetGroups, loadBalancerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, fmt.Errorf("mocked error"))
 	targetGroupsOut, err := ds.ListTargetGroups(loadBalancerID)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -92,7 +99,11 @@ func ListTargetGroupsFailStatusMocked(
 	assert.Nil(err, "TargetGroups test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkLoadBalancerTargetGroups, loadBalancerID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkLoadBalancerTargetGroups, loadBalancerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 499, nil)
 	targetGroupsOut, err := ds.ListTargetGroups(loadBalancerID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -121,7 +132,11 @@ func ListTargetGroupsFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkLoadBalancerTargetGroups, loadBalancerID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkLoadBalancerTargetGroups, loadBalancerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	targetGroupsOut, err := ds.ListTargetGroups(loadBalancerID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -715,7 +730,11 @@ func ListTargetsMocked(t *testing.T, targetGroupID string, targetsIn []*types.Ta
 	assert.Nil(err, "Targets test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkTargetGroupTargets, targetGroupID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkTargetGroupTargets, targetGroupID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	targetsOut, err := ds.ListTargets(targetGroupID)
 
 	assert.Nil(err, "Error getting targets")
@@ -740,8 +759,11 @@ func ListTargetsFailErrMocked(t *testing.T, targetGroupID string, targetsIn []*t
 	assert.Nil(err, "Targets test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkTargetGroupTargets, targetGroupID)).
-		Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkTargetGroupTargets, targetGroupID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, fmt.Errorf("mocked error"))
 	targetsOut, err := ds.ListTargets(targetGroupID)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -767,7 +789,11 @@ func ListTargetsFailStatusMocked(t *testing.T, targetGroupID string, targetsIn [
 	assert.Nil(err, "Targets test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkTargetGroupTargets, targetGroupID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkTargetGroupTargets, targetGroupID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 499, nil)
 	targetsOut, err := ds.ListTargets(targetGroupID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -792,7 +818,11 @@ func ListTargetsFailJSONMocked(t *testing.T, targetGroupID string, targetsIn []*
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkTargetGroupTargets, targetGroupID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathNetworkTargetGroupTargets, targetGroupID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	targetsOut, err := ds.ListTargets(targetGroupID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
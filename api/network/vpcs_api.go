@@ -3,6 +3,7 @@
 package network
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -33,30 +34,58 @@ func NewVPCService(concertoService utils.ConcertoService) (*VPCService, error) {
 
 // ListVPCs returns the list of VPCs as an array of VPC
 func (vs *VPCService) ListVPCs() (vpcs []*types.Vpc, err error) {
-	log.Debug("ListVPCs")
+	return vs.ListVPCsContext(context.Background())
+}
 
-	data, status, err := vs.concertoService.Get(APIPathNetworkVpcs)
+// ListVPCsContext is like ListVPCs, but the request is cancelled as soon as ctx is done
+func (vs *VPCService) ListVPCsContext(ctx context.Context) (vpcs []*types.Vpc, err error) {
+	log.Debug("ListVPCs")
 
+	vpcs = []*types.Vpc{}
+	err = vs.ListVPCsPagesContext(ctx, func(page []*types.Vpc) bool {
+		vpcs = append(vpcs, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return vpcs, nil
+}
 
-	if err = json.Unmarshal(data, &vpcs); err != nil {
-		return nil, err
-	}
+// ListVPCsPages is like ListVPCs, but the list is streamed to fn one page at a time, until fn returns false
+func (vs *VPCService) ListVPCsPages(fn func(vpcs []*types.Vpc) bool) error {
+	return vs.ListVPCsPagesContext(context.Background(), fn)
+}
 
-	return vpcs, nil
+// ListVPCsPagesContext is like ListVPCsPages, but the requests are cancelled as soon as ctx is done. The page options
+// carried by ctx are applied
+func (vs *VPCService) ListVPCsPagesContext(ctx context.Context, fn func(vpcs []*types.Vpc) bool) error {
+	log.Debug("ListVPCsPages")
+
+	pager := utils.NewPager(ctx, vs.concertoService, APIPathNetworkVpcs)
+	for pager.Next() {
+		var vpcs []*types.Vpc
+		if err := pager.Decode(&vpcs); err != nil {
+			return err
+		}
+		if !fn(vpcs) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetVPC returns a VPC by its ID
 func (vs *VPCService) GetVPC(vpcID string) (vpc *types.Vpc, err error) {
+	return vs.GetVPCContext(context.Background(), vpcID)
+}
+
+// GetVPCContext is like GetVPC, but the request is cancelled as soon as ctx is done
+func (vs *VPCService) GetVPCContext(ctx context.Context, vpcID string) (vpc *types.Vpc, err error) {
 	log.Debug("GetVPC")
 
-	data, status, err := vs.concertoService.Get(fmt.Sprintf(APIPathNetworkVpc, vpcID))
+	data, status, err := vs.concertoService.GetContext(ctx, fmt.Sprintf(APIPathNetworkVpc, vpcID))
 	if err != nil {
 		return nil, err
 	}
@@ -74,9 +103,17 @@ func (vs *VPCService) GetVPC(vpcID string) (vpc *types.Vpc, err error) {
 
 // CreateVPC creates a VPC
 func (vs *VPCService) CreateVPC(vpcParams *map[string]interface{}) (vpc *types.Vpc, err error) {
+	return vs.CreateVPCContext(context.Background(), vpcParams)
+}
+
+// CreateVPCContext is like CreateVPC, but the request is cancelled as soon as ctx is done
+func (vs *VPCService) CreateVPCContext(
+	ctx context.Context,
+	vpcParams *map[string]interface{},
+) (vpc *types.Vpc, err error) {
 	log.Debug("CreateVPC")
 
-	data, status, err := vs.concertoService.Post(APIPathNetworkVpcs, vpcParams)
+	data, status, err := vs.concertoService.PostContext(ctx, APIPathNetworkVpcs, vpcParams)
 
 	if err != nil {
 		return nil, err
@@ -93,11 +130,37 @@ func (vs *VPCService) CreateVPC(vpcParams *map[string]interface{}) (vpc *types.V
 	return vpc, nil
 }
 
+// CreateVPCWithInput is like CreateVPC, but takes a typed input, which is validated before being sent
+func (vs *VPCService) CreateVPCWithInput(input *types.VPCCreateInput) (vpc *types.Vpc, err error) {
+	return vs.CreateVPCWithInputContext(context.Background(), input)
+}
+
+// CreateVPCWithInputContext is like CreateVPCWithInput, but the request is cancelled as soon as ctx is done
+func (vs *VPCService) CreateVPCWithInputContext(
+	ctx context.Context,
+	input *types.VPCCreateInput,
+) (vpc *types.Vpc, err error) {
+	vpcParams, err := types.InputParams(input)
+	if err != nil {
+		return nil, err
+	}
+	return vs.CreateVPCContext(ctx, vpcParams)
+}
+
 // UpdateVPC updates a VPC by its ID
 func (vs *VPCService) UpdateVPC(vpcID string, vpcParams *map[string]interface{}) (vpc *types.Vpc, err error) {
+	return vs.UpdateVPCContext(context.Background(), vpcID, vpcParams)
+}
+
+// UpdateVPCContext is like UpdateVPC, but the request is cancelled as soon as ctx is done
+func (vs *VPCService) UpdateVPCContext(
+	ctx context.Context,
+	vpcID string,
+	vpcParams *map[string]interface{},
+) (vpc *types.Vpc, err error) {
 	log.Debug("UpdateVPC")
 
-	data, status, err := vs.concertoService.Put(fmt.Sprintf(APIPathNetworkVpc, vpcID), vpcParams)
+	data, status, err := vs.concertoService.PutContext(ctx, fmt.Sprintf(APIPathNetworkVpc, vpcID), vpcParams)
 
 	if err != nil {
 		return nil, err
@@ -114,11 +177,34 @@ func (vs *VPCService) UpdateVPC(vpcID string, vpcParams *map[string]interface{})
 	return vpc, nil
 }
 
+// UpdateVPCWithInput is like UpdateVPC, but takes a typed input, which is validated before being sent
+func (vs *VPCService) UpdateVPCWithInput(vpcID string, input *types.VPCUpdateInput) (vpc *types.Vpc, err error) {
+	return vs.UpdateVPCWithInputContext(context.Background(), vpcID, input)
+}
+
+// UpdateVPCWithInputContext is like UpdateVPCWithInput, but the request is cancelled as soon as ctx is done
+func (vs *VPCService) UpdateVPCWithInputContext(
+	ctx context.Context,
+	vpcID string,
+	input *types.VPCUpdateInput,
+) (vpc *types.Vpc, err error) {
+	vpcParams, err := types.InputParams(input)
+	if err != nil {
+		return nil, err
+	}
+	return vs.UpdateVPCContext(ctx, vpcID, vpcParams)
+}
+
 // DeleteVPC deletes a VPC by its ID
 func (vs *VPCService) DeleteVPC(vpcID string) (err error) {
+	return vs.DeleteVPCContext(context.Background(), vpcID)
+}
+
+// DeleteVPCContext is like DeleteVPC, but the request is cancelled as soon as ctx is done
+func (vs *VPCService) DeleteVPCContext(ctx context.Context, vpcID string) (err error) {
 	log.Debug("DeleteVPC")
 
-	data, status, err := vs.concertoService.Delete(fmt.Sprintf(APIPathNetworkVpc, vpcID))
+	data, status, err := vs.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathNetworkVpc, vpcID))
 	if err != nil {
 		return err
 	}
@@ -132,9 +218,14 @@ func (vs *VPCService) DeleteVPC(vpcID string) (err error) {
 
 // DiscardVPC discards a VPC by its ID
 func (vs *VPCService) DiscardVPC(vpcID string) (err error) {
+	return vs.DiscardVPCContext(context.Background(), vpcID)
+}
+
+// DiscardVPCContext is like DiscardVPC, but the request is cancelled as soon as ctx is done
+func (vs *VPCService) DiscardVPCContext(ctx context.Context, vpcID string) (err error) {
 	log.Debug("DiscardVPC")
 
-	data, status, err := vs.concertoService.Delete(fmt.Sprintf(APIPathNetworkVpcDiscard, vpcID))
+	data, status, err := vs.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathNetworkVpcDiscard, vpcID))
 	if err != nil {
 		return err
 	}
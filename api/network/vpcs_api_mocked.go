@@ -30,7 +30,7 @@ func ListVPCsMocked(t *testing.T, vpcsIn []*types.Vpc) []*types.Vpc {
 	assert.Nil(err, "VPC test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathNetworkVpcs).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathNetworkVpcs, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	vpcsOut, err := ds.ListVPCs()
 	assert.Nil(err, "Error getting VPC list")
 	assert.Equal(vpcsIn, vpcsOut, "ListVPCs returned different VPCs")
@@ -54,7 +54,8 @@ func ListVPCsFailErrMocked(t *testing.T, vpcsIn []*types.Vpc) []*types.Vpc {
 	assert.Nil(err, "VPC test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathNetworkVpcs).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathNetworkVpcs, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	vpcsOut, err := ds.ListVPCs()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -80,7 +81,7 @@ func ListVPCsFailStatusMocked(t *testing.T, vpcsIn []*types.Vpc) []*types.Vpc {
 	assert.Nil(err, "VPC test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathNetworkVpcs).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathNetworkVpcs, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	vpcsOut, err := ds.ListVPCs()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -105,7 +106,7 @@ func ListVPCsFailJSONMocked(t *testing.T, vpcsIn []*types.Vpc) []*types.Vpc {
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathNetworkVpcs).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathNetworkVpcs, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	vpcsOut, err := ds.ListVPCs()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -3,6 +3,7 @@
 package network
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -32,9 +33,14 @@ func NewVPNService(concertoService utils.ConcertoService) (*VPNService, error) {
 
 // GetVPN returns a VPN by VPC ID
 func (vs *VPNService) GetVPN(vpcID string) (vpn *types.Vpn, err error) {
+	return vs.GetVPNContext(context.Background(), vpcID)
+}
+
+// GetVPNContext is like GetVPN, but the request is cancelled as soon as ctx is done
+func (vs *VPNService) GetVPNContext(ctx context.Context, vpcID string) (vpn *types.Vpn, err error) {
 	log.Debug("GetVPN")
 
-	data, status, err := vs.concertoService.Get(fmt.Sprintf(APIPathNetworkVpcVpn, vpcID))
+	data, status, err := vs.concertoService.GetContext(ctx, fmt.Sprintf(APIPathNetworkVpcVpn, vpcID))
 	if err != nil {
 		return nil, err
 	}
@@ -52,9 +58,18 @@ func (vs *VPNService) GetVPN(vpcID string) (vpn *types.Vpn, err error) {
 
 // CreateVPN creates a VPN
 func (vs *VPNService) CreateVPN(vpcID string, vpnParams *map[string]interface{}) (vpn *types.Vpn, err error) {
+	return vs.CreateVPNContext(context.Background(), vpcID, vpnParams)
+}
+
+// CreateVPNContext is like CreateVPN, but the request is cancelled as soon as ctx is done
+func (vs *VPNService) CreateVPNContext(
+	ctx context.Context,
+	vpcID string,
+	vpnParams *map[string]interface{},
+) (vpn *types.Vpn, err error) {
 	log.Debug("CreateVPN")
 
-	data, status, err := vs.concertoService.Post(fmt.Sprintf(APIPathNetworkVpcVpn, vpcID), vpnParams)
+	data, status, err := vs.concertoService.PostContext(ctx, fmt.Sprintf(APIPathNetworkVpcVpn, vpcID), vpnParams)
 
 	if err != nil {
 		return nil, err
@@ -73,9 +88,14 @@ func (vs *VPNService) CreateVPN(vpcID string, vpnParams *map[string]interface{})
 
 // DeleteVPN deletes VPN by VPC ID
 func (vs *VPNService) DeleteVPN(vpcID string) (err error) {
+	return vs.DeleteVPNContext(context.Background(), vpcID)
+}
+
+// DeleteVPNContext is like DeleteVPN, but the request is cancelled as soon as ctx is done
+func (vs *VPNService) DeleteVPNContext(ctx context.Context, vpcID string) (err error) {
 	log.Debug("DeleteVPN")
 
-	data, status, err := vs.concertoService.Delete(fmt.Sprintf(APIPathNetworkVpcVpn, vpcID))
+	data, status, err := vs.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathNetworkVpcVpn, vpcID))
 	if err != nil {
 		return err
 	}
@@ -89,21 +109,48 @@ func (vs *VPNService) DeleteVPN(vpcID string) (err error) {
 
 // ListVPNPlans returns the list of VPN plans for a given VPC ID
 func (vs *VPNService) ListVPNPlans(vpcID string) (vpnPlans []*types.VpnPlan, err error) {
-	log.Debug("ListVPNPlans")
+	return vs.ListVPNPlansContext(context.Background(), vpcID)
+}
 
-	data, status, err := vs.concertoService.Get(fmt.Sprintf(APIPathNetworkVpcVpnPlans, vpcID))
+// ListVPNPlansContext is like ListVPNPlans, but the request is cancelled as soon as ctx is done
+func (vs *VPNService) ListVPNPlansContext(ctx context.Context, vpcID string) (vpnPlans []*types.VpnPlan, err error) {
+	log.Debug("ListVPNPlans")
 
+	vpnPlans = []*types.VpnPlan{}
+	err = vs.ListVPNPlansPagesContext(ctx, vpcID, func(page []*types.VpnPlan) bool {
+		vpnPlans = append(vpnPlans, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return vpnPlans, nil
+}
 
-	if err = json.Unmarshal(data, &vpnPlans); err != nil {
-		return nil, err
-	}
+// ListVPNPlansPages is like ListVPNPlans, but the list is streamed to fn one page at a time, until fn returns false
+func (vs *VPNService) ListVPNPlansPages(vpcID string, fn func(vpnPlans []*types.VpnPlan) bool) error {
+	return vs.ListVPNPlansPagesContext(context.Background(), vpcID, fn)
+}
 
-	return vpnPlans, nil
+// ListVPNPlansPagesContext is like ListVPNPlansPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (vs *VPNService) ListVPNPlansPagesContext(
+	ctx context.Context,
+	vpcID string,
+	fn func(vpnPlans []*types.VpnPlan) bool,
+) error {
+	log.Debug("ListVPNPlansPages")
+
+	pager := utils.NewPager(ctx, vs.concertoService, fmt.Sprintf(APIPathNetworkVpcVpnPlans, vpcID))
+	for pager.Next() {
+		var vpnPlans []*types.VpnPlan
+		if err := pager.Decode(&vpnPlans); err != nil {
+			return err
+		}
+		if !fn(vpnPlans) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
@@ -315,7 +315,8 @@ func ListVPNPlansMocked(t *testing.T, vpnPlansIn []*types.VpnPlan, vpcID string)
 	assert.Nil(err, "VPN test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkVpcVpnPlans, vpcID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathNetworkVpcVpnPlans, vpcID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	vpnPlansOut, err := ds.ListVPNPlans(vpcID)
 	assert.Nil(err, "Error getting VPN plans list")
 	assert.Equal(vpnPlansIn, vpnPlansOut, "ListVPNPlans returned different VPN plans")
@@ -339,7 +340,8 @@ func ListVPNPlansFailErrMocked(t *testing.T, vpnPlansIn []*types.VpnPlan, vpcID
 	assert.Nil(err, "VPN test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkVpcVpnPlans, vpcID)).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathNetworkVpcVpnPlans, vpcID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	vpnPlansOut, err := ds.ListVPNPlans(vpcID)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -365,7 +367,8 @@ func ListVPNPlansFailStatusMocked(t *testing.T, vpnPlansIn []*types.VpnPlan, vpc
 	assert.Nil(err, "VPN test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkVpcVpnPlans, vpcID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathNetworkVpcVpnPlans, vpcID), 1, utils.DefaultPageSize)).
+		Return(dIn, 499, nil)
 	vpnPlansOut, err := ds.ListVPNPlans(vpcID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -390,7 +393,8 @@ func ListVPNPlansFailJSONMocked(t *testing.T, vpnPlansIn []*types.VpnPlan, vpcID
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathNetworkVpcVpnPlans, vpcID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(fmt.Sprintf(APIPathNetworkVpcVpnPlans, vpcID), 1, utils.DefaultPageSize)).
+		Return(dIn, 200, nil)
 	vpnPlansOut, err := ds.ListVPNPlans(vpcID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -3,6 +3,7 @@
 package polling
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -15,6 +16,7 @@ const APIPathCommandPollingPings = "/command_polling/pings"
 const APIPathCommandPollingNextCommand = "/command_polling/command"
 const APIPathCommandPollingCommand = "/command_polling/commands/%s"
 const APIPathCommandPollingBootstrapLogs = "/command_polling/bootstrap_logs"
+const APIPathCommandPollingCommandLogs = "/command_polling/commands/%s/logs"
 
 // PollingService manages polling operations
 type PollingService struct {
@@ -34,10 +36,15 @@ func NewPollingService(concertoService utils.ConcertoService) (*PollingService,
 
 // Ping resolves if new command is waiting for execution
 func (ps *PollingService) Ping() (ping *types.PollingPing, status int, err error) {
+	return ps.PingContext(context.Background())
+}
+
+// PingContext is like Ping, but the request is cancelled as soon as ctx is done
+func (ps *PollingService) PingContext(ctx context.Context) (ping *types.PollingPing, status int, err error) {
 	log.Debug("Ping")
 
 	payload := make(map[string]interface{})
-	data, status, err := ps.concertoService.Post(APIPathCommandPollingPings, &payload)
+	data, status, err := ps.concertoService.PostContext(ctx, APIPathCommandPollingPings, &payload)
 	if err != nil {
 		return nil, status, err
 	}
@@ -51,9 +58,16 @@ func (ps *PollingService) Ping() (ping *types.PollingPing, status int, err error
 
 // GetNextCommand returns the command to be executed
 func (ps *PollingService) GetNextCommand() (command *types.PollingCommand, status int, err error) {
+	return ps.GetNextCommandContext(context.Background())
+}
+
+// GetNextCommandContext is like GetNextCommand, but the request is cancelled as soon as ctx is done
+func (ps *PollingService) GetNextCommandContext(
+	ctx context.Context,
+) (command *types.PollingCommand, status int, err error) {
 	log.Debug("GetNextCommand")
 
-	data, status, err := ps.concertoService.Get(APIPathCommandPollingNextCommand)
+	data, status, err := ps.concertoService.GetContext(ctx, APIPathCommandPollingNextCommand)
 	if err != nil {
 		return nil, status, err
 	}
@@ -69,10 +83,20 @@ func (ps *PollingService) GetNextCommand() (command *types.PollingCommand, statu
 func (ps *PollingService) UpdateCommand(
 	commandID string,
 	pollingCommandParams *map[string]interface{},
+) (command *types.PollingCommand, status int, err error) {
+	return ps.UpdateCommandContext(context.Background(), commandID, pollingCommandParams)
+}
+
+// UpdateCommandContext is like UpdateCommand, but the request is cancelled as soon as ctx is done
+func (ps *PollingService) UpdateCommandContext(
+	ctx context.Context,
+	commandID string,
+	pollingCommandParams *map[string]interface{},
 ) (command *types.PollingCommand, status int, err error) {
 	log.Debug("UpdateCommand")
 
-	data, status, err := ps.concertoService.Put(
+	data, status, err := ps.concertoService.PutContext(
+		ctx,
 		fmt.Sprintf(APIPathCommandPollingCommand, commandID),
 		pollingCommandParams,
 	)
@@ -91,10 +115,55 @@ func (ps *PollingService) UpdateCommand(
 // ReportBootstrapLog reports a command result
 func (ps *PollingService) ReportBootstrapLog(
 	pollingContinuousReportParams *map[string]interface{},
+) (command *types.PollingContinuousReport, status int, err error) {
+	return ps.ReportBootstrapLogContext(context.Background(), pollingContinuousReportParams)
+}
+
+// ReportBootstrapLogContext is like ReportBootstrapLog, but the request is cancelled as soon as ctx is done
+func (ps *PollingService) ReportBootstrapLogContext(
+	ctx context.Context,
+	pollingContinuousReportParams *map[string]interface{},
 ) (command *types.PollingContinuousReport, status int, err error) {
 	log.Debug("ReportBootstrapLog")
 
-	data, status, err := ps.concertoService.Post(APIPathCommandPollingBootstrapLogs, pollingContinuousReportParams)
+	data, status, err := ps.concertoService.PostIdempotentContext(
+		ctx,
+		APIPathCommandPollingBootstrapLogs,
+		pollingContinuousReportParams,
+	)
+
+	if err != nil {
+		return nil, status, err
+	}
+
+	if err = json.Unmarshal(data, &command); err != nil {
+		return nil, status, err
+	}
+
+	return command, status, nil
+}
+
+// ReportCommandLog reports a chunk of the output of a command by its ID, while it runs
+func (ps *PollingService) ReportCommandLog(
+	commandID string,
+	pollingContinuousReportParams *map[string]interface{},
+) (command *types.PollingContinuousReport, status int, err error) {
+	return ps.ReportCommandLogContext(context.Background(), commandID, pollingContinuousReportParams)
+}
+
+// ReportCommandLogContext is like ReportCommandLog, but the request is cancelled as soon as ctx is done
+func (ps *PollingService) ReportCommandLogContext(
+	ctx context.Context,
+	commandID string,
+	pollingContinuousReportParams *map[string]interface{},
+) (command *types.PollingContinuousReport, status int, err error) {
+	log.Debug("ReportCommandLog")
+
+	data, status, err := ps.concertoService.PostIdempotentContext(
+		ctx,
+		fmt.Sprintf(APIPathCommandPollingCommandLogs, commandID),
+		pollingContinuousReportParams,
+	)
 
 	if err != nil {
 		return nil, status, err
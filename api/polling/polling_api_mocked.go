@@ -362,7 +362,7 @@ func ReportBootstrapLogMocked(t *testing.T, commandIn *types.PollingContinuousRe
 
 	// call service
 	payload := make(map[string]interface{})
-	cs.On("Post", fmt.Sprintf(APIPathCommandPollingBootstrapLogs), &payload).Return(dOut, 201, nil)
+	cs.On("PostIdempotent", fmt.Sprintf(APIPathCommandPollingBootstrapLogs), &payload).Return(dOut, 201, nil)
 	commandOut, status, err := ds.ReportBootstrapLog(&payload)
 
 	assert.Nil(err, "Error posting report command")
@@ -394,7 +394,7 @@ func ReportBootstrapLogFailErrMocked(
 
 	// call service
 	payload := make(map[string]interface{})
-	cs.On("Post", fmt.Sprintf(APIPathCommandPollingBootstrapLogs), &payload).
+	cs.On("PostIdempotent", fmt.Sprintf(APIPathCommandPollingBootstrapLogs), &payload).
 		Return(dIn, 400, fmt.Errorf("mocked error"))
 	commandOut, _, err := ds.ReportBootstrapLog(&payload)
 
@@ -427,7 +427,7 @@ func ReportBootstrapLogFailStatusMocked(
 
 	// call service
 	payload := make(map[string]interface{})
-	cs.On("Post", fmt.Sprintf(APIPathCommandPollingBootstrapLogs), &payload).
+	cs.On("PostIdempotent", fmt.Sprintf(APIPathCommandPollingBootstrapLogs), &payload).
 		Return(dIn, 499, fmt.Errorf("error 499 Mocked error"))
 	commandOut, status, err := ds.ReportBootstrapLog(&payload)
 
@@ -458,7 +458,7 @@ func ReportBootstrapLogFailJSONMocked(
 
 	// call service
 	payload := make(map[string]interface{})
-	cs.On("Post", fmt.Sprintf(APIPathCommandPollingBootstrapLogs), &payload).Return(dIn, 201, nil)
+	cs.On("PostIdempotent", fmt.Sprintf(APIPathCommandPollingBootstrapLogs), &payload).Return(dIn, 201, nil)
 	commandOut, _, err := ds.ReportBootstrapLog(&payload)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -467,3 +467,94 @@ func ReportBootstrapLogFailJSONMocked(
 
 	return commandOut
 }
+
+// ReportCommandLogMocked test mocked function
+func ReportCommandLogMocked(
+	t *testing.T,
+	commandIn *types.PollingCommand,
+	reportIn *types.PollingContinuousReport,
+) *types.PollingContinuousReport {
+
+	assert := assert.New(t)
+
+	// wire up
+	cs := &utils.MockConcertoService{}
+	ds, err := NewPollingService(cs)
+	assert.Nil(err, "Couldn't load polling service")
+	assert.NotNil(ds, "Polling service not instanced")
+
+	// to json
+	dOut, err := json.Marshal(reportIn)
+	assert.Nil(err, "ReportCommandLog test data corrupted")
+
+	// call service
+	payload := map[string]interface{}{"stdout": commandIn.Stdout}
+	cs.On("PostIdempotent", fmt.Sprintf(APIPathCommandPollingCommandLogs, commandIn.ID), &payload).
+		Return(dOut, 201, nil)
+	reportOut, status, err := ds.ReportCommandLog(commandIn.ID, &payload)
+
+	assert.Nil(err, "Error posting command log")
+	assert.Equal(201, status, "ReportCommandLog returned invalid response")
+	assert.Equal(reportIn, reportOut, "ReportCommandLog returned unexpected report")
+
+	return reportOut
+}
+
+// ReportCommandLogFailErrMocked test mocked function
+func ReportCommandLogFailErrMocked(
+	t *testing.T,
+	commandIn *types.PollingCommand,
+	reportIn *types.PollingContinuousReport,
+) *types.PollingContinuousReport {
+
+	assert := assert.New(t)
+
+	// wire up
+	cs := &utils.MockConcertoService{}
+	ds, err := NewPollingService(cs)
+	assert.Nil(err, "Couldn't load polling service")
+	assert.NotNil(ds, "Polling service not instanced")
+
+	// call service
+	payload := map[string]interface{}{"stdout": commandIn.Stdout}
+	cs.On("PostIdempotent", fmt.Sprintf(APIPathCommandPollingCommandLogs, commandIn.ID), &payload).
+		Return([]byte(nil), 400, fmt.Errorf("mocked error"))
+	reportOut, _, err := ds.ReportCommandLog(commandIn.ID, &payload)
+
+	assert.NotNil(err, "We are expecting an error")
+	assert.Nil(reportOut, "Expecting nil output")
+	assert.Equal(err.Error(), "mocked error", "Error should be 'mocked error'")
+
+	return reportOut
+}
+
+// ReportCommandLogFailJSONMocked test mocked function
+func ReportCommandLogFailJSONMocked(
+	t *testing.T,
+	commandIn *types.PollingCommand,
+	reportIn *types.PollingContinuousReport,
+) *types.PollingContinuousReport {
+
+	assert := assert.New(t)
+
+	// wire up
+	cs := &utils.MockConcertoService{}
+	ds, err := NewPollingService(cs)
+	assert.Nil(err, "Couldn't load polling service")
+	assert.NotNil(ds, "Polling service not instanced")
+
+	// wrong json
+	dIn := []byte{10, 20, 30}
+
+	// call service
+	payload := map[string]interface{}{"stdout": commandIn.Stdout}
+	cs.On("PostIdempotent", fmt.Sprintf(APIPathCommandPollingCommandLogs, commandIn.ID), &payload).
+		Return(dIn, 201, nil)
+	reportOut, _, err := ds.ReportCommandLog(commandIn.ID, &payload)
+
+	assert.NotNil(err, "We are expecting a marshalling error")
+	assert.Nil(reportOut, "Expecting nil output")
+	assert.Contains(err.Error(), "invalid character", "Error message should include the string 'invalid character'")
+
+	return reportOut
+}
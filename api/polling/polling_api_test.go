@@ -47,3 +47,11 @@ func TestReportBootstrapLog(t *testing.T) {
 	ReportBootstrapLogFailStatusMocked(t, commandIn)
 	ReportBootstrapLogFailJSONMocked(t, commandIn)
 }
+
+func TestReportCommandLog(t *testing.T) {
+	commandIn := testdata.GetPollingCommandData()
+	reportIn := testdata.GetPollingContinuousReportData()
+	ReportCommandLogMocked(t, commandIn, reportIn)
+	ReportCommandLogFailErrMocked(t, commandIn, reportIn)
+	ReportCommandLogFailJSONMocked(t, commandIn, reportIn)
+}
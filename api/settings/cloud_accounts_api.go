@@ -3,6 +3,7 @@
 package settings
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -32,29 +33,67 @@ func NewCloudAccountService(concertoService utils.ConcertoService) (*CloudAccoun
 
 // ListCloudAccounts returns the list of cloudAccounts as an array of CloudAccount
 func (cas *CloudAccountService) ListCloudAccounts() (cloudAccounts []*types.CloudAccount, err error) {
+	return cas.ListCloudAccountsContext(context.Background())
+}
+
+// ListCloudAccountsContext is like ListCloudAccounts, but the request is cancelled as soon as ctx is done
+func (cas *CloudAccountService) ListCloudAccountsContext(
+	ctx context.Context,
+) (cloudAccounts []*types.CloudAccount, err error) {
 	log.Debug("ListCloudAccounts")
 
-	data, status, err := cas.concertoService.Get(APIPathSettingsCloudAccounts)
+	cloudAccounts = []*types.CloudAccount{}
+	err = cas.ListCloudAccountsPagesContext(ctx, func(page []*types.CloudAccount) bool {
+		cloudAccounts = append(cloudAccounts, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return cloudAccounts, nil
+}
 
-	if err = json.Unmarshal(data, &cloudAccounts); err != nil {
-		return nil, err
-	}
+// ListCloudAccountsPages is like ListCloudAccounts, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (cas *CloudAccountService) ListCloudAccountsPages(fn func(cloudAccounts []*types.CloudAccount) bool) error {
+	return cas.ListCloudAccountsPagesContext(context.Background(), fn)
+}
 
-	return cloudAccounts, nil
+// ListCloudAccountsPagesContext is like ListCloudAccountsPages, but the requests are cancelled as soon as ctx is done.
+// The page options carried by ctx are applied
+func (cas *CloudAccountService) ListCloudAccountsPagesContext(
+	ctx context.Context,
+	fn func(cloudAccounts []*types.CloudAccount) bool,
+) error {
+	log.Debug("ListCloudAccountsPages")
+
+	pager := utils.NewPager(ctx, cas.concertoService, APIPathSettingsCloudAccounts)
+	for pager.Next() {
+		var cloudAccounts []*types.CloudAccount
+		if err := pager.Decode(&cloudAccounts); err != nil {
+			return err
+		}
+		if !fn(cloudAccounts) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetCloudAccount returns a cloudAccount by its ID
 func (cas *CloudAccountService) GetCloudAccount(cloudAccountID string) (cloudAccount *types.CloudAccount, err error) {
+	return cas.GetCloudAccountContext(context.Background(), cloudAccountID)
+}
+
+// GetCloudAccountContext is like GetCloudAccount, but the request is cancelled as soon as ctx is done
+func (cas *CloudAccountService) GetCloudAccountContext(
+	ctx context.Context,
+	cloudAccountID string,
+) (cloudAccount *types.CloudAccount, err error) {
 	log.Debug("GetCloudAccount")
 
-	data, status, err := cas.concertoService.Get(fmt.Sprintf(APIPathSettingsCloudAccount, cloudAccountID))
+	data, status, err := cas.concertoService.GetContext(ctx, fmt.Sprintf(APIPathSettingsCloudAccount, cloudAccountID))
 	if err != nil {
 		return nil, err
 	}
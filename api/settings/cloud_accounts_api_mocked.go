@@ -30,7 +30,7 @@ func ListCloudAccountsMocked(t *testing.T, cloudAccountsIn []*types.CloudAccount
 	assert.Nil(err, "Cloud account test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathSettingsCloudAccounts).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathSettingsCloudAccounts, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	cloudAccountsOut, err := ds.ListCloudAccounts()
 	assert.Nil(err, "Error getting cloud account list")
 	assert.Equal(cloudAccountsIn, cloudAccountsOut, "ListCloudAccounts returned different cloud accounts")
@@ -54,7 +54,8 @@ func ListCloudAccountsFailErrMocked(t *testing.T, cloudAccountsIn []*types.Cloud
 	assert.Nil(err, "Cloud account test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathSettingsCloudAccounts).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathSettingsCloudAccounts, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	cloudAccountsOut, err := ds.ListCloudAccounts()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -80,7 +81,7 @@ func ListCloudAccountsFailStatusMocked(t *testing.T, cloudAccountsIn []*types.Cl
 	assert.Nil(err, "Cloud account test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathSettingsCloudAccounts).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathSettingsCloudAccounts, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	cloudAccountsOut, err := ds.ListCloudAccounts()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -105,7 +106,7 @@ func ListCloudAccountsFailJSONMocked(t *testing.T, cloudAccountsIn []*types.Clou
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathSettingsCloudAccounts).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathSettingsCloudAccounts, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	cloudAccountsOut, err := ds.ListCloudAccounts()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
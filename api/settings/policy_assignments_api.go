@@ -3,6 +3,7 @@
 package settings
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -33,32 +34,77 @@ func NewPolicyAssignmentService(concertoService utils.ConcertoService) (*PolicyA
 // ListAssignments returns the list of policy assignments as an array of PolicyAssignment
 func (pas *PolicyAssignmentService) ListAssignments(
 	cloudAccountID string,
+) (assignments []*types.PolicyAssignment, err error) {
+	return pas.ListAssignmentsContext(context.Background(), cloudAccountID)
+}
+
+// ListAssignmentsContext is like ListAssignments, but the request is cancelled as soon as ctx is done
+func (pas *PolicyAssignmentService) ListAssignmentsContext(
+	ctx context.Context,
+	cloudAccountID string,
 ) (assignments []*types.PolicyAssignment, err error) {
 	log.Debug("ListAssignments")
 
-	data, status, err := pas.concertoService.Get(
-		fmt.Sprintf(APIPathSettingsCloudAccountPolicyAssignments, cloudAccountID),
-	)
+	assignments = []*types.PolicyAssignment{}
+	err = pas.ListAssignmentsPagesContext(ctx, cloudAccountID, func(page []*types.PolicyAssignment) bool {
+		assignments = append(assignments, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return assignments, nil
+}
 
-	if err = json.Unmarshal(data, &assignments); err != nil {
-		return nil, err
-	}
+// ListAssignmentsPages is like ListAssignments, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (pas *PolicyAssignmentService) ListAssignmentsPages(
+	cloudAccountID string,
+	fn func(assignments []*types.PolicyAssignment) bool,
+) error {
+	return pas.ListAssignmentsPagesContext(context.Background(), cloudAccountID, fn)
+}
 
-	return assignments, nil
+// ListAssignmentsPagesContext is like ListAssignmentsPages, but the requests are cancelled as soon as ctx is done. The
+// page options carried by ctx are applied
+func (pas *PolicyAssignmentService) ListAssignmentsPagesContext(
+	ctx context.Context,
+	cloudAccountID string,
+	fn func(assignments []*types.PolicyAssignment) bool,
+) error {
+	log.Debug("ListAssignmentsPages")
+
+	pager := utils.NewPager(
+		ctx,
+		pas.concertoService,
+		fmt.Sprintf(APIPathSettingsCloudAccountPolicyAssignments, cloudAccountID),
+	)
+	for pager.Next() {
+		var assignments []*types.PolicyAssignment
+		if err := pager.Decode(&assignments); err != nil {
+			return err
+		}
+		if !fn(assignments) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetAssignment returns an assignment by its ID
 func (pas *PolicyAssignmentService) GetAssignment(assignmentID string) (assignment *types.PolicyAssignment, err error) {
+	return pas.GetAssignmentContext(context.Background(), assignmentID)
+}
+
+// GetAssignmentContext is like GetAssignment, but the request is cancelled as soon as ctx is done
+func (pas *PolicyAssignmentService) GetAssignmentContext(
+	ctx context.Context,
+	assignmentID string,
+) (assignment *types.PolicyAssignment, err error) {
 	log.Debug("GetAssignment")
 
-	data, status, err := pas.concertoService.Get(fmt.Sprintf(APIPathPolicyAssignment, assignmentID))
+	data, status, err := pas.concertoService.GetContext(ctx, fmt.Sprintf(APIPathPolicyAssignment, assignmentID))
 	if err != nil {
 		return nil, err
 	}
@@ -78,10 +124,20 @@ func (pas *PolicyAssignmentService) GetAssignment(assignmentID string) (assignme
 func (pas *PolicyAssignmentService) CreateAssignment(
 	definitionID string,
 	assignmentParams *map[string]interface{},
+) (assignment *types.PolicyAssignment, err error) {
+	return pas.CreateAssignmentContext(context.Background(), definitionID, assignmentParams)
+}
+
+// CreateAssignmentContext is like CreateAssignment, but the request is cancelled as soon as ctx is done
+func (pas *PolicyAssignmentService) CreateAssignmentContext(
+	ctx context.Context,
+	definitionID string,
+	assignmentParams *map[string]interface{},
 ) (assignment *types.PolicyAssignment, err error) {
 	log.Debug("CreateAssignment")
 
-	data, status, err := pas.concertoService.Post(
+	data, status, err := pas.concertoService.PostContext(
+		ctx,
 		fmt.Sprintf(APIPathPolicyDefinitionAssignments, definitionID),
 		assignmentParams,
 	)
@@ -104,10 +160,23 @@ func (pas *PolicyAssignmentService) CreateAssignment(
 func (pas *PolicyAssignmentService) UpdateAssignment(
 	assignmentID string,
 	assignmentParams *map[string]interface{},
+) (assignment *types.PolicyAssignment, err error) {
+	return pas.UpdateAssignmentContext(context.Background(), assignmentID, assignmentParams)
+}
+
+// UpdateAssignmentContext is like UpdateAssignment, but the request is cancelled as soon as ctx is done
+func (pas *PolicyAssignmentService) UpdateAssignmentContext(
+	ctx context.Context,
+	assignmentID string,
+	assignmentParams *map[string]interface{},
 ) (assignment *types.PolicyAssignment, err error) {
 	log.Debug("UpdateAssignment")
 
-	data, status, err := pas.concertoService.Put(fmt.Sprintf(APIPathPolicyAssignment, assignmentID), assignmentParams)
+	data, status, err := pas.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathPolicyAssignment, assignmentID),
+		assignmentParams,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -126,10 +195,18 @@ func (pas *PolicyAssignmentService) UpdateAssignment(
 // DeleteAssignment deletes an assignment by its ID
 func (pas *PolicyAssignmentService) DeleteAssignment(
 	assignmentID string,
+) (assignment *types.PolicyAssignment, err error) {
+	return pas.DeleteAssignmentContext(context.Background(), assignmentID)
+}
+
+// DeleteAssignmentContext is like DeleteAssignment, but the request is cancelled as soon as ctx is done
+func (pas *PolicyAssignmentService) DeleteAssignmentContext(
+	ctx context.Context,
+	assignmentID string,
 ) (assignment *types.PolicyAssignment, err error) {
 	log.Debug("DeleteAssignment")
 
-	data, status, err := pas.concertoService.Delete(fmt.Sprintf(APIPathPolicyAssignment, assignmentID))
+	data, status, err := pas.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathPolicyAssignment, assignmentID))
 	if err != nil {
 		return nil, err
 	}
@@ -32,7 +32,11 @@ func ListAssignmentsMocked(
 	assert.Nil(err, "PolicyAssignments test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathSettingsCloudAccountPolicyAssignments, cloudAccountID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathSettingsCloudAccountPolicyAssignments, cloudAccountID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	policyAssignmentsOut, err := ds.ListAssignments(cloudAccountID)
 
 	assert.Nil(err, "Error getting policy assignments")
@@ -61,8 +65,11 @@ func ListAssignmentsFailErrMocked(
 	assert.Nil(err, "PolicyAssignments test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathSettingsCloudAccountPolicyAssignments, cloudAccountID)).
-		Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathSettingsCloudAccountPolicyAssignments, cloudAccountID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, fmt.Errorf("mocked error"))
 	policyAssignmentsOut, err := ds.ListAssignments(cloudAccountID)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -92,7 +99,11 @@ func ListAssignmentsFailStatusMocked(
 	assert.Nil(err, "PolicyAssignments test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathSettingsCloudAccountPolicyAssignments, cloudAccountID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathSettingsCloudAccountPolicyAssignments, cloudAccountID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 499, nil)
 	policyAssignmentsOut, err := ds.ListAssignments(cloudAccountID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -121,7 +132,11 @@ func ListAssignmentsFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathSettingsCloudAccountPolicyAssignments, cloudAccountID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathSettingsCloudAccountPolicyAssignments, cloudAccountID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	policyAssignmentsOut, err := ds.ListAssignments(cloudAccountID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
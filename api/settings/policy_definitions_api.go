@@ -3,6 +3,7 @@
 package settings
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -33,29 +34,67 @@ func NewPolicyDefinitionService(concertoService utils.ConcertoService) (*PolicyD
 
 // ListDefinitions returns the list of policy definitions as an array of PolicyDefinition
 func (pds *PolicyDefinitionService) ListDefinitions() (definitions []*types.PolicyDefinition, err error) {
+	return pds.ListDefinitionsContext(context.Background())
+}
+
+// ListDefinitionsContext is like ListDefinitions, but the request is cancelled as soon as ctx is done
+func (pds *PolicyDefinitionService) ListDefinitionsContext(
+	ctx context.Context,
+) (definitions []*types.PolicyDefinition, err error) {
 	log.Debug("ListDefinitions")
 
-	data, status, err := pds.concertoService.Get(APIPathPolicyDefinitions)
+	definitions = []*types.PolicyDefinition{}
+	err = pds.ListDefinitionsPagesContext(ctx, func(page []*types.PolicyDefinition) bool {
+		definitions = append(definitions, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return definitions, nil
+}
 
-	if err = json.Unmarshal(data, &definitions); err != nil {
-		return nil, err
-	}
+// ListDefinitionsPages is like ListDefinitions, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (pds *PolicyDefinitionService) ListDefinitionsPages(fn func(definitions []*types.PolicyDefinition) bool) error {
+	return pds.ListDefinitionsPagesContext(context.Background(), fn)
+}
 
-	return definitions, nil
+// ListDefinitionsPagesContext is like ListDefinitionsPages, but the requests are cancelled as soon as ctx is done. The
+// page options carried by ctx are applied
+func (pds *PolicyDefinitionService) ListDefinitionsPagesContext(
+	ctx context.Context,
+	fn func(definitions []*types.PolicyDefinition) bool,
+) error {
+	log.Debug("ListDefinitionsPages")
+
+	pager := utils.NewPager(ctx, pds.concertoService, APIPathPolicyDefinitions)
+	for pager.Next() {
+		var definitions []*types.PolicyDefinition
+		if err := pager.Decode(&definitions); err != nil {
+			return err
+		}
+		if !fn(definitions) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetDefinition returns a policy definition by its ID
 func (pds *PolicyDefinitionService) GetDefinition(definitionID string) (definition *types.PolicyDefinition, err error) {
+	return pds.GetDefinitionContext(context.Background(), definitionID)
+}
+
+// GetDefinitionContext is like GetDefinition, but the request is cancelled as soon as ctx is done
+func (pds *PolicyDefinitionService) GetDefinitionContext(
+	ctx context.Context,
+	definitionID string,
+) (definition *types.PolicyDefinition, err error) {
 	log.Debug("GetDefinition")
 
-	data, status, err := pds.concertoService.Get(fmt.Sprintf(APIPathPolicyDefinition, definitionID))
+	data, status, err := pds.concertoService.GetContext(ctx, fmt.Sprintf(APIPathPolicyDefinition, definitionID))
 	if err != nil {
 		return nil, err
 	}
@@ -74,10 +113,18 @@ func (pds *PolicyDefinitionService) GetDefinition(definitionID string) (definiti
 // CreateDefinition creates a policy definition
 func (pds *PolicyDefinitionService) CreateDefinition(
 	definitionParams *map[string]interface{},
+) (definition *types.PolicyDefinition, err error) {
+	return pds.CreateDefinitionContext(context.Background(), definitionParams)
+}
+
+// CreateDefinitionContext is like CreateDefinition, but the request is cancelled as soon as ctx is done
+func (pds *PolicyDefinitionService) CreateDefinitionContext(
+	ctx context.Context,
+	definitionParams *map[string]interface{},
 ) (definition *types.PolicyDefinition, err error) {
 	log.Debug("CreateDefinition")
 
-	data, status, err := pds.concertoService.Post(APIPathPolicyDefinitions, definitionParams)
+	data, status, err := pds.concertoService.PostContext(ctx, APIPathPolicyDefinitions, definitionParams)
 	if err != nil {
 		return nil, err
 	}
@@ -97,10 +144,23 @@ func (pds *PolicyDefinitionService) CreateDefinition(
 func (pds *PolicyDefinitionService) UpdateDefinition(
 	definitionID string,
 	definitionParams *map[string]interface{},
+) (definition *types.PolicyDefinition, err error) {
+	return pds.UpdateDefinitionContext(context.Background(), definitionID, definitionParams)
+}
+
+// UpdateDefinitionContext is like UpdateDefinition, but the request is cancelled as soon as ctx is done
+func (pds *PolicyDefinitionService) UpdateDefinitionContext(
+	ctx context.Context,
+	definitionID string,
+	definitionParams *map[string]interface{},
 ) (definition *types.PolicyDefinition, err error) {
 	log.Debug("UpdateDefinition")
 
-	data, status, err := pds.concertoService.Put(fmt.Sprintf(APIPathPolicyDefinition, definitionID), definitionParams)
+	data, status, err := pds.concertoService.PutContext(
+		ctx,
+		fmt.Sprintf(APIPathPolicyDefinition, definitionID),
+		definitionParams,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -118,9 +178,14 @@ func (pds *PolicyDefinitionService) UpdateDefinition(
 
 // DeleteDefinition deletes a policy definition by its ID
 func (pds *PolicyDefinitionService) DeleteDefinition(definitionID string) (err error) {
+	return pds.DeleteDefinitionContext(context.Background(), definitionID)
+}
+
+// DeleteDefinitionContext is like DeleteDefinition, but the request is cancelled as soon as ctx is done
+func (pds *PolicyDefinitionService) DeleteDefinitionContext(ctx context.Context, definitionID string) (err error) {
 	log.Debug("DeleteDefinition")
 
-	data, status, err := pds.concertoService.Delete(fmt.Sprintf(APIPathPolicyDefinition, definitionID))
+	data, status, err := pds.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathPolicyDefinition, definitionID))
 	if err != nil {
 		return err
 	}
@@ -135,21 +200,56 @@ func (pds *PolicyDefinitionService) DeleteDefinition(definitionID string) (err e
 // ListAssignments returns the list of policy assignments for a policy definition as an array of PolicyAssignment
 func (pds *PolicyDefinitionService) ListAssignments(
 	definitionID string,
+) (assignments []*types.PolicyAssignment, err error) {
+	return pds.ListAssignmentsContext(context.Background(), definitionID)
+}
+
+// ListAssignmentsContext is like ListAssignments, but the request is cancelled as soon as ctx is done
+func (pds *PolicyDefinitionService) ListAssignmentsContext(
+	ctx context.Context,
+	definitionID string,
 ) (assignments []*types.PolicyAssignment, err error) {
 	log.Debug("ListAssignments")
 
-	data, status, err := pds.concertoService.Get(fmt.Sprintf(APIPathPolicyDefinitionAssignments, definitionID))
+	assignments = []*types.PolicyAssignment{}
+	err = pds.ListAssignmentsPagesContext(ctx, definitionID, func(page []*types.PolicyAssignment) bool {
+		assignments = append(assignments, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return assignments, nil
+}
 
-	if err = json.Unmarshal(data, &assignments); err != nil {
-		return nil, err
-	}
+// ListAssignmentsPages is like ListAssignments, but the list is streamed to fn one page at a time, until fn returns
+// false
+func (pds *PolicyDefinitionService) ListAssignmentsPages(
+	definitionID string,
+	fn func(assignments []*types.PolicyAssignment) bool,
+) error {
+	return pds.ListAssignmentsPagesContext(context.Background(), definitionID, fn)
+}
 
-	return assignments, nil
+// ListAssignmentsPagesContext is like ListAssignmentsPages, but the requests are cancelled as soon as ctx is done. The
+// page options carried by ctx are applied
+func (pds *PolicyDefinitionService) ListAssignmentsPagesContext(
+	ctx context.Context,
+	definitionID string,
+	fn func(assignments []*types.PolicyAssignment) bool,
+) error {
+	log.Debug("ListAssignmentsPages")
+
+	pager := utils.NewPager(ctx, pds.concertoService, fmt.Sprintf(APIPathPolicyDefinitionAssignments, definitionID))
+	for pager.Next() {
+		var assignments []*types.PolicyAssignment
+		if err := pager.Decode(&assignments); err != nil {
+			return err
+		}
+		if !fn(assignments) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
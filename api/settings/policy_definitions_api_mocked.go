@@ -28,7 +28,7 @@ func ListDefinitionsMocked(t *testing.T, policyDefinitionsIn []*types.PolicyDefi
 	assert.Nil(err, "PolicyDefinitions test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathPolicyDefinitions).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathPolicyDefinitions, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	policyDefinitionsOut, err := ds.ListDefinitions()
 
 	assert.Nil(err, "Error getting policy definitions")
@@ -56,7 +56,8 @@ func ListDefinitionsFailErrMocked(
 	assert.Nil(err, "PolicyDefinitions test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathPolicyDefinitions).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathPolicyDefinitions, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	policyDefinitionsOut, err := ds.ListDefinitions()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -85,7 +86,7 @@ func ListDefinitionsFailStatusMocked(
 	assert.Nil(err, "PolicyDefinitions test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathPolicyDefinitions).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathPolicyDefinitions, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	policyDefinitionsOut, err := ds.ListDefinitions()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -113,7 +114,7 @@ func ListDefinitionsFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathPolicyDefinitions).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathPolicyDefinitions, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	policyDefinitionsOut, err := ds.ListDefinitions()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -558,7 +559,11 @@ func ListDefinitionAssignmentsMocked(
 	assert.Nil(err, "PolicyAssignments test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathPolicyDefinitionAssignments, definitionID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathPolicyDefinitionAssignments, definitionID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	policyAssignmentsOut, err := ds.ListAssignments(definitionID)
 
 	assert.Nil(err, "Error getting policy definitions")
@@ -587,8 +592,11 @@ func ListDefinitionAssignmentsFailErrMocked(
 	assert.Nil(err, "PolicyAssignments test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathPolicyDefinitionAssignments, definitionID)).
-		Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathPolicyDefinitionAssignments, definitionID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, fmt.Errorf("mocked error"))
 	policyAssignmentsOut, err := ds.ListAssignments(definitionID)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -618,7 +626,11 @@ func ListDefinitionAssignmentsFailStatusMocked(
 	assert.Nil(err, "PolicyAssignments test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathPolicyDefinitionAssignments, definitionID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathPolicyDefinitionAssignments, definitionID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 499, nil)
 	policyAssignmentsOut, err := ds.ListAssignments(definitionID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -647,7 +659,11 @@ func ListDefinitionAssignmentsFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathPolicyDefinitionAssignments, definitionID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathPolicyDefinitionAssignments, definitionID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	policyAssignmentsOut, err := ds.ListAssignments(definitionID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
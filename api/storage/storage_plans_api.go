@@ -3,6 +3,7 @@
 package storage
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -31,9 +32,17 @@ func NewStoragePlanService(concertoService utils.ConcertoService) (*StoragePlanS
 
 // GetStoragePlan returns a storage plan by its ID
 func (sps *StoragePlanService) GetStoragePlan(storagePlanID string) (storagePlan *types.StoragePlan, err error) {
+	return sps.GetStoragePlanContext(context.Background(), storagePlanID)
+}
+
+// GetStoragePlanContext is like GetStoragePlan, but the request is cancelled as soon as ctx is done
+func (sps *StoragePlanService) GetStoragePlanContext(
+	ctx context.Context,
+	storagePlanID string,
+) (storagePlan *types.StoragePlan, err error) {
 	log.Debug("GetStoragePlan")
 
-	data, status, err := sps.concertoService.Get(fmt.Sprintf(APIPathStoragePlan, storagePlanID))
+	data, status, err := sps.concertoService.GetContext(ctx, fmt.Sprintf(APIPathStoragePlan, storagePlanID))
 	if err != nil {
 		return nil, err
 	}
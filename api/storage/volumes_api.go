@@ -3,6 +3,7 @@
 package storage
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -35,35 +36,70 @@ func NewVolumeService(concertoService utils.ConcertoService) (*VolumeService, er
 
 // ListVolumes returns the list of Volumes as an array of Volume
 func (vs *VolumeService) ListVolumes(serverID string) (volumes []*types.Volume, err error) {
-	log.Debug("ListVolumes")
-
-	path := APIPathStorageVolumes
-	if serverID != "" {
-		path = fmt.Sprintf(APIPathCloudServerVolumes, serverID)
+	return vs.ListVolumesContext(context.Background(), serverID)
+}
 
-	}
-	data, status, err := vs.concertoService.Get(path)
+// ListVolumesContext is like ListVolumes, but the request is cancelled as soon as ctx is done
+func (vs *VolumeService) ListVolumesContext(ctx context.Context, serverID string) (volumes []*types.Volume, err error) {
+	log.Debug("ListVolumes")
 
+	volumes = []*types.Volume{}
+	err = vs.ListVolumesPagesContext(ctx, serverID, func(page []*types.Volume) bool {
+		volumes = append(volumes, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return volumes, nil
+}
 
-	if err = json.Unmarshal(data, &volumes); err != nil {
-		return nil, err
+// ListVolumesPages is like ListVolumes, but the list is streamed to fn one page at a time, until fn returns false
+func (vs *VolumeService) ListVolumesPages(
+	serverID string,
+	fn func(volumes []*types.Volume) bool,
+) error {
+	return vs.ListVolumesPagesContext(context.Background(), serverID, fn)
+}
+
+// ListVolumesPagesContext is like ListVolumesPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (vs *VolumeService) ListVolumesPagesContext(
+	ctx context.Context,
+	serverID string,
+	fn func(volumes []*types.Volume) bool,
+) error {
+	log.Debug("ListVolumesPages")
+
+	path := APIPathStorageVolumes
+	if serverID != "" {
+		path = fmt.Sprintf(APIPathCloudServerVolumes, serverID)
 	}
 
-	return volumes, nil
+	pager := utils.NewPager(ctx, vs.concertoService, path)
+	for pager.Next() {
+		var volumes []*types.Volume
+		if err := pager.Decode(&volumes); err != nil {
+			return err
+		}
+		if !fn(volumes) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // GetVolume returns a Volume by its ID
 func (vs *VolumeService) GetVolume(volumeID string) (volume *types.Volume, err error) {
+	return vs.GetVolumeContext(context.Background(), volumeID)
+}
+
+// GetVolumeContext is like GetVolume, but the request is cancelled as soon as ctx is done
+func (vs *VolumeService) GetVolumeContext(ctx context.Context, volumeID string) (volume *types.Volume, err error) {
 	log.Debug("GetVolume")
 
-	data, status, err := vs.concertoService.Get(fmt.Sprintf(APIPathStorageVolume, volumeID))
+	data, status, err := vs.concertoService.GetContext(ctx, fmt.Sprintf(APIPathStorageVolume, volumeID))
 	if err != nil {
 		return nil, err
 	}
@@ -81,9 +117,17 @@ func (vs *VolumeService) GetVolume(volumeID string) (volume *types.Volume, err e
 
 // CreateVolume creates a Volume
 func (vs *VolumeService) CreateVolume(volumeParams *map[string]interface{}) (volume *types.Volume, err error) {
+	return vs.CreateVolumeContext(context.Background(), volumeParams)
+}
+
+// CreateVolumeContext is like CreateVolume, but the request is cancelled as soon as ctx is done
+func (vs *VolumeService) CreateVolumeContext(
+	ctx context.Context,
+	volumeParams *map[string]interface{},
+) (volume *types.Volume, err error) {
 	log.Debug("CreateVolume")
 
-	data, status, err := vs.concertoService.Post(APIPathStorageVolumes, volumeParams)
+	data, status, err := vs.concertoService.PostContext(ctx, APIPathStorageVolumes, volumeParams)
 
 	if err != nil {
 		return nil, err
@@ -100,14 +144,40 @@ func (vs *VolumeService) CreateVolume(volumeParams *map[string]interface{}) (vol
 	return volume, nil
 }
 
+// CreateVolumeWithInput is like CreateVolume, but takes a typed input, which is validated before being sent
+func (vs *VolumeService) CreateVolumeWithInput(input *types.VolumeCreateInput) (volume *types.Volume, err error) {
+	return vs.CreateVolumeWithInputContext(context.Background(), input)
+}
+
+// CreateVolumeWithInputContext is like CreateVolumeWithInput, but the request is cancelled as soon as ctx is done
+func (vs *VolumeService) CreateVolumeWithInputContext(
+	ctx context.Context,
+	input *types.VolumeCreateInput,
+) (volume *types.Volume, err error) {
+	volumeParams, err := types.InputParams(input)
+	if err != nil {
+		return nil, err
+	}
+	return vs.CreateVolumeContext(ctx, volumeParams)
+}
+
 // UpdateVolume updates a Volume by its ID
 func (vs *VolumeService) UpdateVolume(
 	volumeID string,
 	volumeParams *map[string]interface{},
+) (volume *types.Volume, err error) {
+	return vs.UpdateVolumeContext(context.Background(), volumeID, volumeParams)
+}
+
+// UpdateVolumeContext is like UpdateVolume, but the request is cancelled as soon as ctx is done
+func (vs *VolumeService) UpdateVolumeContext(
+	ctx context.Context,
+	volumeID string,
+	volumeParams *map[string]interface{},
 ) (volume *types.Volume, err error) {
 	log.Debug("UpdateVolume")
 
-	data, status, err := vs.concertoService.Put(fmt.Sprintf(APIPathStorageVolume, volumeID), volumeParams)
+	data, status, err := vs.concertoService.PutContext(ctx, fmt.Sprintf(APIPathStorageVolume, volumeID), volumeParams)
 
 	if err != nil {
 		return nil, err
@@ -124,14 +194,45 @@ func (vs *VolumeService) UpdateVolume(
 	return volume, nil
 }
 
+// UpdateVolumeWithInput is like UpdateVolume, but takes a typed input, which is validated before being sent
+func (vs *VolumeService) UpdateVolumeWithInput(
+	volumeID string,
+	input *types.VolumeUpdateInput,
+) (volume *types.Volume, err error) {
+	return vs.UpdateVolumeWithInputContext(context.Background(), volumeID, input)
+}
+
+// UpdateVolumeWithInputContext is like UpdateVolumeWithInput, but the request is cancelled as soon as ctx is done
+func (vs *VolumeService) UpdateVolumeWithInputContext(
+	ctx context.Context,
+	volumeID string,
+	input *types.VolumeUpdateInput,
+) (volume *types.Volume, err error) {
+	volumeParams, err := types.InputParams(input)
+	if err != nil {
+		return nil, err
+	}
+	return vs.UpdateVolumeContext(ctx, volumeID, volumeParams)
+}
+
 // AttachVolume attaches a Volume by its ID
 func (vs *VolumeService) AttachVolume(
 	volumeID string,
 	volumeParams *map[string]interface{},
+) (server *types.Server, err error) {
+	return vs.AttachVolumeContext(context.Background(), volumeID, volumeParams)
+}
+
+// AttachVolumeContext is like AttachVolume, but the request is cancelled as soon as ctx is done
+func (vs *VolumeService) AttachVolumeContext(
+	ctx context.Context,
+	volumeID string,
+	volumeParams *map[string]interface{},
 ) (server *types.Server, err error) {
 	log.Debug("AttachVolume")
 
-	data, status, err := vs.concertoService.Post(
+	data, status, err := vs.concertoService.PostContext(
+		ctx,
 		fmt.Sprintf(APIPathStorageVolumeAttachedServer, volumeID),
 		volumeParams,
 	)
@@ -153,9 +254,17 @@ func (vs *VolumeService) AttachVolume(
 
 // DetachVolume detaches a Volume by its ID
 func (vs *VolumeService) DetachVolume(volumeID string) (err error) {
+	return vs.DetachVolumeContext(context.Background(), volumeID)
+}
+
+// DetachVolumeContext is like DetachVolume, but the request is cancelled as soon as ctx is done
+func (vs *VolumeService) DetachVolumeContext(ctx context.Context, volumeID string) (err error) {
 	log.Debug("DetachVolume")
 
-	data, status, err := vs.concertoService.Delete(fmt.Sprintf(APIPathStorageVolumeAttachedServer, volumeID))
+	data, status, err := vs.concertoService.DeleteContext(
+		ctx,
+		fmt.Sprintf(APIPathStorageVolumeAttachedServer, volumeID),
+	)
 	if err != nil {
 		return err
 	}
@@ -169,9 +278,14 @@ func (vs *VolumeService) DetachVolume(volumeID string) (err error) {
 
 // DeleteVolume deletes a Volume by its ID
 func (vs *VolumeService) DeleteVolume(volumeID string) (err error) {
+	return vs.DeleteVolumeContext(context.Background(), volumeID)
+}
+
+// DeleteVolumeContext is like DeleteVolume, but the request is cancelled as soon as ctx is done
+func (vs *VolumeService) DeleteVolumeContext(ctx context.Context, volumeID string) (err error) {
 	log.Debug("DeleteVolume")
 
-	data, status, err := vs.concertoService.Delete(fmt.Sprintf(APIPathStorageVolume, volumeID))
+	data, status, err := vs.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathStorageVolume, volumeID))
 	if err != nil {
 		return err
 	}
@@ -185,9 +299,14 @@ func (vs *VolumeService) DeleteVolume(volumeID string) (err error) {
 
 // DiscardVolume discards a Volume by its ID
 func (vs *VolumeService) DiscardVolume(volumeID string) (err error) {
+	return vs.DiscardVolumeContext(context.Background(), volumeID)
+}
+
+// DiscardVolumeContext is like DiscardVolume, but the request is cancelled as soon as ctx is done
+func (vs *VolumeService) DiscardVolumeContext(ctx context.Context, volumeID string) (err error) {
 	log.Debug("DiscardVolume")
 
-	data, status, err := vs.concertoService.Delete(fmt.Sprintf(APIPathStorageVolumeDiscard, volumeID))
+	data, status, err := vs.concertoService.DeleteContext(ctx, fmt.Sprintf(APIPathStorageVolumeDiscard, volumeID))
 	if err != nil {
 		return err
 	}
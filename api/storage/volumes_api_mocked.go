@@ -30,7 +30,7 @@ func ListVolumesMocked(t *testing.T, volumesIn []*types.Volume) []*types.Volume
 	assert.Nil(err, "Volume test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathStorageVolumes).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathStorageVolumes, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	volumesOut, err := ds.ListVolumes("")
 	assert.Nil(err, "Error getting volume list")
 	assert.Equal(volumesIn, volumesOut, "ListVolumes returned different volumes")
@@ -53,7 +53,11 @@ func ListVolumesMockedFilteredByServer(t *testing.T, volumesIn []*types.Volume)
 	assert.Nil(err, "Volume test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathCloudServerVolumes, volumesIn[0].AttachedServerID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathCloudServerVolumes, volumesIn[0].AttachedServerID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	volumesOut, err := ds.ListVolumes(volumesIn[0].AttachedServerID)
 	assert.Nil(err, "Error getting volume list filtered by server")
 	assert.Equal(volumesIn, volumesOut, "ListVolumes returned different volumes")
@@ -77,7 +81,8 @@ func ListVolumesFailErrMocked(t *testing.T, volumesIn []*types.Volume) []*types.
 	assert.Nil(err, "Volume test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathStorageVolumes).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathStorageVolumes, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	volumesOut, err := ds.ListVolumes("")
 
 	assert.NotNil(err, "We are expecting an error")
@@ -103,7 +108,7 @@ func ListVolumesFailStatusMocked(t *testing.T, volumesIn []*types.Volume) []*typ
 	assert.Nil(err, "Volume test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathStorageVolumes).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathStorageVolumes, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	volumesOut, err := ds.ListVolumes("")
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -128,7 +133,7 @@ func ListVolumesFailJSONMocked(t *testing.T, volumesIn []*types.Volume) []*types
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathStorageVolumes).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathStorageVolumes, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	volumesOut, err := ds.ListVolumes("")
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -267,6 +272,45 @@ func CreateVolumeMocked(t *testing.T, volumeIn *types.Volume) *types.Volume {
 	return volumeOut
 }
 
+// CreateVolumeWithInputMocked test mocked function
+func CreateVolumeWithInputMocked(t *testing.T, volumeIn *types.Volume) *types.Volume {
+
+	assert := assert.New(t)
+
+	// wire up
+	cs := &utils.MockConcertoService{}
+	ds, err := NewVolumeService(cs)
+	assert.Nil(err, "Couldn't load volume service")
+	assert.NotNil(ds, "Volume service not instanced")
+
+	input := &types.VolumeCreateInput{
+		Name:           volumeIn.Name,
+		Size:           volumeIn.Size,
+		CloudAccountID: volumeIn.CloudAccountID,
+		StoragePlanID:  volumeIn.StoragePlanID,
+		LabelIDs:       []string{"fakeLabelID0"},
+	}
+	mapIn := &map[string]interface{}{
+		"name":             volumeIn.Name,
+		"size":             float64(volumeIn.Size),
+		"cloud_account_id": volumeIn.CloudAccountID,
+		"storage_plan_id":  volumeIn.StoragePlanID,
+		"label_ids":        []interface{}{"fakeLabelID0"},
+	}
+
+	// to json
+	dOut, err := json.Marshal(volumeIn)
+	assert.Nil(err, "Volume test data corrupted")
+
+	// call service
+	cs.On("Post", APIPathStorageVolumes, mapIn).Return(dOut, 200, nil)
+	volumeOut, err := ds.CreateVolumeWithInput(input)
+	assert.Nil(err, "Error creating volume")
+	assert.Equal(volumeIn, volumeOut, "CreateVolumeWithInput returned different volumes")
+
+	return volumeOut
+}
+
 // CreateVolumeFailErrMocked test mocked function
 func CreateVolumeFailErrMocked(t *testing.T, volumeIn *types.Volume) *types.Volume {
 
@@ -384,6 +428,52 @@ func UpdateVolumeMocked(t *testing.T, volumeIn *types.Volume) *types.Volume {
 	return volumeOut
 }
 
+// UpdateVolumeWithInputMocked test mocked function
+func UpdateVolumeWithInputMocked(t *testing.T, volumeIn *types.Volume) *types.Volume {
+
+	assert := assert.New(t)
+
+	// wire up
+	cs := &utils.MockConcertoService{}
+	ds, err := NewVolumeService(cs)
+	assert.Nil(err, "Couldn't load volume service")
+	assert.NotNil(ds, "Volume service not instanced")
+
+	mapIn := &map[string]interface{}{"name": volumeIn.Name}
+
+	// to json
+	dOut, err := json.Marshal(volumeIn)
+	assert.Nil(err, "Volume test data corrupted")
+
+	// call service
+	cs.On("Put", fmt.Sprintf(APIPathStorageVolume, volumeIn.ID), mapIn).Return(dOut, 200, nil)
+	volumeOut, err := ds.UpdateVolumeWithInput(volumeIn.ID, &types.VolumeUpdateInput{Name: volumeIn.Name})
+	assert.Nil(err, "Error updating volume")
+	assert.Equal(volumeIn, volumeOut, "UpdateVolumeWithInput returned different volumes")
+
+	return volumeOut
+}
+
+// UpdateVolumeWithInputFailValidationMocked test mocked function
+func UpdateVolumeWithInputFailValidationMocked(t *testing.T, volumeIn *types.Volume) *types.Volume {
+
+	assert := assert.New(t)
+
+	// wire up
+	cs := &utils.MockConcertoService{}
+	ds, err := NewVolumeService(cs)
+	assert.Nil(err, "Couldn't load volume service")
+	assert.NotNil(ds, "Volume service not instanced")
+
+	// call service
+	volumeOut, err := ds.UpdateVolumeWithInput(volumeIn.ID, &types.VolumeUpdateInput{})
+	assert.Nil(volumeOut, "Expecting nil output")
+	assert.Equal(&types.MissingFieldsError{Fields: []string{"name"}}, err, "Volumes cannot be updated without name")
+	cs.AssertNotCalled(t, "Put")
+
+	return volumeOut
+}
+
 // UpdateVolumeFailErrMocked test mocked function
 func UpdateVolumeFailErrMocked(t *testing.T, volumeIn *types.Volume) *types.Volume {
 
@@ -39,6 +39,7 @@ func TestCreateVolume(t *testing.T) {
 	volumesIn := testdata.GetVolumeData()
 	for _, volumeIn := range volumesIn {
 		CreateVolumeMocked(t, volumeIn)
+		CreateVolumeWithInputMocked(t, volumeIn)
 		CreateVolumeFailErrMocked(t, volumeIn)
 		CreateVolumeFailStatusMocked(t, volumeIn)
 		CreateVolumeFailJSONMocked(t, volumeIn)
@@ -49,6 +50,8 @@ func TestUpdateVolume(t *testing.T) {
 	volumesIn := testdata.GetVolumeData()
 	for _, volumeIn := range volumesIn {
 		UpdateVolumeMocked(t, volumeIn)
+		UpdateVolumeWithInputMocked(t, volumeIn)
+		UpdateVolumeWithInputFailValidationMocked(t, volumeIn)
 		UpdateVolumeFailErrMocked(t, volumeIn)
 		UpdateVolumeFailStatusMocked(t, volumeIn)
 		UpdateVolumeFailJSONMocked(t, volumeIn)
@@ -68,3 +68,32 @@ func (fp *FirewallProfile) ConvertFlagParamsToRules(rulesIn string) error {
 	}
 	return nil
 }
+
+// FirewallProfileCreateInput is the payload of FirewallProfileService.CreateFirewallProfileWithInput
+type FirewallProfileCreateInput struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Rules       []Rule   `json:"rules,omitempty"`
+	LabelIDs    []string `json:"label_ids,omitempty"`
+}
+
+// Validate checks that the name and description are given
+func (i *FirewallProfileCreateInput) Validate() error {
+	return checkRequired(
+		requiredField{"name", i.Name == ""},
+		requiredField{"description", i.Description == ""},
+	)
+}
+
+// FirewallProfileUpdateInput is the payload of FirewallProfileService.UpdateFirewallProfileWithInput. Fields not given
+// are left as they are
+type FirewallProfileUpdateInput struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Rules       []Rule `json:"rules,omitempty"`
+}
+
+// Validate accepts any input, as no field is required
+func (i *FirewallProfileUpdateInput) Validate() error {
+	return nil
+}
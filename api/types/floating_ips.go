@@ -15,3 +15,30 @@ type FloatingIP struct {
 	Brownfield       bool   `json:"brownfield,omitempty"         header:"BROWNFIELD"         show:"nolist,noshow"`
 	LabelableFields
 }
+
+// FloatingIPCreateInput is the payload of FloatingIPService.CreateFloatingIPWithInput
+type FloatingIPCreateInput struct {
+	Name           string   `json:"name"`
+	CloudAccountID string   `json:"cloud_account_id"`
+	RealmID        string   `json:"realm_id"`
+	LabelIDs       []string `json:"label_ids,omitempty"`
+}
+
+// Validate checks that every field but the label IDs is given
+func (i *FloatingIPCreateInput) Validate() error {
+	return checkRequired(
+		requiredField{"name", i.Name == ""},
+		requiredField{"cloud_account_id", i.CloudAccountID == ""},
+		requiredField{"realm_id", i.RealmID == ""},
+	)
+}
+
+// FloatingIPUpdateInput is the payload of FloatingIPService.UpdateFloatingIPWithInput
+type FloatingIPUpdateInput struct {
+	Name string `json:"name"`
+}
+
+// Validate checks that the name is given
+func (i *FloatingIPUpdateInput) Validate() error {
+	return checkRequired(requiredField{"name", i.Name == ""})
+}
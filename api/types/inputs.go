@@ -0,0 +1,63 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Validator is a typed payload of a create or update operation, such as ServerCreateInput
+type Validator interface {
+	// Validate returns a MissingFieldsError if any required field is not given
+	Validate() error
+}
+
+// MissingFieldsError is the error of an input not giving some of its required fields, named as in the payload
+type MissingFieldsError struct {
+	Fields []string
+}
+
+// Error lists the missing fields
+func (e *MissingFieldsError) Error() string {
+	return fmt.Sprintf("missing required fields: %s", strings.Join(e.Fields, ", "))
+}
+
+// requiredField is a field of an input, named as in the payload, and whether it is missing
+type requiredField struct {
+	name    string
+	missing bool
+}
+
+// checkRequired returns a MissingFieldsError for the missing fields, if any
+func checkRequired(fields ...requiredField) error {
+	var missing []string
+	for _, field := range fields {
+		if field.missing {
+			missing = append(missing, field.name)
+		}
+	}
+	if len(missing) > 0 {
+		return &MissingFieldsError{Fields: missing}
+	}
+	return nil
+}
+
+// InputParams validates the input and returns it as the payload sent to the API. The typed methods of the services
+// send it through their map based counterparts, which remain the ones making the requests: those pass any field they
+// are given through to the API, while an input only holds the fields it models
+func InputParams(input Validator) (*map[string]interface{}, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+	params := make(map[string]interface{})
+	if err = json.Unmarshal(data, &params); err != nil {
+		return nil, err
+	}
+	return &params, nil
+}
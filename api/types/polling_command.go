@@ -2,10 +2,12 @@
 
 package types
 
+// PollingCommand is a command for the polling agent to run, killed after Timeout seconds, if given
 type PollingCommand struct {
-	ID       string `json:"id"        header:"ID"`
-	Script   string `json:"script"    header:"SCRIPT"`
-	Stdout   string `json:"stdout"    header:"STDOUT"`
-	Stderr   string `json:"stderr"    header:"STDERR"`
-	ExitCode int    `json:"exit_code" header:"EXIT_CODE"`
+	ID       string `json:"id"                header:"ID"`
+	Script   string `json:"script"            header:"SCRIPT"`
+	Stdout   string `json:"stdout"            header:"STDOUT"`
+	Stderr   string `json:"stderr"            header:"STDERR"`
+	ExitCode int    `json:"exit_code"         header:"EXIT_CODE"`
+	Timeout  int    `json:"timeout,omitempty" header:"TIMEOUT"   show:"nolist"`
 }
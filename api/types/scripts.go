@@ -12,3 +12,34 @@ type Script struct {
 	ResourceType string   `json:"resource_type" header:"RESOURCE_TYPE" show:"nolist"`
 	LabelableFields
 }
+
+// ScriptCreateInput is the payload of ScriptService.CreateScriptWithInput
+type ScriptCreateInput struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Code        string   `json:"code"`
+	Parameters  []string `json:"parameters,omitempty"`
+	LabelIDs    []string `json:"label_ids,omitempty"`
+}
+
+// Validate checks that the name, description and code are given
+func (i *ScriptCreateInput) Validate() error {
+	return checkRequired(
+		requiredField{"name", i.Name == ""},
+		requiredField{"description", i.Description == ""},
+		requiredField{"code", i.Code == ""},
+	)
+}
+
+// ScriptUpdateInput is the payload of ScriptService.UpdateScriptWithInput. Fields not given are left as they are
+type ScriptUpdateInput struct {
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Code        string   `json:"code,omitempty"`
+	Parameters  []string `json:"parameters,omitempty"`
+}
+
+// Validate accepts any input, as no field is required
+func (i *ScriptUpdateInput) Validate() error {
+	return nil
+}
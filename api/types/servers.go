@@ -33,3 +33,36 @@ type ScriptChar struct {
 	ScriptID        string                 `json:"script_id"        header:"SCRIPT_ID"`
 	ExecutionOrder  int                    `json:"execution_order"  header:"EXECUTION_ORDER"  show:"noshow,nolist"`
 }
+
+// ServerCreateInput is the payload of ServerService.CreateServerWithInput
+type ServerCreateInput struct {
+	Name              string   `json:"name"`
+	SSHProfileID      string   `json:"ssh_profile_id"`
+	FirewallProfileID string   `json:"firewall_profile_id"`
+	TemplateID        string   `json:"template_id"`
+	ServerPlanID      string   `json:"server_plan_id"`
+	CloudAccountID    string   `json:"cloud_account_id"`
+	LabelIDs          []string `json:"label_ids,omitempty"`
+}
+
+// Validate checks that every field but the label IDs is given
+func (i *ServerCreateInput) Validate() error {
+	return checkRequired(
+		requiredField{"name", i.Name == ""},
+		requiredField{"ssh_profile_id", i.SSHProfileID == ""},
+		requiredField{"firewall_profile_id", i.FirewallProfileID == ""},
+		requiredField{"template_id", i.TemplateID == ""},
+		requiredField{"server_plan_id", i.ServerPlanID == ""},
+		requiredField{"cloud_account_id", i.CloudAccountID == ""},
+	)
+}
+
+// ServerUpdateInput is the payload of ServerService.UpdateServerWithInput. Fields not given are left as they are
+type ServerUpdateInput struct {
+	Name string `json:"name,omitempty"`
+}
+
+// Validate accepts any input, as no field is required
+func (i *ServerUpdateInput) Validate() error {
+	return nil
+}
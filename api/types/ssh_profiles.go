@@ -10,3 +10,32 @@ type SSHProfile struct {
 	ResourceType string `json:"resource_type" header:"RESOURCE_TYPE" show:"nolist"`
 	LabelableFields
 }
+
+// SSHProfileCreateInput is the payload of SSHProfileService.CreateSSHProfileWithInput
+type SSHProfileCreateInput struct {
+	Name       string   `json:"name"`
+	PublicKey  string   `json:"public_key"`
+	PrivateKey string   `json:"private_key,omitempty"`
+	LabelIDs   []string `json:"label_ids,omitempty"`
+}
+
+// Validate checks that the name and public key are given
+func (i *SSHProfileCreateInput) Validate() error {
+	return checkRequired(
+		requiredField{"name", i.Name == ""},
+		requiredField{"public_key", i.PublicKey == ""},
+	)
+}
+
+// SSHProfileUpdateInput is the payload of SSHProfileService.UpdateSSHProfileWithInput. Fields not given are left as
+// they are
+type SSHProfileUpdateInput struct {
+	Name       string `json:"name,omitempty"`
+	PublicKey  string `json:"public_key,omitempty"`
+	PrivateKey string `json:"private_key,omitempty"`
+}
+
+// Validate accepts any input, as no field is required
+func (i *SSHProfileUpdateInput) Validate() error {
+	return nil
+}
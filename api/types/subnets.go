@@ -14,3 +14,30 @@ type Subnet struct {
 	ResourceType           string `json:"resource_type" header:"RESOURCE_TYPE" show:"nolist"`
 	Brownfield             bool   `json:"brownfield,omitempty" header:"BROWNFIELD" show:"nolist,noshow"`
 }
+
+// SubnetCreateInput is the payload of SubnetService.CreateSubnetWithInput
+type SubnetCreateInput struct {
+	Name           string `json:"name"`
+	CIDR           string `json:"cidr"`
+	Type           string `json:"type"`
+	CloudAccountID string `json:"cloud_account_id,omitempty"`
+}
+
+// Validate checks that the name, CIDR and type are given
+func (i *SubnetCreateInput) Validate() error {
+	return checkRequired(
+		requiredField{"name", i.Name == ""},
+		requiredField{"cidr", i.CIDR == ""},
+		requiredField{"type", i.Type == ""},
+	)
+}
+
+// SubnetUpdateInput is the payload of SubnetService.UpdateSubnetWithInput
+type SubnetUpdateInput struct {
+	Name string `json:"name"`
+}
+
+// Validate checks that the name is given
+func (i *SubnetUpdateInput) Validate() error {
+	return checkRequired(requiredField{"name", i.Name == ""})
+}
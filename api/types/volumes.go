@@ -16,3 +16,32 @@ type Volume struct {
 	ResourceType     string `json:"resource_type"                header:"RESOURCE_TYPE"      show:"nolist"`
 	LabelableFields
 }
+
+// VolumeCreateInput is the payload of VolumeService.CreateVolumeWithInput
+type VolumeCreateInput struct {
+	Name           string   `json:"name"`
+	Size           int      `json:"size"`
+	CloudAccountID string   `json:"cloud_account_id"`
+	StoragePlanID  string   `json:"storage_plan_id"`
+	LabelIDs       []string `json:"label_ids,omitempty"`
+}
+
+// Validate checks that every field but the label IDs is given
+func (i *VolumeCreateInput) Validate() error {
+	return checkRequired(
+		requiredField{"name", i.Name == ""},
+		requiredField{"size", i.Size == 0},
+		requiredField{"cloud_account_id", i.CloudAccountID == ""},
+		requiredField{"storage_plan_id", i.StoragePlanID == ""},
+	)
+}
+
+// VolumeUpdateInput is the payload of VolumeService.UpdateVolumeWithInput
+type VolumeUpdateInput struct {
+	Name string `json:"name"`
+}
+
+// Validate checks that the name is given
+func (i *VolumeUpdateInput) Validate() error {
+	return checkRequired(requiredField{"name", i.Name == ""})
+}
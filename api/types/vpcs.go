@@ -16,3 +16,32 @@ type Vpc struct {
 	Brownfield         bool     `json:"brownfield,omitempty"           header:"BROWNFIELD"           show:"nolist,noshow"`
 	LabelableFields
 }
+
+// VPCCreateInput is the payload of VPCService.CreateVPCWithInput
+type VPCCreateInput struct {
+	Name              string   `json:"name"`
+	CIDR              string   `json:"cidr"`
+	CloudAccountID    string   `json:"cloud_account_id"`
+	RealmProviderName string   `json:"realm_provider_name"`
+	LabelIDs          []string `json:"label_ids,omitempty"`
+}
+
+// Validate checks that every field but the label IDs is given
+func (i *VPCCreateInput) Validate() error {
+	return checkRequired(
+		requiredField{"name", i.Name == ""},
+		requiredField{"cidr", i.CIDR == ""},
+		requiredField{"cloud_account_id", i.CloudAccountID == ""},
+		requiredField{"realm_provider_name", i.RealmProviderName == ""},
+	)
+}
+
+// VPCUpdateInput is the payload of VPCService.UpdateVPCWithInput
+type VPCUpdateInput struct {
+	Name string `json:"name"`
+}
+
+// Validate checks that the name is given
+func (i *VPCUpdateInput) Validate() error {
+	return checkRequired(requiredField{"name", i.Name == ""})
+}
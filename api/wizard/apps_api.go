@@ -3,6 +3,7 @@
 package wizard
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -32,29 +33,62 @@ func NewAppService(concertoService utils.ConcertoService) (*AppService, error) {
 
 // ListApps returns the list of apps as an array of App
 func (as *AppService) ListApps() (apps []*types.WizardApp, err error) {
+	return as.ListAppsContext(context.Background())
+}
+
+// ListAppsContext is like ListApps, but the request is cancelled as soon as ctx is done
+func (as *AppService) ListAppsContext(ctx context.Context) (apps []*types.WizardApp, err error) {
 	log.Debug("ListApps")
 
-	data, status, err := as.concertoService.Get(APIPathWizardApps)
+	apps = []*types.WizardApp{}
+	err = as.ListAppsPagesContext(ctx, func(page []*types.WizardApp) bool {
+		apps = append(apps, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return apps, nil
+}
 
-	if err = json.Unmarshal(data, &apps); err != nil {
-		return nil, err
-	}
+// ListAppsPages is like ListApps, but the list is streamed to fn one page at a time, until fn returns false
+func (as *AppService) ListAppsPages(fn func(apps []*types.WizardApp) bool) error {
+	return as.ListAppsPagesContext(context.Background(), fn)
+}
 
-	return apps, nil
+// ListAppsPagesContext is like ListAppsPages, but the requests are cancelled as soon as ctx is done. The page options
+// carried by ctx are applied
+func (as *AppService) ListAppsPagesContext(ctx context.Context, fn func(apps []*types.WizardApp) bool) error {
+	log.Debug("ListAppsPages")
+
+	pager := utils.NewPager(ctx, as.concertoService, APIPathWizardApps)
+	for pager.Next() {
+		var apps []*types.WizardApp
+		if err := pager.Decode(&apps); err != nil {
+			return err
+		}
+		if !fn(apps) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
 
 // DeployApp deploys a app
 func (as *AppService) DeployApp(appID string, appParams *map[string]interface{}) (app *types.Server, err error) {
+	return as.DeployAppContext(context.Background(), appID, appParams)
+}
+
+// DeployAppContext is like DeployApp, but the request is cancelled as soon as ctx is done
+func (as *AppService) DeployAppContext(
+	ctx context.Context,
+	appID string,
+	appParams *map[string]interface{},
+) (app *types.Server, err error) {
 	log.Debug("DeployApp")
 
-	data, status, err := as.concertoService.Post(fmt.Sprintf(APIPathWizardAppDeploy, appID), appParams)
+	data, status, err := as.concertoService.PostContext(ctx, fmt.Sprintf(APIPathWizardAppDeploy, appID), appParams)
 
 	if err != nil {
 		return nil, err
@@ -30,7 +30,7 @@ func ListAppsMocked(t *testing.T, appsIn []*types.WizardApp) []*types.WizardApp
 	assert.Nil(err, "App test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathWizardApps).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathWizardApps, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	appsOut, err := ds.ListApps()
 	assert.Nil(err, "Error getting app list")
 	assert.Equal(appsIn, appsOut, "ListApps returned different apps")
@@ -54,7 +54,8 @@ func ListAppsFailErrMocked(t *testing.T, appsIn []*types.WizardApp) []*types.Wiz
 	assert.Nil(err, "App test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathWizardApps).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathWizardApps, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	appsOut, err := ds.ListApps()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -80,7 +81,7 @@ func ListAppsFailStatusMocked(t *testing.T, appsIn []*types.WizardApp) []*types.
 	assert.Nil(err, "App test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathWizardApps).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathWizardApps, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	appsOut, err := ds.ListApps()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -105,7 +106,7 @@ func ListAppsFailJSONMocked(t *testing.T, appsIn []*types.WizardApp) []*types.Wi
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathWizardApps).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathWizardApps, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	appsOut, err := ds.ListApps()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
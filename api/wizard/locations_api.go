@@ -3,7 +3,7 @@
 package wizard
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 
 	"github.com/ingrammicro/cio/api/types"
@@ -31,20 +31,47 @@ func NewLocationService(concertoService utils.ConcertoService) (*LocationService
 
 // ListLocations returns the list of locations as an array of Location
 func (ls *LocationService) ListLocations() (locations []*types.Location, err error) {
+	return ls.ListLocationsContext(context.Background())
+}
+
+// ListLocationsContext is like ListLocations, but the request is cancelled as soon as ctx is done
+func (ls *LocationService) ListLocationsContext(ctx context.Context) (locations []*types.Location, err error) {
 	log.Debug("ListLocations")
 
-	data, status, err := ls.concertoService.Get(APIPathWizardLocations)
+	locations = []*types.Location{}
+	err = ls.ListLocationsPagesContext(ctx, func(page []*types.Location) bool {
+		locations = append(locations, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return locations, nil
+}
 
-	if err = json.Unmarshal(data, &locations); err != nil {
-		return nil, err
-	}
+// ListLocationsPages is like ListLocations, but the list is streamed to fn one page at a time, until fn returns false
+func (ls *LocationService) ListLocationsPages(fn func(locations []*types.Location) bool) error {
+	return ls.ListLocationsPagesContext(context.Background(), fn)
+}
 
-	return locations, nil
+// ListLocationsPagesContext is like ListLocationsPages, but the requests are cancelled as soon as ctx is done. The page
+// options carried by ctx are applied
+func (ls *LocationService) ListLocationsPagesContext(
+	ctx context.Context,
+	fn func(locations []*types.Location) bool,
+) error {
+	log.Debug("ListLocationsPages")
+
+	pager := utils.NewPager(ctx, ls.concertoService, APIPathWizardLocations)
+	for pager.Next() {
+		var locations []*types.Location
+		if err := pager.Decode(&locations); err != nil {
+			return err
+		}
+		if !fn(locations) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
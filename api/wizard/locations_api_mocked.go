@@ -30,7 +30,7 @@ func ListLocationsMocked(t *testing.T, locationsIn []*types.Location) []*types.L
 	assert.Nil(err, "Location test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathWizardLocations).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathWizardLocations, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	locationsOut, err := ds.ListLocations()
 	assert.Nil(err, "Error getting location list")
 	assert.Equal(locationsIn, locationsOut, "ListLocations returned different locations")
@@ -54,7 +54,8 @@ func ListLocationsFailErrMocked(t *testing.T, locationsIn []*types.Location) []*
 	assert.Nil(err, "Location test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathWizardLocations).Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(APIPathWizardLocations, 1, utils.DefaultPageSize)).
+		Return(dIn, 200, fmt.Errorf("mocked error"))
 	locationsOut, err := ds.ListLocations()
 
 	assert.NotNil(err, "We are expecting an error")
@@ -80,7 +81,7 @@ func ListLocationsFailStatusMocked(t *testing.T, locationsIn []*types.Location)
 	assert.Nil(err, "Location test data corrupted")
 
 	// call service
-	cs.On("Get", APIPathWizardLocations).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(APIPathWizardLocations, 1, utils.DefaultPageSize)).Return(dIn, 499, nil)
 	locationsOut, err := ds.ListLocations()
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -105,7 +106,7 @@ func ListLocationsFailJSONMocked(t *testing.T, locationsIn []*types.Location) []
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", APIPathWizardLocations).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(APIPathWizardLocations, 1, utils.DefaultPageSize)).Return(dIn, 200, nil)
 	locationsOut, err := ds.ListLocations()
 
 	assert.NotNil(err, "We are expecting a marshalling error")
@@ -3,7 +3,7 @@
 package wizard
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 
 	"github.com/ingrammicro/cio/api/types"
@@ -33,23 +33,63 @@ func NewWizardCloudProviderService(concertoService utils.ConcertoService) (*Wiza
 func (wcps *WizardCloudProviderService) ListWizardCloudProviders(
 	appID string,
 	locationID string,
+) (cloudProviders []*types.CloudProvider, err error) {
+	return wcps.ListWizardCloudProvidersContext(context.Background(), appID, locationID)
+}
+
+// ListWizardCloudProvidersContext is like ListWizardCloudProviders, but the request is cancelled as soon as ctx is done
+func (wcps *WizardCloudProviderService) ListWizardCloudProvidersContext(
+	ctx context.Context,
+	appID string,
+	locationID string,
 ) (cloudProviders []*types.CloudProvider, err error) {
 	log.Debug("ListWizardCloudProviders")
 
-	data, status, err := wcps.concertoService.Get(
-		fmt.Sprintf(APIPathWizardCloudProvidersByAppLocation, appID, locationID),
-	)
+	cloudProviders = []*types.CloudProvider{}
+	err = wcps.ListWizardCloudProvidersPagesContext(ctx, appID, locationID, func(page []*types.CloudProvider) bool {
+		cloudProviders = append(cloudProviders, page...)
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return cloudProviders, nil
+}
 
-	if err = json.Unmarshal(data, &cloudProviders); err != nil {
-		return nil, err
-	}
+// ListWizardCloudProvidersPages is like ListWizardCloudProviders, but the list is streamed to fn one page at a time,
+// until fn returns false
+func (wcps *WizardCloudProviderService) ListWizardCloudProvidersPages(
+	appID string,
+	locationID string,
+	fn func(cloudProviders []*types.CloudProvider) bool,
+) error {
+	return wcps.ListWizardCloudProvidersPagesContext(context.Background(), appID, locationID, fn)
+}
 
-	return cloudProviders, nil
+// ListWizardCloudProvidersPagesContext is like ListWizardCloudProvidersPages, but the requests are cancelled as soon as
+// ctx is done. The page options carried by ctx are applied
+func (wcps *WizardCloudProviderService) ListWizardCloudProvidersPagesContext(
+	ctx context.Context,
+	appID string,
+	locationID string,
+	fn func(cloudProviders []*types.CloudProvider) bool,
+) error {
+	log.Debug("ListWizardCloudProvidersPages")
+
+	pager := utils.NewPager(
+		ctx,
+		wcps.concertoService,
+		fmt.Sprintf(APIPathWizardCloudProvidersByAppLocation, appID, locationID),
+	)
+	for pager.Next() {
+		var cloudProviders []*types.CloudProvider
+		if err := pager.Decode(&cloudProviders); err != nil {
+			return err
+		}
+		if !fn(cloudProviders) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
@@ -33,7 +33,11 @@ func ListWizardCloudProvidersMocked(
 	assert.Nil(err, "WizCloudProvider test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathWizardCloudProvidersByAppLocation, AppID, LocID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathWizardCloudProvidersByAppLocation, AppID, LocID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	cloudProvidersOut, err := ds.ListWizardCloudProviders(AppID, LocID)
 	assert.Nil(err, "Error getting cloudProvider list")
 	assert.Equal(cloudProvidersIn, cloudProvidersOut, "ListWizardCloudProviders returned different cloudProviders")
@@ -62,8 +66,11 @@ func ListWizardCloudProvidersFailErrMocked(
 	assert.Nil(err, "WizCloudProvider test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathWizardCloudProvidersByAppLocation, AppID, LocID)).
-		Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathWizardCloudProvidersByAppLocation, AppID, LocID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, fmt.Errorf("mocked error"))
 	cloudProvidersOut, err := ds.ListWizardCloudProviders(AppID, LocID)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -94,7 +101,11 @@ func ListWizardCloudProvidersFailStatusMocked(
 	assert.Nil(err, "WizCloudProvider test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathWizardCloudProvidersByAppLocation, AppID, LocID)).Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathWizardCloudProvidersByAppLocation, AppID, LocID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 499, nil)
 	cloudProvidersOut, err := ds.ListWizardCloudProviders(AppID, LocID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -124,7 +135,11 @@ func ListWizardCloudProvidersFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathWizardCloudProvidersByAppLocation, AppID, LocID)).Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathWizardCloudProvidersByAppLocation, AppID, LocID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	cloudProvidersOut, err := ds.ListWizardCloudProviders(AppID, LocID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
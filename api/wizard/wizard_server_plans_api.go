@@ -3,7 +3,7 @@
 package wizard
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 
 	"github.com/ingrammicro/cio/api/types"
@@ -34,28 +34,72 @@ func (wsps *WizardServerPlanService) ListWizardServerPlans(
 	appID string,
 	locationID string,
 	cloudProviderID string,
+) (serverPlans []*types.ServerPlan, err error) {
+	return wsps.ListWizardServerPlansContext(context.Background(), appID, locationID, cloudProviderID)
+}
+
+// ListWizardServerPlansContext is like ListWizardServerPlans, but the request is cancelled as soon as ctx is done
+func (wsps *WizardServerPlanService) ListWizardServerPlansContext(
+	ctx context.Context,
+	appID string,
+	locationID string,
+	cloudProviderID string,
 ) (serverPlans []*types.ServerPlan, err error) {
 	log.Debug("ListWizardServerPlans")
 
-	data, status, err := wsps.concertoService.Get(
-		fmt.Sprintf(
-			APIPathWizardServerPlansByAppLocationCloudProvider,
-			appID,
-			locationID,
-			cloudProviderID,
-		),
+	serverPlans = []*types.ServerPlan{}
+	err = wsps.ListWizardServerPlansPagesContext(
+		ctx,
+		appID,
+		locationID,
+		cloudProviderID,
+		func(page []*types.ServerPlan) bool {
+			serverPlans = append(serverPlans, page...)
+			return true
+		},
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.CheckStandardStatus(status, data); err != nil {
-		return nil, err
-	}
+	return serverPlans, nil
+}
 
-	if err = json.Unmarshal(data, &serverPlans); err != nil {
-		return nil, err
-	}
+// ListWizardServerPlansPages is like ListWizardServerPlans, but the list is streamed to fn one page at a time, until fn
+// returns false
+func (wsps *WizardServerPlanService) ListWizardServerPlansPages(
+	appID string,
+	locationID string,
+	cloudProviderID string,
+	fn func(serverPlans []*types.ServerPlan) bool,
+) error {
+	return wsps.ListWizardServerPlansPagesContext(context.Background(), appID, locationID, cloudProviderID, fn)
+}
 
-	return serverPlans, nil
+// ListWizardServerPlansPagesContext is like ListWizardServerPlansPages, but the requests are cancelled as soon as ctx
+// is done. The page options carried by ctx are applied
+func (wsps *WizardServerPlanService) ListWizardServerPlansPagesContext(
+	ctx context.Context,
+	appID string,
+	locationID string,
+	cloudProviderID string,
+	fn func(serverPlans []*types.ServerPlan) bool,
+) error {
+	log.Debug("ListWizardServerPlansPages")
+
+	pager := utils.NewPager(
+		ctx,
+		wsps.concertoService,
+		fmt.Sprintf(APIPathWizardServerPlansByAppLocationCloudProvider, appID, locationID, cloudProviderID),
+	)
+	for pager.Next() {
+		var serverPlans []*types.ServerPlan
+		if err := pager.Decode(&serverPlans); err != nil {
+			return err
+		}
+		if !fn(serverPlans) {
+			return nil
+		}
+	}
+	return pager.Err()
 }
@@ -34,8 +34,11 @@ func ListWizardServerPlansMocked(
 	assert.Nil(err, "WizServerPlan test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathWizardServerPlansByAppLocationCloudProvider, AppID, LocID, ProviderID)).
-		Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathWizardServerPlansByAppLocationCloudProvider, AppID, LocID, ProviderID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	serverPlansOut, err := ds.ListWizardServerPlans(AppID, LocID, ProviderID)
 	assert.Nil(err, "Error getting serverPlan list")
 	assert.Equal(serverPlansIn, serverPlansOut, "ListWizardServerPlans returned different serverPlans")
@@ -65,8 +68,11 @@ func ListWizardServerPlansFailErrMocked(
 	assert.Nil(err, "WizServerPlan test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathWizardServerPlansByAppLocationCloudProvider, AppID, LocID, ProviderID)).
-		Return(dIn, 200, fmt.Errorf("mocked error"))
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathWizardServerPlansByAppLocationCloudProvider, AppID, LocID, ProviderID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, fmt.Errorf("mocked error"))
 	serverPlansOut, err := ds.ListWizardServerPlans(AppID, LocID, ProviderID)
 
 	assert.NotNil(err, "We are expecting an error")
@@ -98,8 +104,11 @@ func ListWizardServerPlansFailStatusMocked(
 	assert.Nil(err, "WizServerPlan test data corrupted")
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathWizardServerPlansByAppLocationCloudProvider, AppID, LocID, ProviderID)).
-		Return(dIn, 499, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathWizardServerPlansByAppLocationCloudProvider, AppID, LocID, ProviderID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 499, nil)
 	serverPlansOut, err := ds.ListWizardServerPlans(AppID, LocID, ProviderID)
 
 	assert.NotNil(err, "We are expecting an status code error")
@@ -130,8 +139,11 @@ func ListWizardServerPlansFailJSONMocked(
 	dIn := []byte{10, 20, 30}
 
 	// call service
-	cs.On("Get", fmt.Sprintf(APIPathWizardServerPlansByAppLocationCloudProvider, AppID, LocID, ProviderID)).
-		Return(dIn, 200, nil)
+	cs.On("Get", utils.PagePath(
+		fmt.Sprintf(APIPathWizardServerPlansByAppLocationCloudProvider, AppID, LocID, ProviderID),
+		1,
+		utils.DefaultPageSize,
+	)).Return(dIn, 200, nil)
 	serverPlansOut, err := ds.ListWizardServerPlans(AppID, LocID, ProviderID)
 
 	assert.NotNil(err, "We are expecting a marshalling error")
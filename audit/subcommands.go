@@ -14,11 +14,13 @@ func SubCommands() []cli.Command {
 			Name:   "list-events",
 			Usage:  "Returns information about the events related to the account group.",
 			Action: cmd.EventList,
+			Flags:  cmd.PageFlags(),
 		},
 		{
 			Name:   "list-system-events",
 			Usage:  "Returns information about system-wide events.",
 			Action: cmd.SysEventList,
+			Flags:  cmd.PageFlags(),
 		},
 	}
 }
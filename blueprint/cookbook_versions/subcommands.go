@@ -14,12 +14,12 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists all available cookbook versions",
 			Action: cmd.CookbookVersionList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "labels",
 					Usage: "A list of comma separated label as a query filter",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
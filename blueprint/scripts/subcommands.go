@@ -14,12 +14,12 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists all available scripts",
 			Action: cmd.ScriptsList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "labels",
 					Usage: "A list of comma separated label as a query filter",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
@@ -120,12 +120,12 @@ func SubCommands() []cli.Command {
 			Name:   "list-attachments",
 			Usage:  "List the attachments a script has",
 			Action: cmd.ScriptAttachmentList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Script Id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "add-label",
@@ -14,12 +14,12 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists all available templates",
 			Action: cmd.TemplateList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "labels",
 					Usage: "A list of comma separated label as a query filter",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
@@ -146,7 +146,7 @@ func SubCommands() []cli.Command {
 			Name:   "list-template-scripts",
 			Usage:  "Shows the script characterisations of a template",
 			Action: cmd.TemplateScriptList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "template-id",
 					Usage: "Template Id",
@@ -155,7 +155,7 @@ func SubCommands() []cli.Command {
 					Name:  "type",
 					Usage: "Must be \"operational\", \"boot\" or \"shutdown\"",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show-template-script",
@@ -271,12 +271,12 @@ func SubCommands() []cli.Command {
 			Name:   "list-template-servers",
 			Usage:  "Returns information about the servers that use a specific template. ",
 			Action: cmd.TemplateServersList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "template-id",
 					Usage: "Template Id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "add-label",
@@ -37,7 +37,7 @@ func applyAnsiblePolicyfiles(
 		formatter.PrintError("couldn't prepare variables:", err)
 		return err
 	}
-	err = processAnsiblePolicyfiles(blueprintConfig, bootstrappingSvc, bsProcess)
+	err = processAnsiblePolicyfiles(ctx, blueprintConfig, bootstrappingSvc, bsProcess)
 	if err != nil {
 		formatter.PrintError("couldn't process policyfiles:", err)
 		return err
@@ -95,7 +95,12 @@ func variableFilePath(dir string) string {
 }
 
 // processAnsiblePolicyfiles applies for each policy the required ansible-galaxy and ansible-playbook commands, reporting in bunches of N lines
-func processAnsiblePolicyfiles(blueprintConfig *types.BootstrappingConfiguration, bootstrappingSvc *blueprint.BootstrappingService, bsProcess *bootstrappingProcess) error {
+func processAnsiblePolicyfiles(
+	ctx context.Context,
+	blueprintConfig *types.BootstrappingConfiguration,
+	bootstrappingSvc *blueprint.BootstrappingService,
+	bsProcess *bootstrappingProcess,
+) error {
 	log.Debug("processAnsiblePolicyfiles")
 	for _, bsPolicyfile := range bsProcess.policyfiles {
 		policyfileDir := bsPolicyfile.Path(bsProcess.directoryPath)
@@ -106,7 +111,7 @@ func processAnsiblePolicyfiles(blueprintConfig *types.BootstrappingConfiguration
 		log.Debug(command)
 		bsProcess.cmsVersion = ""
 		// Custom method for chunks processing
-		fn := getBootstrapLogReporter(bootstrappingSvc, bsProcess, blueprintConfig)
+		fn := getBootstrapLogReporter(ctx, bsProcess, blueprintConfig)
 		if err := runCommand(fn, command, bsProcess.thresholdLines); err != nil {
 			return err
 		}
@@ -44,7 +44,6 @@ const (
 	// ProcessLockFile is the name of the file used to ensure the bootstrap
 	// command is the only one of its kind running
 	ProcessLockFile = "cio-bootstrapping.lock"
-	retriesNumber   = 5
 	CMSChef         = "chef"
 	CMSAnsible      = "ansible"
 )
@@ -61,6 +60,7 @@ type bootstrappingProcess struct {
 	directoryPath                string
 	appliedPolicyfileRevisionIDs map[string]string
 	cmsVersion                   string
+	outbox                       *utils.Outbox
 }
 type attributes struct {
 	revisionID string
@@ -187,6 +187,7 @@ func runBootstrapPeriodically(ctx context.Context, c *cli.Context, formatter for
 	applyAfterIterations, thresholdLines, interval, splay := getBootstrappingConfigOrDefaults(c, config)
 
 	bootstrappingSvc, formatter := cmd.WireUpBootstrapping(c)
+	outbox := cmd.WireUpOutbox(c)
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	var blueprintConfig *types.BootstrappingConfiguration
 	var noPolicyfileApplicationIterations int
@@ -195,6 +196,10 @@ func runBootstrapPeriodically(ctx context.Context, c *cli.Context, formatter for
 		var updated bool
 		blueprintConfig, updated, err = getBlueprintConfig(ctx, bootstrappingSvc, blueprintConfig, formatter)
 		if err == nil {
+			// the platform is reachable again, so the reports kept meanwhile are sent before any other
+			if flushErr := outbox.Flush(ctx); flushErr != nil {
+				formatter.PrintError("Couldn't send the reports kept in the outbox", flushErr)
+			}
 			if updated || lastPolicyfileApplicationErr != nil ||
 				noPolicyfileApplicationIterations >= applyAfterIterations {
 				noPolicyfileApplicationIterations = -1
@@ -202,6 +207,7 @@ func runBootstrapPeriodically(ctx context.Context, c *cli.Context, formatter for
 					ctx,
 					bootstrappingSvc,
 					blueprintConfig,
+					outbox,
 					formatter,
 					thresholdLines,
 				)
@@ -258,9 +264,10 @@ func runBootstrapOnce(ctx context.Context, c *cli.Context, config *utils.Config,
 	_, thresholdLines, interval, splay := getBootstrappingConfigOrDefaults(c, config)
 
 	bootstrappingSvc, formatter := cmd.WireUpBootstrapping(c)
+	outbox := cmd.WireUpOutbox(c)
 	blueprintConfig, _, err := getBlueprintConfig(ctx, bootstrappingSvc, nil, formatter)
 	if err == nil {
-		err = applyPolicyfiles(ctx, bootstrappingSvc, blueprintConfig, formatter, thresholdLines)
+		err = applyPolicyfiles(ctx, bootstrappingSvc, blueprintConfig, outbox, formatter, thresholdLines)
 	}
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	for i := 0; err != nil && i < 3; i++ {
@@ -278,7 +285,7 @@ func runBootstrapOnce(ctx context.Context, c *cli.Context, config *utils.Config,
 		ticker.Stop()
 		blueprintConfig, _, err = getBlueprintConfig(ctx, bootstrappingSvc, nil, formatter)
 		if err == nil {
-			err = applyPolicyfiles(ctx, bootstrappingSvc, blueprintConfig, formatter, thresholdLines)
+			err = applyPolicyfiles(ctx, bootstrappingSvc, blueprintConfig, outbox, formatter, thresholdLines)
 		}
 	}
 	return err
@@ -289,6 +296,7 @@ func applyPolicyfiles(
 	ctx context.Context,
 	bootstrappingSvc *blueprint.BootstrappingService,
 	blueprintConfig *types.BootstrappingConfiguration,
+	outbox *utils.Outbox,
 	formatter format.Formatter,
 	thresholdLines int,
 ) error {
@@ -303,6 +311,7 @@ func applyPolicyfiles(
 		thresholdLines:               thresholdLines,
 		directoryPath:                workspaceDir(),
 		appliedPolicyfileRevisionIDs: make(map[string]string),
+		outbox:                       outbox,
 	}
 	// proto structures
 	err = initializePrototype(blueprintConfig, bsProcess)
@@ -336,7 +345,7 @@ func applyPolicyfiles(
 	// Inform the platform of applied changes via a `PUT /blueprint/applied_configuration` request with a JSON payload
 	// similar to
 	log.Debug("reporting applied policy files")
-	reportErr := reportAppliedConfiguration(bootstrappingSvc, bsProcess)
+	reportErr := reportAppliedConfiguration(ctx, bootstrappingSvc, bsProcess)
 	if reportErr != nil {
 		formatter.PrintError("couldn't report applied status for policy files", err)
 		return err
@@ -382,7 +391,7 @@ func getBlueprintConfig(
 	log.Debug("getBlueprintConfig")
 	// Inquire about desired configuration changes to be applied by querying the `GET /blueprint/configuration`
 	// endpoint. This will provide a JSON response with the desired configuration changes
-	blueprintConfig, status, err := bootstrappingSvc.GetBootstrappingConfiguration()
+	blueprintConfig, status, err := bootstrappingSvc.GetBootstrappingConfigurationContext(ctx)
 	if err == nil && status != 200 {
 		err = fmt.Errorf("received non-ok %d response", status)
 	}
@@ -408,34 +417,19 @@ func getBlueprintConfig(
 }
 
 func getBootstrapLogReporter(
-	bootstrappingSvc *blueprint.BootstrappingService,
+	ctx context.Context,
 	bsProcess *bootstrappingProcess,
 	blueprintConfig *types.BootstrappingConfiguration) func(chunk string) error {
 	fn := func(chunk string) error {
 		log.Debug("sendChunks")
-		err := utils.Retry(retriesNumber, time.Second, func() error {
-			log.Debug("Sending: ", chunk)
-			bsProcess.parseCMSVersion(blueprintConfig, chunk)
-
-			commandIn := map[string]interface{}{
-				"stdout": chunk,
-			}
+		log.Debug("Sending: ", chunk)
+		bsProcess.parseCMSVersion(blueprintConfig, chunk)
 
-			_, statusCode, err := bootstrappingSvc.ReportBootstrappingLog(&commandIn)
-			switch {
-			// 0<100 error cases??
-			case statusCode == 0:
-				return fmt.Errorf("communication error %v %v", statusCode, err)
-			case statusCode >= 500:
-				return fmt.Errorf("server error %v %v", statusCode, err)
-			case statusCode >= 400:
-				return fmt.Errorf("client error %v %v", statusCode, err)
-			default:
-				return nil
-			}
-		})
-
-		if err != nil {
+		// chunks failing to be sent are kept in the outbox, to be sent once the platform is reachable
+		commandIn := map[string]interface{}{
+			"stdout": chunk,
+		}
+		if err := bsProcess.outbox.Post(ctx, blueprint.APIPathBlueprintBootstrapLogs, &commandIn); err != nil {
 			return fmt.Errorf("cannot send the chunk data, %v", err)
 		}
 		return nil
@@ -445,6 +439,7 @@ func getBootstrapLogReporter(
 
 // reportAppliedConfiguration Inform the platform of applied changes
 func reportAppliedConfiguration(
+	ctx context.Context,
 	bootstrappingSvc *blueprint.BootstrappingService,
 	bsProcess *bootstrappingProcess,
 ) error {
@@ -460,7 +455,7 @@ func reportAppliedConfiguration(
 	if bsProcess.cmsVersion != "" {
 		payload["cms_version"] = bsProcess.cmsVersion
 	}
-	return bootstrappingSvc.ReportBootstrappingAppliedConfiguration(&payload)
+	return bootstrappingSvc.ReportBootstrappingAppliedConfigurationContext(ctx, &payload)
 }
 
 func (bsProcess *bootstrappingProcess) parseCMSVersion(blueprintConfig *types.BootstrappingConfiguration, chunk string) {
@@ -510,7 +505,7 @@ func downloadPolicyfiles(
 	for _, bsPolicyfile := range bsProcess.policyfiles {
 		tarballPath := bsPolicyfile.TarballPath(bsProcess.directoryPath)
 		log.Debug("downloading: ", tarballPath)
-		_, status, err := bootstrappingSvc.DownloadPolicyfile(bsPolicyfile.DownloadURL, tarballPath)
+		_, status, err := bootstrappingSvc.DownloadPolicyfileContext(ctx, bsPolicyfile.DownloadURL, tarballPath)
 		if err == nil && status != 200 {
 			err = fmt.Errorf("obtained non-ok response when downloading policyfile %s", bsPolicyfile.DownloadURL)
 		}
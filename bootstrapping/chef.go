@@ -25,7 +25,7 @@ func applyChefPolicyfiles(
 	formatter format.Formatter,
 ) error {
 	// Process tarballs policies
-	return processChefPolicyfiles(blueprintConfig, bootstrappingSvc, bsProcess)
+	return processChefPolicyfiles(ctx, blueprintConfig, bootstrappingSvc, bsProcess)
 
 }
 
@@ -58,7 +58,12 @@ func runCommand(fn func(chunk string) error, command string, thresholdLines int)
 }
 
 // processChefPolicyfiles applies for each policy the required chef commands, reporting in bunches of N lines
-func processChefPolicyfiles(blueprintConfig *types.BootstrappingConfiguration, bootstrappingSvc *blueprint.BootstrappingService, bsProcess *bootstrappingProcess) error {
+func processChefPolicyfiles(
+	ctx context.Context,
+	blueprintConfig *types.BootstrappingConfiguration,
+	bootstrappingSvc *blueprint.BootstrappingService,
+	bsProcess *bootstrappingProcess,
+) error {
 	log.Debug("processChefPolicyfiles")
 	for _, bsPolicyfile := range bsProcess.policyfiles {
 		command, renamedPolicyfileDir, policyfileDir, err := preparePolicyfileCommand(bsProcess, bsPolicyfile)
@@ -68,7 +73,7 @@ func processChefPolicyfiles(blueprintConfig *types.BootstrappingConfiguration, b
 		log.Debug(command)
 		bsProcess.cmsVersion = ""
 		// Custom method for chunks processing
-		fn := getBootstrapLogReporter(bootstrappingSvc, bsProcess, blueprintConfig)
+		fn := getBootstrapLogReporter(ctx, bsProcess, blueprintConfig)
 		if err = runCommand(fn, command, bsProcess.thresholdLines); err != nil {
 			return err
 		}
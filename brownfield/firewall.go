@@ -37,13 +37,13 @@ func startFirewallMapping(cs *utils.HTTPConcertoservice, rules []*discovery.Fire
 	payload := convertFirewallChainToPayload(rules)
 	fmt.Printf("DEBUG: Sending following firewall profile: %+v\n", payload)
 	body, status, err := cs.Post("/cloud/firewall_profile", &payload)
-	if err != nil {
-		return
-	}
 	if status >= 300 {
 		err = fmt.Errorf("server responded with %d code: %s", status, string(body))
 		return
 	}
+	if err != nil {
+		return
+	}
 	responseData := &types.Firewall{}
 	err = json.Unmarshal(body, &responseData)
 	if err != nil {
@@ -56,9 +56,6 @@ func applyConcertoSettings(cs *utils.HTTPConcertoservice, f format.Formatter, _,
 
 func obtainSettings(cs *utils.HTTPConcertoservice) (settings *Settings, err error) {
 	body, status, err := cs.Get("/brownfield/settings")
-	if err != nil {
-		return
-	}
 	if status == 403 {
 		err = fmt.Errorf("server responded with 403 code: authentication was not successful")
 		return
@@ -67,6 +64,9 @@ func obtainSettings(cs *utils.HTTPConcertoservice) (settings *Settings, err erro
 		err = fmt.Errorf("server responded with %d code: %s", status, string(body))
 		return
 	}
+	if err != nil {
+		return
+	}
 	settings = &Settings{}
 	err = json.Unmarshal(body, settings)
 	if err != nil {
@@ -53,9 +53,6 @@ func applyConcertoSettings(cs *utils.HTTPConcertoservice, f format.Formatter, us
 
 func obtainSettings(cs *utils.HTTPConcertoservice) (settings *Settings, err error) {
 	body, status, err := cs.Get("/brownfield/settings")
-	if err != nil {
-		return
-	}
 	if status == 403 {
 		err = fmt.Errorf("server responded with 403 code: authentication was not successful")
 		return
@@ -64,6 +61,9 @@ func obtainSettings(cs *utils.HTTPConcertoservice) (settings *Settings, err erro
 		err = fmt.Errorf("server responded with %d code: %s", status, string(body))
 		return
 	}
+	if err != nil {
+		return
+	}
 	settings = &Settings{}
 	err = json.Unmarshal(body, settings)
 	if err != nil {
@@ -81,15 +81,15 @@ func sendUsernamePassword(cs *utils.HTTPConcertoservice, username, password stri
 		},
 	}
 	body, status, err := cs.Put("/brownfield/settings", payload)
-	if err != nil {
-		return err
-	}
 	if status == 403 {
 		return fmt.Errorf("server responded with 403 code: authentication was not successful")
 	}
 	if status >= 300 {
 		return fmt.Errorf("server responded with %d code: %s", status, string(body))
 	}
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
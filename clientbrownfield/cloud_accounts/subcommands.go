@@ -14,6 +14,7 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists the cloud accounts that support importing resources",
 			Action: cmd.BrownfieldCloudAccountList,
+			Flags:  cmd.PageFlags(),
 		},
 	}
 }
@@ -14,39 +14,40 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists all available cloud providers",
 			Action: cmd.CloudProviderList,
+			Flags:  cmd.PageFlags(),
 		},
 		{
 			Name:   "list-storage-plans",
 			Usage:  "This action lists the storage plans offered by the cloud provider identified by the given id",
 			Action: cmd.CloudProviderStoragePlansList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "cloud-provider-id",
 					Usage: "Cloud provider id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "list-load-balancer-plans",
 			Usage:  "This action lists the load balancer plans offered by the cloud provider identified by the given id",
 			Action: cmd.CloudProviderLoadBalancerPlansList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "cloud-provider-id",
 					Usage: "Cloud provider id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "list-cluster-plans",
 			Usage:  "This action lists the cluster plans offered by the cloud provider identified by the given id",
 			Action: cmd.CloudProviderClusterPlansList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "cloud-provider-id",
 					Usage: "Cloud provider id",
 				},
-			},
+			),
 		},
 	}
 }
@@ -14,6 +14,7 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "This action lists the available generic images.",
 			Action: cmd.GenericImageList,
+			Flags:  cmd.PageFlags(),
 		},
 	}
 }
@@ -14,12 +14,12 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists all realms of a cloud provider",
 			Action: cmd.RealmList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Cloud provider id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
@@ -36,12 +36,12 @@ func SubCommands() []cli.Command {
 			Name:   "list-node-pool-plans",
 			Usage:  "This action lists the node pool plans offered by the realm identified by the given id",
 			Action: cmd.RealmNodePoolPlansList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Realm id",
 				},
-			},
+			),
 		},
 	}
 }
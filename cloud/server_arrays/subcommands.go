@@ -14,12 +14,12 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists information about all the server arrays on this account",
 			Action: cmd.ServerArrayList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "labels",
 					Usage: "A list of comma separated label as a query filter",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
@@ -152,12 +152,12 @@ func SubCommands() []cli.Command {
 			Name:   "list-servers",
 			Usage:  "This action list servers in server array with the given id",
 			Action: cmd.ServerArrayServerList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Server Array Id",
 				},
-			},
+			),
 		},
 		{
 			Name: "delete",
@@ -14,7 +14,7 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "This action lists the server plans offered by the cloud provider identified by the given id.",
 			Action: cmd.ServerPlanList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "cloud-provider-id",
 					Usage: "Cloud provider id",
@@ -23,7 +23,7 @@ func SubCommands() []cli.Command {
 					Name:  "realm-id",
 					Usage: "Identifier of the realm to which the server plan belongs",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
@@ -14,12 +14,12 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists information about all the servers on this account.",
 			Action: cmd.ServerList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "labels",
 					Usage: "A list of comma separated label as a query filter",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
@@ -36,7 +36,7 @@ func SubCommands() []cli.Command {
 			Name:   "create",
 			Usage:  "Creates a new server.",
 			Action: cmd.ServerCreate,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "name",
 					Usage: "Name of the server",
@@ -65,7 +65,7 @@ func SubCommands() []cli.Command {
 					Name:  "labels",
 					Usage: "A list of comma separated label names to be associated with server",
 				},
-			},
+			),
 		},
 		{
 			Name:   "update",
@@ -86,12 +86,12 @@ func SubCommands() []cli.Command {
 			Name:   "boot",
 			Usage:  "Boots a server with the given id",
 			Action: cmd.ServerBoot,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Server Id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "reboot",
@@ -108,12 +108,12 @@ func SubCommands() []cli.Command {
 			Name:   "shutdown",
 			Usage:  "Shuts down a server with the given id",
 			Action: cmd.ServerShutdown,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Server Id",
 				},
-			},
+			),
 		},
 		{
 			Name: "override-server",
@@ -132,35 +132,35 @@ func SubCommands() []cli.Command {
 			Usage: "This action decommissions the server with the given id. The server must be in a inactive, " +
 				"stalled or commission_stalled state.",
 			Action: cmd.ServerDelete,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Server Id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "list-events",
 			Usage:  "This action returns information about the events related to the server with the given id.",
 			Action: cmd.EventsList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Server Id",
 				},
-			},
+			),
 		},
 		{
 			Name: "list-operational-scripts",
 			Usage: "This action returns information about the operational scripts characterisations related to the " +
 				"server with the given id.",
 			Action: cmd.OperationalScriptsList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Server Id",
 				},
-			},
+			),
 		},
 		{
 			Name: "execute-script",
@@ -182,23 +182,23 @@ func SubCommands() []cli.Command {
 			Name:   "list-floating-ips",
 			Usage:  "This action returns information about the floating IPs attached to the server with the given id",
 			Action: cmd.ServerFloatingIPList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Server Id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "list-volumes",
 			Usage:  "This action returns information about the volumes attached to the server with the given id",
 			Action: cmd.ServerVolumesList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Server Id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "add-label",
@@ -242,5 +242,6 @@ func SubCommands() []cli.Command {
 				},
 			},
 		},
+		cmd.WaitCommand("server"),
 	}
 }
@@ -14,6 +14,7 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists deployments",
 			Action: cmd.CloudApplicationDeploymentList,
+			Flags:  cmd.PageFlags(),
 		},
 		{
 			Name:   "show",
@@ -14,6 +14,7 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists CATs",
 			Action: cmd.CloudApplicationTemplateList,
+			Flags:  cmd.PageFlags(),
 		},
 		{
 			Name:   "show",
@@ -14,6 +14,7 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "List CSE deployments",
 			Action: cmd.CloudSpecificExtensionDeploymentList,
+			Flags:  cmd.PageFlags(),
 		},
 		{
 			Name:   "show",
@@ -14,6 +14,7 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "List CSE templates",
 			Action: cmd.CloudSpecificExtensionTemplateList,
+			Flags:  cmd.PageFlags(),
 		},
 		{
 			Name:   "show",
@@ -79,12 +80,12 @@ func SubCommands() []cli.Command {
 			Name:   "list-deployments",
 			Usage:  "List CSE deployments of a CSE template",
 			Action: cmd.CloudSpecificExtensionTemplateListDeployments,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "CSE template Id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "delete",
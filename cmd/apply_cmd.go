@@ -0,0 +1,251 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ingrammicro/cio/api/labels"
+	"github.com/ingrammicro/cio/api/manifest"
+	"github.com/ingrammicro/cio/utils"
+	"github.com/ingrammicro/cio/utils/format"
+	"github.com/urfave/cli"
+)
+
+// DriftExitCode is the exit code of diff, and of apply with --dry-run, when applying the manifests changes anything
+const DriftExitCode = 3
+
+// DiffFlags are the flags of the diff command
+var DiffFlags = []cli.Flag{
+	cli.StringSliceFlag{
+		Name:  "filename, f",
+		Usage: "Manifest file to apply, or - to read them from the standard input. Can be given several times",
+	},
+	cli.StringFlag{
+		Name:  "prune",
+		Usage: "Deletes the resources with this label which the manifests don't describe",
+	},
+}
+
+// ApplyFlags are the flags of the apply command
+var ApplyFlags = append(DiffFlags, cli.BoolFlag{
+	Name:  "dry-run",
+	Usage: "Prints what applying the manifests would do, as diff does, without changing anything",
+})
+
+// ExportFlags are the flags of the export command
+var ExportFlags = []cli.Flag{
+	cli.StringFlag{
+		Name: "kinds",
+		Usage: fmt.Sprintf(
+			"A list of comma separated kinds of resources to export, out of %s. All by default",
+			strings.Join(exportKindNames(), ", "),
+		),
+	},
+	cli.StringFlag{
+		Name:  "labels",
+		Usage: "A list of comma separated label names the exported resources have",
+	},
+	cli.StringFlag{
+		Name:  "filename, f",
+		Usage: "File to write the manifests to, instead of the standard output",
+	},
+}
+
+// exportKinds maps the kinds given to the export command to those of manifests
+var exportKinds = map[string]string{
+	"ssh-profiles":      manifest.KindSSHProfile,
+	"firewall-profiles": manifest.KindFirewallProfile,
+	"templates":         manifest.KindTemplate,
+	"vpcs":              manifest.KindVPC,
+	"subnets":           manifest.KindSubnet,
+	"servers":           manifest.KindServer,
+	"dns":               manifest.KindDNSRecord,
+}
+
+func exportKindNames() []string {
+	names := make([]string, 0, len(exportKinds))
+	for name := range exportKinds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// WireUpApply prepares common resources to send request to Concerto API
+func WireUpApply(c *cli.Context) (a *manifest.Applier, f format.Formatter) {
+	kinds, ls, f := wireUpManifests()
+	return manifest.NewApplier(kinds, ls), f
+}
+
+// WireUpExport prepares common resources to send request to Concerto API
+func WireUpExport(c *cli.Context) (e *manifest.Exporter, f format.Formatter) {
+	kinds, ls, f := wireUpManifests()
+	return manifest.NewExporter(kinds, ls), f
+}
+
+func wireUpManifests() (kinds map[string]*manifest.Kind, ls *labels.LabelService, f format.Formatter) {
+
+	f = format.GetFormatter()
+
+	config, err := utils.GetConcertoConfig()
+	if err != nil {
+		f.PrintFatal("Couldn't wire up config", err)
+	}
+	hcs, err := utils.NewHTTPConcertoService(config)
+	if err != nil {
+		f.PrintFatal("Couldn't wire up concerto service", err)
+	}
+	kinds, err = manifest.NewKinds(hcs)
+	if err != nil {
+		f.PrintFatal("Couldn't wire up manifest kinds", err)
+	}
+	ls, err = labels.NewLabelService(hcs)
+	if err != nil {
+		f.PrintFatal("Couldn't wire up label service", err)
+	}
+
+	return kinds, ls, f
+}
+
+// readManifests decodes the manifests of the files given by the filename flag
+func readManifests(c *cli.Context) ([]*manifest.Manifest, error) {
+	filenames := c.StringSlice("filename")
+	if len(filenames) == 0 {
+		return nil, fmt.Errorf("please, use --filename to give the manifests")
+	}
+
+	manifests := make([]*manifest.Manifest, 0)
+	for _, filename := range filenames {
+		var r io.Reader = os.Stdin
+		source := "stdin"
+		if filename != "-" {
+			file, err := os.Open(filename)
+			if err != nil {
+				return nil, err
+			}
+			defer file.Close()
+			r = file
+			source = filename
+		}
+		ms, err := manifest.Decode(r, source)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, ms...)
+	}
+	return manifests, nil
+}
+
+// Apply subcommand function
+func Apply(c *cli.Context) error {
+	debugCmdFuncInfo(c)
+	if c.Bool("dry-run") {
+		return Diff(c)
+	}
+	applier, formatter := WireUpApply(c)
+
+	manifests, err := readManifests(c)
+	if err != nil {
+		formatter.PrintFatal("Couldn't read manifests", err)
+	}
+
+	ctx, cancel := cmdContext()
+	defer cancel()
+	applier.PruneLabel = c.String("prune")
+	results, err := applier.Apply(ctx, manifests)
+	printResults(results, formatter)
+	if err != nil {
+		formatter.PrintFatal("Couldn't apply manifests", err)
+	}
+	return nil
+}
+
+// Diff subcommand function
+func Diff(c *cli.Context) error {
+	debugCmdFuncInfo(c)
+	applier, formatter := WireUpApply(c)
+
+	manifests, err := readManifests(c)
+	if err != nil {
+		formatter.PrintFatal("Couldn't read manifests", err)
+	}
+
+	ctx, cancel := cmdContext()
+	defer cancel()
+	applier.PruneLabel = c.String("prune")
+	results, err := applier.Plan(ctx, manifests)
+	printResults(results, formatter)
+	if err != nil {
+		formatter.PrintFatal("Couldn't plan manifests", err)
+	}
+	if manifest.Drifted(results) {
+		os.Exit(DriftExitCode)
+	}
+	return nil
+}
+
+// printResults prints what was done, or would be done, to apply the manifests
+func printResults(results []*manifest.Result, formatter format.Formatter) {
+	if len(results) == 0 {
+		return
+	}
+	if err := formatter.PrintList(results); err != nil {
+		formatter.PrintFatal(PrintFormatError, err)
+	}
+}
+
+// Export subcommand function
+func Export(c *cli.Context) error {
+	debugCmdFuncInfo(c)
+	exporter, formatter := WireUpExport(c)
+
+	kinds := make([]string, 0)
+	for _, name := range exportKindNames() {
+		kinds = append(kinds, exportKinds[name])
+	}
+	if c.IsSet("kinds") {
+		kinds = make([]string, 0)
+		for _, name := range strings.Split(c.String("kinds"), ",") {
+			kind, ok := exportKinds[strings.TrimSpace(name)]
+			if !ok {
+				formatter.PrintFatal(
+					"Couldn't export resources",
+					fmt.Errorf("unknown kind %q. Available kinds: %s", name, strings.Join(exportKindNames(), ", ")),
+				)
+			}
+			kinds = append(kinds, kind)
+		}
+	}
+	labelNames := make([]string, 0)
+	if c.IsSet("labels") {
+		for _, name := range strings.Split(c.String("labels"), ",") {
+			labelNames = append(labelNames, strings.TrimSpace(name))
+		}
+	}
+
+	ctx, cancel := cmdContext()
+	defer cancel()
+	manifests, err := exporter.Export(ctx, kinds, labelNames)
+	if err != nil {
+		formatter.PrintFatal("Couldn't export resources", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if c.IsSet("filename") {
+		file, err := os.Create(c.String("filename"))
+		if err != nil {
+			formatter.PrintFatal("Couldn't write manifests", err)
+		}
+		defer file.Close()
+		w = file
+	}
+	if err := manifest.Encode(w, manifests); err != nil {
+		formatter.PrintFatal("Couldn't write manifests", err)
+	}
+	return nil
+}
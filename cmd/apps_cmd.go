@@ -35,7 +35,9 @@ func AppList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	appSvc, formatter := WireUpApp(c)
 
-	apps, err := appSvc.ListApps()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	apps, err := appSvc.ListAppsContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive app data", err)
 	}
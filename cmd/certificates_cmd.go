@@ -36,7 +36,9 @@ func CertificateList(c *cli.Context) error {
 	svc, formatter := WireUpCertificate(c)
 
 	checkRequiredFlags(c, []string{"load-balancer-id"}, formatter)
-	certificates, err := svc.ListCertificates(c.String("load-balancer-id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	certificates, err := svc.ListCertificatesContext(ctx, c.String("load-balancer-id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive load balancer certificates data", err)
 	}
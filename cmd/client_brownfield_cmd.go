@@ -37,7 +37,9 @@ func BrownfieldCloudAccountList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	svc, formatter := WireUpBrownfieldCloudAccount(c)
 
-	cloudAccounts, err := svc.ListBrownfieldCloudAccounts()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	cloudAccounts, err := svc.ListBrownfieldCloudAccountsContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive cloud accounts data", err)
 	}
@@ -35,7 +35,9 @@ func CloudAccountList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 
 	cloudAccountSvc, formatter := WireUpCloudAccount(c)
-	cloudAccounts, err := cloudAccountSvc.ListCloudAccounts()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	cloudAccounts, err := cloudAccountSvc.ListCloudAccountsContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive cloudAccount data", err)
 	}
@@ -3,6 +3,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -46,7 +47,9 @@ func CloudApplicationDeploymentList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	svc, formatter := WireUpCloudApplicationDeployment(c)
 
-	deps, err := svc.ListDeployments()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	deps, err := svc.ListDeploymentsContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive cloud application deployments data", err)
 	}
@@ -112,7 +115,10 @@ func CloudApplicationDeploymentDeploy(c *cli.Context) error {
 	}
 	log.Debug("Time lapse -seconds- for deployment status check:", timeLapseDeploymentStatusCheck)
 
-	deploymentTask, err := svc.CreateDeploymentTask(c.String("id"), &deploymentIn)
+	ctx, cancel := cmdContext()
+	defer cancel()
+
+	deploymentTask, err := svc.CreateDeploymentTaskContext(ctx, c.String("id"), &deploymentIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't create cloud application deployment data", err)
 	}
@@ -120,20 +126,21 @@ func CloudApplicationDeploymentDeploy(c *cli.Context) error {
 	log.Info("Task ID... ", deploymentTask.ID)
 	log.Info("Deployment ID: ", deploymentTask.DeploymentID)
 	log.Info("Deploying... ")
-	for {
-		deploymentTask, err = svc.GetDeploymentTask(c.String("id"), deploymentTask.ID)
+	poller := utils.FixedPoller(time.Duration(timeLapseDeploymentStatusCheck) * time.Second)
+	err = poller.Poll(ctx, func(ctx context.Context) (bool, error) {
+		deploymentTask, err = svc.GetDeploymentTaskContext(ctx, c.String("id"), deploymentTask.ID)
 		if err != nil {
 			formatter.PrintFatal("Couldn't get cloud application deployment data", err)
 		}
 		log.Info("State: ", deploymentTask.State)
+		return deploymentTask.State != "pending", nil
+	})
+	if err != nil {
+		formatter.PrintFatal("Interrupted while deploying cloud application", err)
+	}
 
-		if deploymentTask.State != "pending" {
-			if err = formatter.PrintItem(*deploymentTask); err != nil {
-				formatter.PrintFatal(PrintFormatError, err)
-			}
-			break
-		}
-		time.Sleep(time.Duration(timeLapseDeploymentStatusCheck) * time.Second)
+	if err = formatter.PrintItem(*deploymentTask); err != nil {
+		formatter.PrintFatal(PrintFormatError, err)
 	}
 	return nil
 }
@@ -150,7 +157,10 @@ func CloudApplicationDeploymentDelete(c *cli.Context) error {
 	}
 	log.Debug("Time lapse -seconds- for deletion status check:", timeLapseDeletionStatusCheck)
 
-	deployment, err := svc.DeleteDeployment(c.String("id"))
+	ctx, cancel := cmdContext()
+	defer cancel()
+
+	deployment, err := svc.DeleteDeploymentContext(ctx, c.String("id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't delete cloud application deployment", err)
 	}
@@ -158,15 +168,15 @@ func CloudApplicationDeploymentDelete(c *cli.Context) error {
 	deploymentName := deployment.Name
 
 	log.Info(fmt.Sprintf("Deployment: %s - %s undeploying...", deploymentID, deploymentName))
-	for {
-		deployment, status, err := svc.GetDeployment(deploymentID)
+	poller := utils.FixedPoller(time.Duration(timeLapseDeletionStatusCheck) * time.Second)
+	err = poller.Poll(ctx, func(ctx context.Context) (bool, error) {
+		deployment, status, err := svc.GetDeploymentContext(ctx, deploymentID)
 		if err != nil {
 			if status == 404 {
 				log.Info(fmt.Sprintf("Deployment: %s - %s undeployed.", deploymentID, deploymentName))
-				break
-			} else {
-				formatter.PrintFatal("Couldn't check cloud application deployment data", err)
+				return true, nil
 			}
+			formatter.PrintFatal("Couldn't check cloud application deployment data", err)
 		}
 		log.Info("State: ", deployment.Value)
 
@@ -175,9 +185,12 @@ func CloudApplicationDeploymentDelete(c *cli.Context) error {
 			if err = formatter.PrintItem(*deployment); err != nil {
 				formatter.PrintFatal(PrintFormatError, err)
 			}
-			break
+			return true, nil
 		}
-		time.Sleep(time.Duration(timeLapseDeletionStatusCheck) * time.Second)
+		return false, nil
+	})
+	if err != nil {
+		formatter.PrintFatal("Interrupted while undeploying cloud application", err)
 	}
 	return nil
 }
@@ -39,7 +39,9 @@ func CloudApplicationTemplateList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	svc, formatter := WireUpCloudApplicationTemplate(c)
 
-	cats, err := svc.ListTemplates()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	cats, err := svc.ListTemplatesContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive cloud application templates data", err)
 	}
@@ -35,7 +35,9 @@ func CloudProviderList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	cloudProviderSvc, formatter := WireUpCloudProvider(c)
 
-	cloudProviders, err := cloudProviderSvc.ListCloudProviders()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	cloudProviders, err := cloudProviderSvc.ListCloudProvidersContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive cloudProvider data", err)
 	}
@@ -51,7 +53,9 @@ func CloudProviderStoragePlansList(c *cli.Context) error {
 	cloudProvidersSvc, formatter := WireUpCloudProvider(c)
 
 	checkRequiredFlags(c, []string{"cloud-provider-id"}, formatter)
-	storagePlans, err := cloudProvidersSvc.ListServerStoragePlans(c.String("cloud-provider-id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	storagePlans, err := cloudProvidersSvc.ListServerStoragePlansContext(ctx, c.String("cloud-provider-id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive storage plans data", err)
 	}
@@ -76,7 +80,9 @@ func CloudProviderLoadBalancerPlansList(c *cli.Context) error {
 	cloudProvidersSvc, formatter := WireUpCloudProvider(c)
 
 	checkRequiredFlags(c, []string{"cloud-provider-id"}, formatter)
-	loadBalancerPlans, err := cloudProvidersSvc.ListLoadBalancerPlans(c.String("cloud-provider-id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	loadBalancerPlans, err := cloudProvidersSvc.ListLoadBalancerPlansContext(ctx, c.String("cloud-provider-id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive load balancer plans data", err)
 	}
@@ -98,7 +104,9 @@ func CloudProviderClusterPlansList(c *cli.Context) error {
 	cloudProvidersSvc, formatter := WireUpCloudProvider(c)
 
 	checkRequiredFlags(c, []string{"cloud-provider-id"}, formatter)
-	clusterPlans, err := cloudProvidersSvc.ListClusterPlans(c.String("cloud-provider-id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	clusterPlans, err := cloudProvidersSvc.ListClusterPlansContext(ctx, c.String("cloud-provider-id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive cluster plans data", err)
 	}
@@ -40,7 +40,9 @@ func CloudSpecificExtensionDeploymentList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	svc, formatter := WireUpCloudSpecificExtensionDeployment(c)
 
-	cseds, err := svc.ListDeployments()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	cseds, err := svc.ListDeploymentsContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive CSE deployment data", err)
 	}
@@ -40,7 +40,9 @@ func CloudSpecificExtensionTemplateList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	svc, formatter := WireUpCloudSpecificExtensionTemplate(c)
 
-	csets, err := svc.ListTemplates()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	csets, err := svc.ListTemplatesContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive CSE template data", err)
 	}
@@ -159,7 +161,9 @@ func CloudSpecificExtensionTemplateListDeployments(c *cli.Context) error {
 	svc, formatter := WireUpCloudSpecificExtensionTemplate(c)
 	checkRequiredFlags(c, []string{"id"}, formatter)
 
-	cseds, err := svc.ListDeployments(c.String("id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	cseds, err := svc.ListDeploymentsContext(ctx, c.String("id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive CSE deployments data", err)
 	}
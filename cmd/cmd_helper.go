@@ -3,11 +3,15 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"runtime"
 	"strings"
+	"syscall"
 
+	"github.com/ingrammicro/cio/utils"
 	"github.com/ingrammicro/cio/utils/format"
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
@@ -85,3 +89,45 @@ func setParamInt(c *cli.Context, name string, flag string, paramsIn map[string]i
 		paramsIn[name] = c.Int(flag)
 	}
 }
+
+// cmdContext returns a context which is cancelled as soon as the command is interrupted, aborting any in-flight request
+func cmdContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+}
+
+// commandGroupPath returns the path of the command group the command belongs to, such as "cloud servers"
+func commandGroupPath(c *cli.Context) string {
+	return strings.TrimPrefix(c.App.Name, fmt.Sprintf("%s ", strings.Fields(c.App.Name)[0]))
+}
+
+// commandPath returns the path of the command, such as "cloud servers show"
+func commandPath(c *cli.Context) string {
+	return strings.Join(append(strings.Fields(c.App.Name)[1:], c.Command.Name), " ")
+}
+
+// PageFlags returns the given flags along with the --page-size and --limit flags understood by list commands
+func PageFlags(flags ...cli.Flag) []cli.Flag {
+	return append(flags,
+		cli.IntFlag{
+			Name:  "page-size",
+			Usage: "Number of items requested per page",
+			Value: utils.DefaultPageSize,
+		},
+		cli.IntFlag{
+			Name:  "limit",
+			Usage: "Maximum number of items listed, 0 meaning all of them",
+		},
+	)
+}
+
+// pageContext is like cmdContext, but the returned context also carries the page options given by the --page-size and
+// --limit flags. When items are filtered by --labels the limit is left to LabelFiltering, as it has to be applied to
+// the filtered items
+func pageContext(c *cli.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := cmdContext()
+	limit := c.Int("limit")
+	if c.String("labels") != "" {
+		limit = 0
+	}
+	return utils.WithPageOptions(ctx, utils.PageOptions{Size: c.Int("page-size"), Limit: limit}), cancel
+}
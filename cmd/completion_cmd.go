@@ -0,0 +1,247 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ingrammicro/cio/utils"
+	"github.com/ingrammicro/cio/utils/format"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// completionShellEnv tells the completion functions which shell they are talking to, as set by the scripts
+const completionShellEnv = "CIO_COMPLETION"
+
+// completionCacheTTL is how long resources listed for completion are kept in the local cache
+const completionCacheTTL = 2 * time.Minute
+
+// completionTimeout bounds the API requests done to complete resource IDs, so that shells don't hang
+const completionTimeout = 5 * time.Second
+
+// completionLimit is the maximum number of resources listed to complete an ID
+const completionLimit = 500
+
+const bashCompletionScript = `# bash completion for cio. Load it with: source <(cio completion bash)
+_cio_completion() {
+  local cur="${COMP_WORDS[COMP_CWORD]}"
+  local IFS=$'\n'
+  COMPREPLY=( $(compgen -W "$(` + completionShellEnv + `=bash "${COMP_WORDS[@]:0:$COMP_CWORD}" ` +
+	`--generate-bash-completion 2>/dev/null)" -- "${cur}") )
+}
+
+complete -o bashdefault -o default -F _cio_completion cio
+`
+
+const zshCompletionScript = `#compdef cio
+# zsh completion for cio. Load it with: source <(cio completion zsh)
+_cio() {
+  local -a opts
+  opts=("${(@f)$(` + completionShellEnv + `=zsh ${words[1,CURRENT-1]} --generate-bash-completion 2>/dev/null)}")
+  if [[ "${opts[1]}" != "" ]]; then
+    _describe 'values' opts
+  else
+    _files
+  fi
+}
+
+compdef _cio cio
+`
+
+const fishCompletionScript = `# fish completion for cio. Load it with: cio completion fish | source
+function __cio_complete
+    set -l tokens (commandline -opc)
+    env ` + completionShellEnv + `=fish $tokens --generate-bash-completion 2>/dev/null
+end
+
+complete -c cio -f -a '(__cio_complete)'
+`
+
+// completionItem is a value offered by the completion, along with a description shown by the shells supporting it
+type completionItem struct {
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+// completionFlagResources maps the flags referring to labels, which take names rather than IDs, to the command path
+// listing them
+var completionFlagResources = map[string]string{
+	"label":  "labels",
+	"labels": "labels",
+}
+
+// CompletionBash subcommand function
+func CompletionBash(c *cli.Context) error {
+	fmt.Fprint(c.App.Writer, bashCompletionScript)
+	return nil
+}
+
+// CompletionZsh subcommand function
+func CompletionZsh(c *cli.Context) error {
+	fmt.Fprint(c.App.Writer, zshCompletionScript)
+	return nil
+}
+
+// CompletionFish subcommand function
+func CompletionFish(c *cli.Context) error {
+	fmt.Fprint(c.App.Writer, fishCompletionScript)
+	return nil
+}
+
+// SetCompletions sets the completion function of the given commands and all of their subcommands
+func SetCompletions(commands []cli.Command) {
+	for i := range commands {
+		commands[i].BashComplete = Complete
+		SetCompletions(commands[i].Subcommands)
+	}
+}
+
+// Complete prints the completions for the command line being completed: the subcommands of a command group, the flags
+// of a command or, right after a flag referring to a resource, the IDs of the resources
+func Complete(c *cli.Context) {
+	if c.Command.Action == nil {
+		for _, command := range c.App.Commands {
+			if !command.Hidden {
+				printCompletionItem(c.App.Writer, completionItem{command.Name, command.Usage})
+			}
+		}
+		if c.Parent() == nil {
+			printFlagCompletions(c.App.Writer, c.App.Flags)
+		}
+		return
+	}
+
+	// the last argument is the completion flag, so the one before is the word previous to the one being completed
+	if len(os.Args) > 2 {
+		if flag := findFlag(c.Command.Flags, os.Args[len(os.Args)-2]); flag != nil {
+			if f, ok := flag.(cli.DocGenerationFlag); ok && f.TakesValue() {
+				for _, item := range completionValues(c, completionResourcePath(c, flag)) {
+					printCompletionItem(c.App.Writer, item)
+				}
+				return
+			}
+		}
+	}
+	printFlagCompletions(c.App.Writer, c.Command.Flags)
+}
+
+// findFlag returns the flag given by an argument such as --name or -n, or nil if it is not one of flags
+func findFlag(flags []cli.Flag, arg string) cli.Flag {
+	if !strings.HasPrefix(arg, "-") {
+		return nil
+	}
+	arg = strings.TrimLeft(arg, "-")
+	for _, flag := range flags {
+		for _, name := range strings.Split(flag.GetName(), ",") {
+			if strings.TrimSpace(name) == arg {
+				return flag
+			}
+		}
+	}
+	return nil
+}
+
+// completionResourcePath returns the command path listing the resources referred by the flag of the command, if any
+func completionResourcePath(c *cli.Context, flag cli.Flag) string {
+	name := strings.TrimSpace(strings.Split(flag.GetName(), ",")[0])
+	if path, ok := completionFlagResources[name]; ok {
+		return path
+	}
+	return resourceFlags[commandPath(c)][name]
+}
+
+// printFlagCompletions prints the flags not used yet in the command line
+func printFlagCompletions(w io.Writer, flags []cli.Flag) {
+	for _, flag := range flags {
+		if f, ok := flag.(cli.BoolFlag); ok && f.Hidden {
+			continue
+		}
+		usage := ""
+		if f, ok := flag.(cli.DocGenerationFlag); ok {
+			usage = f.GetUsage()
+		}
+		for _, name := range strings.Split(flag.GetName(), ",") {
+			name = strings.TrimSpace(name)
+			arg := fmt.Sprintf("--%s", name)
+			if len(name) == 1 {
+				arg = fmt.Sprintf("-%s", name)
+			}
+			if !utils.Contains(os.Args, arg) {
+				printCompletionItem(w, completionItem{arg, usage})
+			}
+		}
+	}
+}
+
+// printCompletionItem prints a completion as expected by the shell in use
+func printCompletionItem(w io.Writer, item completionItem) {
+	description := strings.Fields(item.Description)
+	switch os.Getenv(completionShellEnv) {
+	case "zsh":
+		fmt.Fprintf(w, "%s:%s\n", strings.Replace(item.Value, ":", "\\:", -1), strings.Join(description, " "))
+	case "fish":
+		fmt.Fprintf(w, "%s\t%s\n", item.Value, strings.Join(description, " "))
+	default:
+		fmt.Fprintln(w, item.Value)
+	}
+}
+
+// completionValues returns the IDs of the resources listed by the command path, or their names for labels. Results
+// are cached for a while, as shells request them on every key press. Any failure just results in no completions
+func completionValues(c *cli.Context, path string) []completionItem {
+	if _, ok := resourceListers[path]; !ok {
+		return nil
+	}
+
+	// app.Before is not run while completing, so the configuration is read here from the global flags
+	root := c
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+	config, err := utils.InitializeConcertoConfig(root)
+	if err != nil {
+		log.Debugf("Couldn't complete %s: %v", path, err)
+		return nil
+	}
+	format.InitializeFormatter("text", os.Stderr)
+	if _, err := utils.NewHTTPConcertoService(config); err != nil {
+		log.Debugf("Couldn't complete %s: %v", path, err)
+		return nil
+	}
+
+	items := make([]completionItem, 0)
+	cache := utils.NewFileCache(filepath.Join(config.ConfLocation, "cache", "completion"), completionCacheTTL)
+	// tenants sharing an endpoint are told apart by the profile and certificate in use
+	key := fmt.Sprintf("%s|%s|%s|%s", config.APIEndpoint, config.Profile, config.Certificate.Cert, path)
+	if cache.Get(key, &items) {
+		return items
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+	ctx = utils.WithPageOptions(ctx, utils.PageOptions{Size: utils.DefaultPageSize, Limit: completionLimit})
+	refs, err := listResourceRefs(ctx, c, path)
+	if err != nil {
+		log.Debugf("Couldn't complete %s: %v", path, err)
+		return nil
+	}
+	for _, ref := range refs {
+		if path == "labels" {
+			items = append(items, completionItem{Value: ref.Name})
+		} else if ref.ID != "" {
+			items = append(items, completionItem{Value: ref.ID, Description: ref.Name})
+		}
+	}
+
+	if err := cache.Set(key, items); err != nil {
+		log.Debugf("Couldn't cache %s completions: %v", path, err)
+	}
+	return items
+}
@@ -0,0 +1,242 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/ingrammicro/cio/utils"
+	"github.com/ingrammicro/cio/utils/format"
+	"github.com/urfave/cli"
+)
+
+const maskedSecret = "********"
+
+var configFileTemplate = template.Must(template.New("configFile").Parse(
+	`<concerto version="1.0" server="{{html .APIEndpoint}}" log_file="{{html .LogFile}}" log_level="{{html .LogLevel}}">
+<ssl cert="{{html .Certificate.Cert}}" key="{{html .Certificate.Key}}" server_ca="{{html .Certificate.Ca}}" />
+</concerto>
+`))
+
+// WireUpConfig prepares common resources to manage the CLI configuration
+func WireUpConfig(c *cli.Context) (config *utils.Config, profiles *utils.Profiles, f format.Formatter) {
+
+	f = format.GetFormatter()
+
+	config, err := utils.GetConcertoConfig()
+	if err != nil {
+		f.PrintFatal("Couldn't wire up config", err)
+	}
+	profiles, err = utils.LoadProfiles(config.ProfilesFile)
+	if err != nil {
+		f.PrintFatal("Couldn't read profiles", err)
+	}
+
+	return config, profiles, f
+}
+
+// ConfigListContexts subcommand function
+func ConfigListContexts(c *cli.Context) error {
+	debugCmdFuncInfo(c)
+
+	config, profiles, formatter := WireUpConfig(c)
+	for i := range profiles.Profiles {
+		profiles.Profiles[i].Current = profiles.Profiles[i].Name == config.Profile
+	}
+
+	if err := formatter.PrintList(profiles.Profiles); err != nil {
+		formatter.PrintFatal(PrintFormatError, err)
+	}
+	return nil
+}
+
+// ConfigCurrentContext subcommand function
+func ConfigCurrentContext(c *cli.Context) error {
+	debugCmdFuncInfo(c)
+
+	config, profiles, formatter := WireUpConfig(c)
+	profile := profiles.Find(config.Profile)
+	if profile == nil {
+		formatter.PrintFatal("Couldn't show current context", fmt.Errorf("no context is in use"))
+	}
+	profile.Current = true
+
+	if err := formatter.PrintItem(*profile); err != nil {
+		formatter.PrintFatal(PrintFormatError, err)
+	}
+	return nil
+}
+
+// ConfigUseContext subcommand function
+func ConfigUseContext(c *cli.Context) error {
+	debugCmdFuncInfo(c)
+
+	config, profiles, formatter := WireUpConfig(c)
+	name := c.Args().First()
+	if name == "" {
+		formatter.PrintError("Incorrect usage.", fmt.Errorf("context name is missing"))
+		cli.ShowCommandHelp(c, c.Command.Name)
+		os.Exit(2)
+	}
+
+	if err := profiles.Use(name); err != nil {
+		formatter.PrintFatal("Couldn't use context", err)
+	}
+	if err := profiles.Save(config.ProfilesFile); err != nil {
+		formatter.PrintFatal("Couldn't save profiles", err)
+	}
+
+	profile := profiles.Find(name)
+	profile.Current = true
+	if err := formatter.PrintItem(*profile); err != nil {
+		formatter.PrintFatal(PrintFormatError, err)
+	}
+	return nil
+}
+
+// ConfigSetContext subcommand function
+func ConfigSetContext(c *cli.Context) error {
+	debugCmdFuncInfo(c)
+
+	config, profiles, formatter := WireUpConfig(c)
+	name := c.Args().First()
+	if name == "" {
+		formatter.PrintError("Incorrect usage.", fmt.Errorf("context name is missing"))
+		cli.ShowCommandHelp(c, c.Command.Name)
+		os.Exit(2)
+	}
+
+	profile := profiles.Set(utils.Profile{
+		Name:        name,
+		APIEndpoint: c.String("server"),
+		Formatter:   c.String("formatter"),
+		Certificate: utils.Cert{
+			Cert: c.String("cert"),
+			Key:  c.String("key"),
+			Ca:   c.String("ca-cert"),
+		},
+	})
+	if err := profiles.Save(config.ProfilesFile); err != nil {
+		formatter.PrintFatal("Couldn't save profiles", err)
+	}
+
+	profile.Current = name == config.Profile
+	if err := formatter.PrintItem(*profile); err != nil {
+		formatter.PrintFatal(PrintFormatError, err)
+	}
+	return nil
+}
+
+// ConfigInit subcommand function
+func ConfigInit(c *cli.Context) error {
+	debugCmdFuncInfo(c)
+
+	config, _, formatter := WireUpConfig(c)
+	if utils.FileExists(config.ConfFile) && !c.Bool("force") {
+		formatter.PrintFatal(
+			"Couldn't write configuration",
+			fmt.Errorf("%s already exists, use --force to overwrite it", config.ConfFile),
+		)
+	}
+
+	// current values, as given by env/args, are offered as defaults
+	data := utils.Config{
+		APIEndpoint: config.APIEndpoint,
+		LogFile:     defaultString(config.LogFile, utils.GetDefaultLogFilePath()),
+		LogLevel:    defaultString(config.LogLevel, "info"),
+		Certificate: utils.Cert{
+			Cert: defaultString(config.Certificate.Cert, filepath.Join(config.ConfLocation, "ssl", "cert.crt")),
+			Key:  defaultString(config.Certificate.Key, filepath.Join(config.ConfLocation, "ssl", "private", "cert.key")),
+			Ca:   defaultString(config.Certificate.Ca, filepath.Join(config.ConfLocation, "ssl", "ca_cert.pem")),
+		},
+	}
+	if !c.Bool("non-interactive") {
+		in := bufio.NewReader(os.Stdin)
+		data.APIEndpoint = promptString(in, os.Stderr, "API endpoint", data.APIEndpoint)
+		data.Certificate.Cert = promptString(in, os.Stderr, "Client certificate", data.Certificate.Cert)
+		data.Certificate.Key = promptString(in, os.Stderr, "Client key", data.Certificate.Key)
+		data.Certificate.Ca = promptString(in, os.Stderr, "CA certificate", data.Certificate.Ca)
+		data.LogFile = promptString(in, os.Stderr, "Log file", data.LogFile)
+		data.LogLevel = promptString(in, os.Stderr, "Log level", data.LogLevel)
+	}
+
+	if err := os.MkdirAll(config.ConfLocation, 0700); err != nil {
+		formatter.PrintFatal("Couldn't create directory to place config file", err)
+	}
+	f, err := os.OpenFile(config.ConfFile, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 0644)
+	if err != nil {
+		formatter.PrintFatal("Couldn't open config file for writing", err)
+	}
+	defer f.Close()
+	if err := configFileTemplate.Execute(f, data); err != nil {
+		formatter.PrintFatal("Couldn't generate config file contents", err)
+	}
+
+	// the written configuration is printed, the hint goes along with the prompts so that output stays parseable
+	data.ConfLocation = config.ConfLocation
+	data.ConfFile = config.ConfFile
+	if err := formatter.PrintItem(data); err != nil {
+		formatter.PrintFatal(PrintFormatError, err)
+	}
+	fmt.Fprintf(os.Stderr, "Configuration file placed at %s. Run 'cio config validate' to check it\n", config.ConfFile)
+	return nil
+}
+
+// ConfigValidate subcommand function
+func ConfigValidate(c *cli.Context) error {
+	debugCmdFuncInfo(c)
+
+	config, _, formatter := WireUpConfig(c)
+	ctx, cancel := cmdContext()
+	defer cancel()
+	checks := utils.ValidateConfig(ctx, config)
+
+	if err := formatter.PrintList(checks); err != nil {
+		formatter.PrintFatal(PrintFormatError, err)
+	}
+	if failed := utils.ConfigChecksFailed(checks); failed > 0 {
+		formatter.PrintFatal("Configuration is not valid", fmt.Errorf("%d of %d checks failed", failed, len(checks)))
+	}
+	return nil
+}
+
+// ConfigShow subcommand function
+func ConfigShow(c *cli.Context) error {
+	debugCmdFuncInfo(c)
+
+	config, _, formatter := WireUpConfig(c)
+
+	// tokens are secrets, so they are only shown as set
+	shown := *config
+	if shown.BrownfieldToken != "" {
+		shown.BrownfieldToken = maskedSecret
+	}
+	if shown.CommandPollingToken != "" {
+		shown.CommandPollingToken = maskedSecret
+	}
+
+	if err := formatter.PrintItem(shown); err != nil {
+		formatter.PrintFatal(PrintFormatError, err)
+	}
+	return nil
+}
+
+// promptString asks for a value, returning the default one if none is entered
+func promptString(in *bufio.Reader, out io.Writer, label string, def string) string {
+	fmt.Fprintf(out, "%s [%s]: ", label, def)
+	line, _ := in.ReadString('\n')
+	return defaultString(strings.TrimSpace(line), def)
+}
+
+func defaultString(value string, def string) string {
+	if value == "" {
+		return def
+	}
+	return value
+}
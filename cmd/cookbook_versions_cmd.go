@@ -38,7 +38,9 @@ func CookbookVersionList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	svc, formatter := WireUpCookbookVersion(c)
 
-	cookbookVersions, err := svc.ListCookbookVersions()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	cookbookVersions, err := svc.ListCookbookVersionsContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive cookbook versions data", err)
 	}
@@ -38,7 +38,9 @@ func DomainList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	svc, formatter := WireUpDomain(c)
 
-	domains, err := svc.ListDomains()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	domains, err := svc.ListDomainsContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive dns domains data", err)
 	}
@@ -108,6 +110,9 @@ func DomainCreate(c *cli.Context) error {
 	if err != nil {
 		formatter.PrintFatal("Couldn't create dns domain", err)
 	}
+	if r := waitIfRequested(c, domain.ID, false, formatter); r != nil {
+		domain = r.(*types.Domain)
+	}
 
 	domain.FillInLabelNames(labelNamesByID)
 	if err = formatter.PrintItem(*domain); err != nil {
@@ -126,6 +131,7 @@ func DomainDelete(c *cli.Context) error {
 	if err != nil {
 		formatter.PrintFatal("Couldn't delete dns domain", err)
 	}
+	waitIfRequested(c, c.String("id"), true, formatter)
 
 	_, labelNamesByID := LabelLoadsMapping(c)
 	domain.FillInLabelNames(labelNamesByID)
@@ -161,7 +167,9 @@ func DomainListRecords(c *cli.Context) error {
 	svc, formatter := WireUpDomain(c)
 
 	checkRequiredFlags(c, []string{"domain-id"}, formatter)
-	records, err := svc.ListRecords(c.String("domain-id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	records, err := svc.ListRecordsContext(ctx, c.String("domain-id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive dns records data", err)
 	}
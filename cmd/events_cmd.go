@@ -35,7 +35,9 @@ func EventList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	eventSvc, formatter := WireUpEvent(c)
 
-	events, err := eventSvc.ListEvents()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	events, err := eventSvc.ListEventsContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive event data", err)
 	}
@@ -50,7 +52,9 @@ func SysEventList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	eventSvc, formatter := WireUpEvent(c)
 
-	events, err := eventSvc.ListSysEvents()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	events, err := eventSvc.ListSysEventsContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive system event data", err)
 	}
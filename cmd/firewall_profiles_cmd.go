@@ -38,7 +38,9 @@ func FirewallProfileList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	firewallProfileSvc, formatter := WireUpFirewallProfile(c)
 
-	firewallProfiles, err := firewallProfileSvc.ListFirewallProfiles()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	firewallProfiles, err := firewallProfileSvc.ListFirewallProfilesContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive firewallProfile data", err)
 	}
@@ -91,9 +93,9 @@ func FirewallProfileCreate(c *cli.Context) error {
 
 	checkRequiredFlags(c, []string{"name", "description"}, formatter)
 
-	firewallProfileIn := map[string]interface{}{
-		"name":        c.String("name"),
-		"description": c.String("description"),
+	firewallProfileIn := &types.FirewallProfileCreateInput{
+		Name:        c.String("name"),
+		Description: c.String("description"),
 	}
 
 	if c.String("rules") != "" {
@@ -101,16 +103,16 @@ func FirewallProfileCreate(c *cli.Context) error {
 		if err := fw.ConvertFlagParamsToRules(c.String("rules")); err != nil {
 			formatter.PrintFatal("Error parsing parameters", err)
 		}
-		firewallProfileIn["rules"] = fw.Rules
+		firewallProfileIn.Rules = fw.Rules
 	}
 
 	labelIDsByName, labelNamesByID := LabelLoadsMapping(c)
 
 	if c.IsSet("labels") {
-		firewallProfileIn["label_ids"] = LabelResolution(c, c.String("labels"), &labelNamesByID, &labelIDsByName)
+		firewallProfileIn.LabelIDs = LabelResolution(c, c.String("labels"), &labelNamesByID, &labelIDsByName)
 	}
 
-	firewallProfile, err := firewallProfileSvc.CreateFirewallProfile(&firewallProfileIn)
+	firewallProfile, err := firewallProfileSvc.CreateFirewallProfileWithInput(firewallProfileIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't create firewallProfile", err)
 	}
@@ -129,22 +131,19 @@ func FirewallProfileUpdate(c *cli.Context) error {
 
 	checkRequiredFlags(c, []string{"id"}, formatter)
 
-	firewallProfileIn := map[string]interface{}{}
-	if c.String("name") != "" {
-		firewallProfileIn["name"] = c.String("name")
-	}
-	if c.String("description") != "" {
-		firewallProfileIn["description"] = c.String("description")
+	firewallProfileIn := &types.FirewallProfileUpdateInput{
+		Name:        c.String("name"),
+		Description: c.String("description"),
 	}
 	if c.String("rules") != "" {
 		fw := new(types.FirewallProfile)
 		if err := fw.ConvertFlagParamsToRules(c.String("rules")); err != nil {
 			formatter.PrintFatal("Error parsing parameters", err)
 		}
-		firewallProfileIn["rules"] = fw.Rules
+		firewallProfileIn.Rules = fw.Rules
 	}
 
-	firewallProfile, err := firewallProfileSvc.UpdateFirewallProfile(c.String("id"), &firewallProfileIn)
+	firewallProfile, err := firewallProfileSvc.UpdateFirewallProfileWithInput(c.String("id"), firewallProfileIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't update firewallProfile", err)
 	}
@@ -38,7 +38,9 @@ func FloatingIPList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	floatingIPSvc, formatter := WireUpFloatingIP(c)
 
-	floatingIPs, err := floatingIPSvc.ListFloatingIPs(c.String("server-id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	floatingIPs, err := floatingIPSvc.ListFloatingIPsContext(ctx, c.String("server-id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive floating IP data", err)
 	}
@@ -91,22 +93,25 @@ func FloatingIPCreate(c *cli.Context) error {
 
 	checkRequiredFlags(c, []string{"name", "cloud-account-id", "realm-id"}, formatter)
 
-	floatingIPIn := map[string]interface{}{
-		"name":             c.String("name"),
-		"cloud_account_id": c.String("cloud-account-id"),
-		"realm_id":         c.String("realm-id"),
+	floatingIPIn := &types.FloatingIPCreateInput{
+		Name:           c.String("name"),
+		CloudAccountID: c.String("cloud-account-id"),
+		RealmID:        c.String("realm-id"),
 	}
 
 	labelIDsByName, labelNamesByID := LabelLoadsMapping(c)
 
 	if c.IsSet("labels") {
-		floatingIPIn["label_ids"] = LabelResolution(c, c.String("labels"), &labelNamesByID, &labelIDsByName)
+		floatingIPIn.LabelIDs = LabelResolution(c, c.String("labels"), &labelNamesByID, &labelIDsByName)
 	}
 
-	floatingIP, err := floatingIPSvc.CreateFloatingIP(&floatingIPIn)
+	floatingIP, err := floatingIPSvc.CreateFloatingIPWithInput(floatingIPIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't create floating IP", err)
 	}
+	if r := waitIfRequested(c, floatingIP.ID, false, formatter); r != nil {
+		floatingIP = r.(*types.FloatingIP)
+	}
 
 	floatingIP.FillInLabelNames(labelNamesByID)
 	if err = formatter.PrintItem(*floatingIP); err != nil {
@@ -122,11 +127,11 @@ func FloatingIPUpdate(c *cli.Context) error {
 
 	checkRequiredFlags(c, []string{"id", "name"}, formatter)
 
-	floatingIPIn := map[string]interface{}{
-		"name": c.String("name"),
+	floatingIPIn := &types.FloatingIPUpdateInput{
+		Name: c.String("name"),
 	}
 
-	floatingIP, err := floatingIPSvc.UpdateFloatingIP(c.String("id"), &floatingIPIn)
+	floatingIP, err := floatingIPSvc.UpdateFloatingIPWithInput(c.String("id"), floatingIPIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't update floating IP", err)
 	}
@@ -186,6 +191,7 @@ func FloatingIPDelete(c *cli.Context) error {
 	if err != nil {
 		formatter.PrintFatal("Couldn't delete floating IP", err)
 	}
+	waitIfRequested(c, c.String("id"), true, formatter)
 	return nil
 }
 
@@ -35,7 +35,9 @@ func GenericImageList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	genericImageSvc, formatter := WireUpGenericImage(c)
 
-	genericImages, err := genericImageSvc.ListGenericImages()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	genericImages, err := genericImageSvc.ListGenericImagesContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive genericImage data", err)
 	}
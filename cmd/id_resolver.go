@@ -0,0 +1,472 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ingrammicro/cio/utils/format"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// resourceIDRegexp matches IMCO resource IDs, which are never looked up
+var resourceIDRegexp = regexp.MustCompile("^[0-9a-f]{24}$")
+
+// resourceLister lists the resources of a type, whose IDs are resolved and completed
+type resourceLister func(ctx context.Context, c *cli.Context) (interface{}, error)
+
+// resourceListers maps command paths to the listing of their resources, which their --id flags refer to
+var resourceListers = map[string]resourceLister{
+	"cloud servers": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpServer(c)
+		return svc.ListServersContext(ctx)
+	},
+	"cloud server-arrays": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpServerArray(c)
+		return svc.ListServerArraysContext(ctx)
+	},
+	"cloud generic-images": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpGenericImage(c)
+		return svc.ListGenericImagesContext(ctx)
+	},
+	"cloud ssh-profiles": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpSSHProfile(c)
+		return svc.ListSSHProfilesContext(ctx)
+	},
+	"cloud cloud-providers": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpCloudProvider(c)
+		return svc.ListCloudProvidersContext(ctx)
+	},
+	"cloud realms": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpRealm(c)
+		return svc.ListRealmsContext(ctx, c.String("cloud-provider-id"))
+	},
+	"cloud server-plans": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpServerPlan(c)
+		return svc.ListServerPlansContext(ctx, c.String("cloud-provider-id"), c.String("realm-id"))
+	},
+	"blueprint templates": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpTemplate(c)
+		return svc.ListTemplatesContext(ctx)
+	},
+	"blueprint scripts": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpScript(c)
+		return svc.ListScriptsContext(ctx)
+	},
+	"blueprint cookbook-versions": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpCookbookVersion(c)
+		return svc.ListCookbookVersionsContext(ctx)
+	},
+	"network firewall-profiles": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpFirewallProfile(c)
+		return svc.ListFirewallProfilesContext(ctx)
+	},
+	"network floating-ips": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpFloatingIP(c)
+		return svc.ListFloatingIPsContext(ctx, "")
+	},
+	"network load-balancers": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpLoadBalancer(c)
+		return svc.ListLoadBalancersContext(ctx)
+	},
+	"network vpcs": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpVPC(c)
+		return svc.ListVPCsContext(ctx)
+	},
+	"network dns-domains": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpDomain(c)
+		return svc.ListDomainsContext(ctx)
+	},
+	"storage volumes": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpVolume(c)
+		return svc.ListVolumesContext(ctx, "")
+	},
+	"kubernetes clusters": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpCluster(c)
+		return svc.ListClustersContext(ctx)
+	},
+	"settings cloud-accounts": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpCloudAccount(c)
+		return svc.ListCloudAccountsContext(ctx)
+	},
+	"settings policies definitions": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpPolicyDefinition(c)
+		return svc.ListDefinitionsContext(ctx)
+	},
+	"cloud-applications templates": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpCloudApplicationTemplate(c)
+		return svc.ListTemplatesContext(ctx)
+	},
+	"cloud-applications deployments": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpCloudApplicationDeployment(c)
+		return svc.ListDeploymentsContext(ctx)
+	},
+	"wizard apps": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpApp(c)
+		return svc.ListAppsContext(ctx)
+	},
+	"wizard locations": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpLocation(c)
+		return svc.ListLocationsContext(ctx)
+	},
+	"labels": func(ctx context.Context, c *cli.Context) (interface{}, error) {
+		svc, _ := WireUpLabel(c)
+		return svc.ListLabelsContext(ctx)
+	},
+}
+
+// resourceListerFlags are the flags some listings depend on, which are resolved before the flags referring to them
+var resourceListerFlags = map[string][]string{
+	"cloud realms":       {"cloud-provider-id"},
+	"cloud server-plans": {"cloud-provider-id", "realm-id"},
+}
+
+// resourceFlags maps the paths of the commands taking resources by their ID to the flags doing so, and these to the
+// command paths listing the resources. Flags not given here, such as those referring to resources which can't be
+// listed, are left as they are
+var resourceFlags = map[string]map[string]string{
+	"blueprint cookbook-versions add-label":    {"id": "blueprint cookbook-versions"},
+	"blueprint cookbook-versions delete":       {"id": "blueprint cookbook-versions"},
+	"blueprint cookbook-versions remove-label": {"id": "blueprint cookbook-versions"},
+	"blueprint cookbook-versions show":         {"id": "blueprint cookbook-versions"},
+	"blueprint scripts add-attachment":         {"id": "blueprint scripts"},
+	"blueprint scripts add-label":              {"id": "blueprint scripts"},
+	"blueprint scripts delete":                 {"id": "blueprint scripts"},
+	"blueprint scripts list-attachments":       {"id": "blueprint scripts"},
+	"blueprint scripts remove-label":           {"id": "blueprint scripts"},
+	"blueprint scripts show":                   {"id": "blueprint scripts"},
+	"blueprint scripts update":                 {"id": "blueprint scripts"},
+	"blueprint templates add-label":            {"id": "blueprint templates"},
+	"blueprint templates compile":              {"id": "blueprint templates"},
+	"blueprint templates create":               {"generic-image-id": "cloud generic-images"},
+	"blueprint templates create-template-script": {
+		"template-id": "blueprint templates",
+		"script-id":   "blueprint scripts",
+	},
+	"blueprint templates delete":                     {"id": "blueprint templates"},
+	"blueprint templates delete-template-script":     {"template-id": "blueprint templates"},
+	"blueprint templates list-template-scripts":      {"template-id": "blueprint templates"},
+	"blueprint templates list-template-servers":      {"template-id": "blueprint templates"},
+	"blueprint templates remove-label":               {"id": "blueprint templates"},
+	"blueprint templates reorder-template-scripts":   {"template-id": "blueprint templates"},
+	"blueprint templates show":                       {"id": "blueprint templates"},
+	"blueprint templates show-template-script":       {"template-id": "blueprint templates"},
+	"blueprint templates update":                     {"id": "blueprint templates"},
+	"blueprint templates update-template-script":     {"template-id": "blueprint templates"},
+	"brownfield import-floating-ips":                 {"id": "settings cloud-accounts"},
+	"brownfield import-k8s-clusters":                 {"id": "settings cloud-accounts"},
+	"brownfield import-policies":                     {"id": "settings cloud-accounts"},
+	"brownfield import-servers":                      {"id": "settings cloud-accounts"},
+	"brownfield import-volumes":                      {"id": "settings cloud-accounts"},
+	"brownfield import-vpcs":                         {"id": "settings cloud-accounts"},
+	"cloud cloud-providers list-cluster-plans":       {"cloud-provider-id": "cloud cloud-providers"},
+	"cloud cloud-providers list-load-balancer-plans": {"cloud-provider-id": "cloud cloud-providers"},
+	"cloud cloud-providers list-storage-plans":       {"cloud-provider-id": "cloud cloud-providers"},
+	"cloud realms list":                              {"id": "cloud cloud-providers"},
+	"cloud server-arrays add-label":                  {"id": "cloud server-arrays"},
+	"cloud server-arrays boot":                       {"id": "cloud server-arrays"},
+	"cloud server-arrays create": {
+		"template-id":         "blueprint templates",
+		"cloud-account-id":    "settings cloud-accounts",
+		"firewall-profile-id": "network firewall-profiles",
+		"ssh-profile-id":      "cloud ssh-profiles",
+	},
+	"cloud server-arrays delete":       {"id": "cloud server-arrays"},
+	"cloud server-arrays empty":        {"id": "cloud server-arrays"},
+	"cloud server-arrays enlarge":      {"id": "cloud server-arrays"},
+	"cloud server-arrays list-servers": {"id": "cloud server-arrays"},
+	"cloud server-arrays remove-label": {"id": "cloud server-arrays"},
+	"cloud server-arrays show":         {"id": "cloud server-arrays"},
+	"cloud server-arrays shutdown":     {"id": "cloud server-arrays"},
+	"cloud server-arrays update":       {"id": "cloud server-arrays"},
+	"cloud server-plans list":          {"cloud-provider-id": "cloud cloud-providers", "realm-id": "cloud realms"},
+	"cloud servers add-label":          {"id": "cloud servers"},
+	"cloud servers boot":               {"id": "cloud servers"},
+	"cloud servers create": {
+		"ssh-profile-id":      "cloud ssh-profiles",
+		"firewall-profile-id": "network firewall-profiles",
+		"template-id":         "blueprint templates",
+		"cloud-account-id":    "settings cloud-accounts",
+	},
+	"cloud servers delete":                         {"id": "cloud servers"},
+	"cloud servers execute-script":                 {"server-id": "cloud servers", "script-id": "blueprint scripts"},
+	"cloud servers list-events":                    {"id": "cloud servers"},
+	"cloud servers list-floating-ips":              {"id": "cloud servers"},
+	"cloud servers list-operational-scripts":       {"id": "cloud servers"},
+	"cloud servers list-volumes":                   {"id": "cloud servers"},
+	"cloud servers override-server":                {"id": "cloud servers"},
+	"cloud servers reboot":                         {"id": "cloud servers"},
+	"cloud servers remove-label":                   {"id": "cloud servers"},
+	"cloud servers show":                           {"id": "cloud servers"},
+	"cloud servers shutdown":                       {"id": "cloud servers"},
+	"cloud servers update":                         {"id": "cloud servers"},
+	"cloud servers wait":                           {"id": "cloud servers"},
+	"cloud ssh-profiles add-label":                 {"id": "cloud ssh-profiles"},
+	"cloud ssh-profiles delete":                    {"id": "cloud ssh-profiles"},
+	"cloud ssh-profiles remove-label":              {"id": "cloud ssh-profiles"},
+	"cloud ssh-profiles show":                      {"id": "cloud ssh-profiles"},
+	"cloud ssh-profiles update":                    {"id": "cloud ssh-profiles"},
+	"cloud-applications deployments delete":        {"id": "cloud-applications deployments"},
+	"cloud-applications deployments deploy":        {"id": "cloud-applications templates"},
+	"cloud-applications deployments show":          {"id": "cloud-applications deployments"},
+	"cloud-applications templates delete":          {"id": "cloud-applications templates"},
+	"cloud-applications templates show":            {"id": "cloud-applications templates"},
+	"cloud-specific-extensions deployments deploy": {"cloud-account-id": "settings cloud-accounts"},
+	"kubernetes clusters add-label":                {"id": "kubernetes clusters"},
+	"kubernetes clusters create": {
+		"cloud-account-id": "settings cloud-accounts",
+		"vpc-id":           "network vpcs",
+	},
+	"kubernetes clusters delete":       {"id": "kubernetes clusters"},
+	"kubernetes clusters discard":      {"id": "kubernetes clusters"},
+	"kubernetes clusters remove-label": {"id": "kubernetes clusters"},
+	"kubernetes clusters retry":        {"id": "kubernetes clusters"},
+	"kubernetes clusters show":         {"id": "kubernetes clusters"},
+	"kubernetes clusters update":       {"id": "kubernetes clusters"},
+	"kubernetes clusters wait":         {"id": "kubernetes clusters"},
+	"kubernetes node-pools create":     {"id": "kubernetes clusters"},
+	"kubernetes node-pools list":       {"id": "kubernetes clusters"},
+	"network dns-domains create":       {"cloud-account-id": "settings cloud-accounts"},
+	"network dns-domains delete":       {"id": "network dns-domains"},
+	"network dns-domains records create": {
+		"domain-id":        "network dns-domains",
+		"server-id":        "cloud servers",
+		"floating-ip-id":   "network floating-ips",
+		"load-balancer-id": "network load-balancers",
+	},
+	"network dns-domains records list":           {"domain-id": "network dns-domains"},
+	"network dns-domains retry":                  {"id": "network dns-domains"},
+	"network dns-domains show":                   {"id": "network dns-domains"},
+	"network dns-domains wait":                   {"id": "network dns-domains"},
+	"network firewall-profiles add-label":        {"id": "network firewall-profiles"},
+	"network firewall-profiles delete":           {"id": "network firewall-profiles"},
+	"network firewall-profiles remove-label":     {"id": "network firewall-profiles"},
+	"network firewall-profiles show":             {"id": "network firewall-profiles"},
+	"network firewall-profiles update":           {"id": "network firewall-profiles"},
+	"network floating-ips add-label":             {"id": "network floating-ips"},
+	"network floating-ips attach":                {"id": "network floating-ips", "server-id": "cloud servers"},
+	"network floating-ips create":                {"cloud-account-id": "settings cloud-accounts"},
+	"network floating-ips delete":                {"id": "network floating-ips"},
+	"network floating-ips detach":                {"id": "network floating-ips"},
+	"network floating-ips discard":               {"id": "network floating-ips"},
+	"network floating-ips list":                  {"server-id": "cloud servers"},
+	"network floating-ips remove-label":          {"id": "network floating-ips"},
+	"network floating-ips show":                  {"id": "network floating-ips"},
+	"network floating-ips update":                {"id": "network floating-ips"},
+	"network floating-ips wait":                  {"id": "network floating-ips"},
+	"network load-balancers add-label":           {"id": "network load-balancers"},
+	"network load-balancers certificates create": {"load-balancer-id": "network load-balancers"},
+	"network load-balancers certificates delete": {"load-balancer-id": "network load-balancers"},
+	"network load-balancers certificates list":   {"load-balancer-id": "network load-balancers"},
+	"network load-balancers certificates show":   {"load-balancer-id": "network load-balancers"},
+	"network load-balancers certificates update": {"load-balancer-id": "network load-balancers"},
+	"network load-balancers create": {
+		"cloud-account-id": "settings cloud-accounts",
+		"vpc-id":           "network vpcs",
+	},
+	"network load-balancers delete":               {"id": "network load-balancers"},
+	"network load-balancers listeners create":     {"load-balancer-id": "network load-balancers"},
+	"network load-balancers listeners list":       {"load-balancer-id": "network load-balancers"},
+	"network load-balancers remove-label":         {"id": "network load-balancers"},
+	"network load-balancers retry":                {"id": "network load-balancers"},
+	"network load-balancers show":                 {"id": "network load-balancers"},
+	"network load-balancers target-groups create": {"load-balancer-id": "network load-balancers"},
+	"network load-balancers target-groups list":   {"load-balancer-id": "network load-balancers"},
+	"network load-balancers update":               {"id": "network load-balancers"},
+	"network load-balancers wait":                 {"id": "network load-balancers"},
+	"network subnets create":                      {"vpc-id": "network vpcs"},
+	"network subnets list":                        {"vpc-id": "network vpcs"},
+	"network vpcs add-label":                      {"id": "network vpcs"},
+	"network vpcs create":                         {"cloud-account-id": "settings cloud-accounts"},
+	"network vpcs delete":                         {"id": "network vpcs"},
+	"network vpcs discard":                        {"id": "network vpcs"},
+	"network vpcs remove-label":                   {"id": "network vpcs"},
+	"network vpcs show":                           {"id": "network vpcs"},
+	"network vpcs update":                         {"id": "network vpcs"},
+	"network vpcs wait":                           {"id": "network vpcs"},
+	"network vpns create":                         {"vpc-id": "network vpcs"},
+	"network vpns delete":                         {"vpc-id": "network vpcs"},
+	"network vpns list-plans":                     {"vpc-id": "network vpcs"},
+	"network vpns show":                           {"vpc-id": "network vpcs"},
+	"settings cloud-accounts show":                {"id": "settings cloud-accounts"},
+	"settings policies assignments create": {
+		"cloud-account-id": "settings cloud-accounts",
+		"definition-id":    "settings policies definitions",
+	},
+	"settings policies assignments list":             {"cloud-account-id": "settings cloud-accounts"},
+	"settings policies definitions delete":           {"id": "settings policies definitions"},
+	"settings policies definitions list-assignments": {"id": "settings policies definitions"},
+	"settings policies definitions show":             {"id": "settings policies definitions"},
+	"settings policies definitions update":           {"id": "settings policies definitions"},
+	"storage volumes add-label":                      {"id": "storage volumes"},
+	"storage volumes attach":                         {"id": "storage volumes", "server-id": "cloud servers"},
+	"storage volumes create":                         {"cloud-account-id": "settings cloud-accounts"},
+	"storage volumes delete":                         {"id": "storage volumes"},
+	"storage volumes detach":                         {"id": "storage volumes"},
+	"storage volumes discard":                        {"id": "storage volumes"},
+	"storage volumes list":                           {"server-id": "cloud servers"},
+	"storage volumes remove-label":                   {"id": "storage volumes"},
+	"storage volumes show":                           {"id": "storage volumes"},
+	"storage volumes update":                         {"id": "storage volumes"},
+	"storage volumes wait":                           {"id": "storage volumes"},
+	"wizard apps deploy": {
+		"id":               "wizard apps",
+		"location-id":      "wizard locations",
+		"cloud-account-id": "settings cloud-accounts",
+	},
+	"wizard cloud-providers list": {"app-id": "wizard apps", "location-id": "wizard locations"},
+	"wizard server-plans list": {
+		"app-id":            "wizard apps",
+		"location-id":       "wizard locations",
+		"cloud-provider-id": "cloud cloud-providers",
+	},
+}
+
+// resourceRef is a resource as referred in the command line: either by its ID or by its name
+type resourceRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// resolvedResourceRefs caches the resources listed to resolve names, so that they are only listed once per invocation
+var resolvedResourceRefs = map[string][]resourceRef{}
+
+// listResourceRefs lists the resources of the command path, taking their ID and Name fields
+func listResourceRefs(ctx context.Context, c *cli.Context, path string) ([]resourceRef, error) {
+	lister, ok := resourceListers[path]
+	if !ok {
+		return nil, fmt.Errorf("%s can't be listed", path)
+	}
+	for _, name := range resourceListerFlags[path] {
+		if c.String(name) == "" {
+			return nil, fmt.Errorf("%s can only be listed along with --%s", path, name)
+		}
+	}
+	resources, err := lister(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]resourceRef, 0)
+	rs := reflect.ValueOf(resources)
+	for i := 0; i < rs.Len(); i++ {
+		r := reflect.Indirect(rs.Index(i))
+		if r.Kind() != reflect.Struct {
+			continue
+		}
+		ref := resourceRef{}
+		if id := r.FieldByName("ID"); id.IsValid() && id.Kind() == reflect.String {
+			ref.ID = id.String()
+		}
+		if name := r.FieldByName("Name"); name.IsValid() && name.Kind() == reflect.String {
+			ref.Name = name.String()
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+// resourceListerKey identifies a listing, along with the flags it depends on
+func resourceListerKey(c *cli.Context, path string) string {
+	key := path
+	for _, name := range resourceListerFlags[path] {
+		key = fmt.Sprintf("%s|%s", key, c.String(name))
+	}
+	return key
+}
+
+// resourceFlagPath returns the command path listing the resources referred by the flag of the command, or "" if the
+// flag doesn't refer to resources which can be listed
+func resourceFlagPath(c *cli.Context, name string) string {
+	path := resourceFlags[commandPath(c)][name]
+	if _, ok := resourceListers[path]; !ok {
+		return ""
+	}
+	return path
+}
+
+// resolveResourceID returns the ID of the resource of the command path referred by value, which is either its ID or
+// its name
+func resolveResourceID(ctx context.Context, c *cli.Context, path string, value string) (string, error) {
+	if resourceIDRegexp.MatchString(value) {
+		return value, nil
+	}
+
+	key := resourceListerKey(c, path)
+	refs, ok := resolvedResourceRefs[key]
+	if !ok {
+		var err error
+		if refs, err = listResourceRefs(ctx, c, path); err != nil {
+			return "", err
+		}
+		resolvedResourceRefs[key] = refs
+	}
+
+	ids := make([]string, 0)
+	for _, ref := range refs {
+		if ref.ID == value {
+			return ref.ID, nil
+		}
+		if ref.Name == value {
+			ids = append(ids, ref.ID)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s found with ID or name %q", path, value)
+	case 1:
+		log.Debugf("Resolved %s name %q to ID %s", path, value, ids[0])
+		return ids[0], nil
+	}
+	return "", fmt.Errorf(
+		"name %q is ambiguous, as %d %s share that name: %s. Please, use an ID instead",
+		value,
+		len(ids),
+		path,
+		strings.Join(ids, ", "),
+	)
+}
+
+// ResolveIDs replaces the resource names given to the flags referring to resources by their IDs, so that commands
+// can take either of them. Flags other listings depend on are resolved first
+func ResolveIDs(c *cli.Context) error {
+	names := make([]string, 0)
+	for _, name := range c.FlagNames() {
+		if c.IsSet(name) && c.String(name) != "" && resourceFlagPath(c, name) != "" {
+			names = append(names, name)
+		}
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		return len(resourceListerFlags[resourceFlagPath(c, names[i])]) <
+			len(resourceListerFlags[resourceFlagPath(c, names[j])])
+	})
+
+	ctx, cancel := cmdContext()
+	defer cancel()
+	for _, name := range names {
+		id, err := resolveResourceID(ctx, c, resourceFlagPath(c, name), c.String(name))
+		if err != nil {
+			format.GetFormatter().PrintFatal(fmt.Sprintf("Couldn't resolve --%s", name), err)
+		}
+		if err := c.Set(name, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetIDResolution sets the resolution of resource names of the given commands and all of their subcommands
+func SetIDResolution(commands []cli.Command) {
+	for i := range commands {
+		if commands[i].Action != nil && commands[i].Before == nil {
+			commands[i].Before = ResolveIDs
+		}
+		SetIDResolution(commands[i].Subcommands)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+// runResolving runs the command line against an app with the given command, whose flags are resolved before its
+// action, and returns the resource path of each flag and its value once resolved
+func runResolving(t *testing.T, group []string, command string, args ...string) (map[string]string, map[string]string) {
+	paths := make(map[string]string)
+	values := make(map[string]string)
+	flags := []cli.Flag{cli.StringFlag{Name: "id"}, cli.StringFlag{Name: "server-id"}}
+	commands := []cli.Command{{
+		Name:  command,
+		Flags: flags,
+		Action: func(c *cli.Context) error {
+			for _, flag := range flags {
+				paths[flag.GetName()] = resourceFlagPath(c, flag.GetName())
+				values[flag.GetName()] = c.String(flag.GetName())
+			}
+			return nil
+		},
+	}}
+	for i := len(group) - 1; i >= 0; i-- {
+		commands = []cli.Command{{Name: group[i], Subcommands: commands}}
+	}
+	SetIDResolution(commands)
+
+	app := cli.NewApp()
+	app.Name = "cio"
+	app.Commands = commands
+	err := app.Run(append(append([]string{"cio"}, append(group, command)...), args...))
+	assert.Nil(t, err, "The command should run")
+	return paths, values
+}
+
+func TestResourceFlagPath(t *testing.T) {
+	assert := assert.New(t)
+
+	paths, _ := runResolving(t, []string{"network", "load-balancers"}, "show")
+	assert.Equal("network load-balancers", paths["id"], "--id should refer to load balancers")
+	assert.Equal("", paths["server-id"], "Flags which the command doesn't take by ID should not be resolved")
+
+	paths, _ = runResolving(t, []string{"network", "load-balancers"}, "show-plan")
+	assert.Equal("", paths["id"], "--id refers to a load balancer plan, which can't be listed")
+
+	paths, _ = runResolving(t, []string{"cloud", "realms"}, "list")
+	assert.Equal("cloud cloud-providers", paths["id"], "--id should refer to cloud providers")
+
+	paths, _ = runResolving(t, []string{"cloud-applications", "deployments"}, "deploy")
+	assert.Equal("cloud-applications templates", paths["id"], "--id should refer to CATs")
+
+	paths, _ = runResolving(t, []string{"blueprint", "templates"}, "show-template-script")
+	assert.Equal("", paths["id"], "--id refers to a template script, which can't be listed")
+
+	paths, _ = runResolving(t, []string{"storage", "volumes"}, "attach")
+	assert.Equal("storage volumes", paths["id"], "--id should refer to volumes")
+	assert.Equal("cloud servers", paths["server-id"], "--server-id should refer to servers")
+}
+
+func TestResolveIDsLeavesUnknownFlags(t *testing.T) {
+	assert := assert.New(t)
+
+	// no listing is requested, as the flag is not resolved
+	_, values := runResolving(t, []string{"network", "load-balancers"}, "show-plan", "--id", "small-plan")
+	assert.Equal("small-plan", values["id"], "Flags not referring to resources which can be listed should be kept")
+
+	_, values = runResolving(t, []string{"network", "load-balancers"}, "show", "--id", "5b5074d8f1e4fc0a3da5c80d")
+	assert.Equal("5b5074d8f1e4fc0a3da5c80d", values["id"], "IDs should not be looked up")
+}
@@ -3,6 +3,7 @@
 package cmd
 
 import (
+	"context"
 	"time"
 
 	"github.com/ingrammicro/cio/api/clientbrownfield"
@@ -42,23 +43,30 @@ func checkCloudAccountImportingState(
 	debugCmdFuncInfo(c)
 	cloudAccountSvc, formatter := WireUpBrownfieldCloudAccount(c)
 
+	ctx, cancel := cmdContext()
+	defer cancel()
+
 	log.Info("Brownfield cloud account ID... ", cloudAccount.ID)
 	log.Info("Checking importing process... ")
-	for {
-		ca, err := cloudAccountSvc.GetBrownfieldCloudAccount(c.String("id"))
+	var ca *types.CloudAccount
+	err := utils.FixedPoller(5*time.Second).Poll(ctx, func(ctx context.Context) (bool, error) {
+		var err error
+		ca, err = cloudAccountSvc.GetBrownfieldCloudAccountContext(ctx, c.String("id"))
 		if err != nil {
 			formatter.PrintFatal("Couldn't get cloud account data", err)
 		}
-		if (cloudAccount.State != ca.State) || (ca.State != state) {
-			if ca.State == "idle" && ca.ErrorEventID != "" {
-				log.Error("Error while importing: ", ca.ErrorEventID)
-			} else {
-				log.Info("Done!")
-			}
-			return ca
-		}
-		time.Sleep(5 * time.Second)
+		return (cloudAccount.State != ca.State) || (ca.State != state), nil
+	})
+	if err != nil {
+		formatter.PrintFatal("Interrupted while checking importing process", err)
+	}
+
+	if ca.State == "idle" && ca.ErrorEventID != "" {
+		log.Error("Error while importing: ", ca.ErrorEventID)
+	} else {
+		log.Info("Done!")
 	}
+	return ca
 }
 
 // ImportServers subcommand function
@@ -39,7 +39,9 @@ func ClusterList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	clusterSvc, formatter := WireUpCluster(c)
 
-	clusters, err := clusterSvc.ListClusters()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	clusters, err := clusterSvc.ListClustersContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive cluster data", err)
 	}
@@ -127,6 +129,9 @@ func ClusterCreate(c *cli.Context) error {
 	if err != nil {
 		formatter.PrintFatal("Couldn't create cluster", err)
 	}
+	if r := waitIfRequested(c, cluster.ID, false, formatter); r != nil {
+		cluster = r.(*types.Cluster)
+	}
 
 	cluster.FillInLabelNames(labelNamesByID)
 	if err = formatter.PrintItem(*cluster); err != nil {
@@ -176,6 +181,7 @@ func ClusterDelete(c *cli.Context) error {
 	if err != nil {
 		formatter.PrintFatal("Couldn't delete cluster", err)
 	}
+	waitIfRequested(c, c.String("id"), true, formatter)
 
 	_, labelNamesByID := LabelLoadsMapping(c)
 	cluster.FillInLabelNames(labelNamesByID)
@@ -4,6 +4,7 @@ package cmd
 
 import (
 	"github.com/ingrammicro/cio/api/kubernetes"
+	"github.com/ingrammicro/cio/api/types"
 	"github.com/ingrammicro/cio/utils"
 	"github.com/ingrammicro/cio/utils/format"
 	"github.com/urfave/cli"
@@ -36,7 +37,9 @@ func NodePoolList(c *cli.Context) error {
 	nodePoolSvc, formatter := WireUpNodePool(c)
 
 	checkRequiredFlags(c, []string{"id"}, formatter)
-	nodePools, err := nodePoolSvc.ListNodePools(c.String("id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	nodePools, err := nodePoolSvc.ListNodePoolsContext(ctx, c.String("id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive node pool data", err)
 	}
@@ -101,6 +104,9 @@ func NodePoolCreate(c *cli.Context) error {
 	if err != nil {
 		formatter.PrintFatal("Couldn't create node pool", err)
 	}
+	if r := waitIfRequested(c, nodePool.ID, false, formatter); r != nil {
+		nodePool = r.(*types.NodePool)
+	}
 
 	if err = formatter.PrintItem(*nodePool); err != nil {
 		formatter.PrintFatal(PrintFormatError, err)
@@ -150,6 +156,7 @@ func NodePoolDelete(c *cli.Context) error {
 	if err != nil {
 		formatter.PrintFatal("Couldn't delete node pool", err)
 	}
+	waitIfRequested(c, c.String("id"), true, formatter)
 
 	if err = formatter.PrintItem(*nodePool); err != nil {
 		formatter.PrintFatal(PrintFormatError, err)
@@ -40,7 +40,9 @@ func LabelList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 
 	labelsSvc, formatter := WireUpLabel(c)
-	labels, err := labelsSvc.ListLabels()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	labels, err := labelsSvc.ListLabelsContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive labels data", err)
 	}
@@ -68,7 +70,7 @@ func LabelCreate(c *cli.Context) error {
 }
 
 // LabelFiltering subcommand function receives a collection of references to labelable objects
-// Evaluates the matching of assigned labels with the labels requested for filtering.
+// Evaluates the matching of assigned labels with the labels requested for filtering, keeping no more than --limit items.
 func LabelFiltering(c *cli.Context, items []types.Labelable, labelIDsByName map[string]string) []types.Labelable {
 	debugCmdFuncInfo(c)
 
@@ -86,6 +88,9 @@ func LabelFiltering(c *cli.Context, items []types.Labelable, labelIDsByName map[
 				result = append(result, item)
 			}
 		}
+		if limit := c.Int("limit"); limit > 0 && len(result) > limit {
+			result = result[:limit]
+		}
 		return result
 	}
 
@@ -38,7 +38,9 @@ func ListenerList(c *cli.Context) error {
 	svc, formatter := WireUpListener(c)
 
 	checkRequiredFlags(c, []string{"load-balancer-id"}, formatter)
-	listeners, err := svc.ListListeners(c.String("load-balancer-id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	listeners, err := svc.ListListenersContext(ctx, c.String("load-balancer-id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive load balancer listeners data", err)
 	}
@@ -150,7 +152,9 @@ func ListenerListRules(c *cli.Context) error {
 	svc, formatter := WireUpListener(c)
 
 	checkRequiredFlags(c, []string{"listener-id"}, formatter)
-	rules, err := svc.ListRules(c.String("listener-id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	rules, err := svc.ListRulesContext(ctx, c.String("listener-id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive listener rules data", err)
 	}
@@ -38,7 +38,9 @@ func LoadBalancerList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	svc, formatter := WireUpLoadBalancer(c)
 
-	loadBalancers, err := svc.ListLoadBalancers()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	loadBalancers, err := svc.ListLoadBalancersContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive load balancers data", err)
 	}
@@ -106,6 +108,9 @@ func LoadBalancerCreate(c *cli.Context) error {
 	if err != nil {
 		formatter.PrintFatal("Couldn't create load balancer", err)
 	}
+	if r := waitIfRequested(c, loadBalancer.ID, false, formatter); r != nil {
+		loadBalancer = r.(*types.LoadBalancer)
+	}
 
 	loadBalancer.FillInLabelNames(labelNamesByID)
 	if err = formatter.PrintItem(*loadBalancer); err != nil {
@@ -147,6 +152,7 @@ func LoadBalancerDelete(c *cli.Context) error {
 	if err != nil {
 		formatter.PrintFatal("Couldn't delete load balancer", err)
 	}
+	waitIfRequested(c, c.String("id"), true, formatter)
 
 	_, labelNamesByID := LabelLoadsMapping(c)
 	loadBalancer.FillInLabelNames(labelNamesByID)
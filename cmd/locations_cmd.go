@@ -35,7 +35,9 @@ func LocationList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	locationSvc, formatter := WireUpLocation(c)
 
-	locations, err := locationSvc.ListLocations()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	locations, err := locationSvc.ListLocationsContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive location data", err)
 	}
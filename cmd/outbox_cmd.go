@@ -0,0 +1,25 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package cmd
+
+import (
+	"github.com/ingrammicro/cio/utils"
+	"github.com/ingrammicro/cio/utils/format"
+	"github.com/urfave/cli"
+)
+
+// WireUpOutbox prepares the outbox of the reports sent to Concerto API
+func WireUpOutbox(c *cli.Context) (o *utils.Outbox) {
+
+	formatter := format.GetFormatter()
+	config, err := utils.GetConcertoConfig()
+	if err != nil {
+		formatter.PrintFatal("Couldn't wire up config", err)
+	}
+	hcs, err := utils.NewHTTPConcertoService(config)
+	if err != nil {
+		formatter.PrintFatal("Couldn't wire up concerto service", err)
+	}
+
+	return utils.NewOutbox(utils.OutboxFile(config), hcs)
+}
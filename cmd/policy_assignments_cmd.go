@@ -38,7 +38,9 @@ func PolicyAssignmentList(c *cli.Context) error {
 	svc, formatter := WireUpPolicyAssignment(c)
 
 	checkRequiredFlags(c, []string{"cloud-account-id"}, formatter)
-	assignments, err := svc.ListAssignments(c.String("cloud-account-id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	assignments, err := svc.ListAssignmentsContext(ctx, c.String("cloud-account-id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive policy assignments data", err)
 	}
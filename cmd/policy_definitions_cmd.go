@@ -37,7 +37,9 @@ func PolicyDefinitionList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	svc, formatter := WireUpPolicyDefinition(c)
 
-	definitions, err := svc.ListDefinitions()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	definitions, err := svc.ListDefinitionsContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive policy definitions data", err)
 	}
@@ -146,7 +148,9 @@ func PolicyDefinitionListAssignments(c *cli.Context) error {
 	svc, formatter := WireUpPolicyDefinition(c)
 	checkRequiredFlags(c, []string{"id"}, formatter)
 
-	assignments, err := svc.ListAssignments(c.String("id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	assignments, err := svc.ListAssignmentsContext(ctx, c.String("id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive policy assignments data", err)
 	}
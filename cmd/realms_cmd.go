@@ -36,7 +36,9 @@ func RealmList(c *cli.Context) error {
 	svc, formatter := WireUpRealm(c)
 
 	checkRequiredFlags(c, []string{"id"}, formatter)
-	realms, err := svc.ListRealms(c.String("id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	realms, err := svc.ListRealmsContext(ctx, c.String("id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive realms data", err)
 	}
@@ -70,7 +72,9 @@ func RealmNodePoolPlansList(c *cli.Context) error {
 	svc, formatter := WireUpRealm(c)
 
 	checkRequiredFlags(c, []string{"id"}, formatter)
-	realms, err := svc.ListRealmNodePoolPlans(c.String("id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	realms, err := svc.ListRealmNodePoolPlansContext(ctx, c.String("id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive realms data", err)
 	}
@@ -39,7 +39,9 @@ func ScriptsList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	scriptSvc, formatter := WireUpScript(c)
 
-	scripts, err := scriptSvc.ListScripts()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	scripts, err := scriptSvc.ListScriptsContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive script data", err)
 	}
@@ -94,22 +96,22 @@ func ScriptCreate(c *cli.Context) error {
 	scriptSvc, formatter := WireUpScript(c)
 
 	checkRequiredFlags(c, []string{"name", "description", "code"}, formatter)
-	scriptIn := map[string]interface{}{
-		"name":        c.String("name"),
-		"description": c.String("description"),
-		"code":        c.String("code"),
+	scriptIn := &types.ScriptCreateInput{
+		Name:        c.String("name"),
+		Description: c.String("description"),
+		Code:        c.String("code"),
 	}
 	if c.String("parameters") != "" {
-		scriptIn["parameters"] = strings.Split(c.String("parameters"), ",")
+		scriptIn.Parameters = strings.Split(c.String("parameters"), ",")
 	}
 
 	labelIDsByName, labelNamesByID := LabelLoadsMapping(c)
 
 	if c.IsSet("labels") {
-		scriptIn["label_ids"] = LabelResolution(c, c.String("labels"), &labelNamesByID, &labelIDsByName)
+		scriptIn.LabelIDs = LabelResolution(c, c.String("labels"), &labelNamesByID, &labelIDsByName)
 	}
 
-	script, err := scriptSvc.CreateScript(&scriptIn)
+	script, err := scriptSvc.CreateScriptWithInput(scriptIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't create script", err)
 	}
@@ -127,21 +129,16 @@ func ScriptUpdate(c *cli.Context) error {
 	scriptSvc, formatter := WireUpScript(c)
 
 	checkRequiredFlags(c, []string{"id"}, formatter)
-	scriptIn := map[string]interface{}{}
-	if c.String("name") != "" {
-		scriptIn["name"] = c.String("name")
-	}
-	if c.String("description") != "" {
-		scriptIn["description"] = c.String("description")
-	}
-	if c.String("code") != "" {
-		scriptIn["code"] = c.String("code")
+	scriptIn := &types.ScriptUpdateInput{
+		Name:        c.String("name"),
+		Description: c.String("description"),
+		Code:        c.String("code"),
 	}
 	if c.String("parameters") != "" {
-		scriptIn["parameters"] = strings.Split(c.String("parameters"), ",")
+		scriptIn.Parameters = strings.Split(c.String("parameters"), ",")
 	}
 
-	script, err := scriptSvc.UpdateScript(c.String("id"), &scriptIn)
+	script, err := scriptSvc.UpdateScriptWithInput(c.String("id"), scriptIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't update script", err)
 	}
@@ -224,7 +221,9 @@ func ScriptAttachmentList(c *cli.Context) error {
 	scriptSvc, formatter := WireUpScript(c)
 
 	checkRequiredFlags(c, []string{"id"}, formatter)
-	attachments, err := scriptSvc.ListScriptAttachments(c.String("id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	attachments, err := scriptSvc.ListScriptAttachmentsContext(ctx, c.String("id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't list attachments script", err)
 	}
@@ -38,7 +38,9 @@ func ServerArrayList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	serverArraySvc, formatter := WireUpServerArray(c)
 
-	serverArrays, err := serverArraySvc.ListServerArrays()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	serverArrays, err := serverArraySvc.ListServerArraysContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive server array data", err)
 	}
@@ -236,7 +238,9 @@ func ServerArrayServerList(c *cli.Context) error {
 	serverArraySvc, formatter := WireUpServerArray(c)
 
 	checkRequiredFlags(c, []string{"id"}, formatter)
-	servers, err := serverArraySvc.ListServerArrayServers(c.String("id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	servers, err := serverArraySvc.ListServerArrayServersContext(ctx, c.String("id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive server data", err)
 	}
@@ -36,7 +36,9 @@ func ServerPlanList(c *cli.Context) error {
 	serverPlanSvc, formatter := WireUpServerPlan(c)
 
 	checkRequiredFlags(c, []string{"cloud-provider-id", "realm-id"}, formatter)
-	serverPlans, err := serverPlanSvc.ListServerPlans(c.String("cloud-provider-id"), c.String("realm-id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	serverPlans, err := serverPlanSvc.ListServerPlansContext(ctx, c.String("cloud-provider-id"), c.String("realm-id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive serverPlan data", err)
 	}
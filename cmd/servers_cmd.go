@@ -38,7 +38,9 @@ func ServerList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	serverSvc, formatter := WireUpServer(c)
 
-	servers, err := serverSvc.ListServers()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	servers, err := serverSvc.ListServersContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive server data", err)
 	}
@@ -95,25 +97,28 @@ func ServerCreate(c *cli.Context) error {
 		[]string{"name", "ssh-profile-id", "firewall-profile-id", "template-id", "server-plan-id", "cloud-account-id"},
 		formatter,
 	)
-	serverIn := map[string]interface{}{
-		"name":                c.String("name"),
-		"ssh_profile_id":      c.String("ssh-profile-id"),
-		"firewall_profile_id": c.String("firewall-profile-id"),
-		"template_id":         c.String("template-id"),
-		"server_plan_id":      c.String("server-plan-id"),
-		"cloud_account_id":    c.String("cloud-account-id"),
+	serverIn := &types.ServerCreateInput{
+		Name:              c.String("name"),
+		SSHProfileID:      c.String("ssh-profile-id"),
+		FirewallProfileID: c.String("firewall-profile-id"),
+		TemplateID:        c.String("template-id"),
+		ServerPlanID:      c.String("server-plan-id"),
+		CloudAccountID:    c.String("cloud-account-id"),
 	}
 
 	labelIDsByName, labelNamesByID := LabelLoadsMapping(c)
 
 	if c.IsSet("labels") {
-		serverIn["label_ids"] = LabelResolution(c, c.String("labels"), &labelNamesByID, &labelIDsByName)
+		serverIn.LabelIDs = LabelResolution(c, c.String("labels"), &labelNamesByID, &labelIDsByName)
 	}
 
-	server, err := serverSvc.CreateServer(&serverIn)
+	server, err := serverSvc.CreateServerWithInput(serverIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't create server", err)
 	}
+	if r := waitIfRequested(c, server.ID, false, formatter); r != nil {
+		server = r.(*types.Server)
+	}
 
 	server.FillInLabelNames(labelNamesByID)
 	if err = formatter.PrintItem(*server); err != nil {
@@ -128,7 +133,7 @@ func ServerUpdate(c *cli.Context) error {
 	serverSvc, formatter := WireUpServer(c)
 
 	checkRequiredFlags(c, []string{"id"}, formatter)
-	server, err := serverSvc.UpdateServer(c.String("id"), utils.FlagConvertParams(c))
+	server, err := serverSvc.UpdateServerWithInput(c.String("id"), &types.ServerUpdateInput{Name: c.String("name")})
 	if err != nil {
 		formatter.PrintFatal("Couldn't update server", err)
 	}
@@ -151,6 +156,9 @@ func ServerBoot(c *cli.Context) error {
 	if err != nil {
 		formatter.PrintFatal("Couldn't boot server", err)
 	}
+	if r := waitIfRequested(c, server.ID, false, formatter); r != nil {
+		server = r.(*types.Server)
+	}
 
 	_, labelNamesByID := LabelLoadsMapping(c)
 	server.FillInLabelNames(labelNamesByID)
@@ -189,6 +197,9 @@ func ServerShutdown(c *cli.Context) error {
 	if err != nil {
 		formatter.PrintFatal("Couldn't shutdown server", err)
 	}
+	if r := waitIfRequested(c, server.ID, false, formatter); r != nil {
+		server = r.(*types.Server)
+	}
 
 	_, labelNamesByID := LabelLoadsMapping(c)
 	server.FillInLabelNames(labelNamesByID)
@@ -227,6 +238,7 @@ func ServerDelete(c *cli.Context) error {
 	if err != nil {
 		formatter.PrintFatal("Couldn't delete server", err)
 	}
+	waitIfRequested(c, c.String("id"), true, formatter)
 	return nil
 }
 
@@ -236,7 +248,9 @@ func ServerFloatingIPList(c *cli.Context) error {
 	serverSvc, formatter := WireUpServer(c)
 
 	checkRequiredFlags(c, []string{"id"}, formatter)
-	floatingIPs, err := serverSvc.ListServerFloatingIPs(c.String("id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	floatingIPs, err := serverSvc.ListServerFloatingIPsContext(ctx, c.String("id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive floating IPs data", err)
 	}
@@ -270,7 +284,9 @@ func ServerVolumesList(c *cli.Context) error {
 	serverSvc, formatter := WireUpServer(c)
 
 	checkRequiredFlags(c, []string{"id"}, formatter)
-	volumes, err := serverSvc.ListServerVolumes(c.String("id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	volumes, err := serverSvc.ListServerVolumesContext(ctx, c.String("id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive volumes data", err)
 	}
@@ -306,7 +322,9 @@ func EventsList(c *cli.Context) error {
 	svc, formatter := WireUpServer(c)
 
 	checkRequiredFlags(c, []string{"id"}, formatter)
-	events, err := svc.ListEvents(c.String("id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	events, err := svc.ListEventsContext(ctx, c.String("id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive event data", err)
 	}
@@ -324,7 +342,9 @@ func OperationalScriptsList(c *cli.Context) error {
 	svc, formatter := WireUpServer(c)
 
 	checkRequiredFlags(c, []string{"id"}, formatter)
-	scripts, err := svc.ListOperationalScripts(c.String("id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	scripts, err := svc.ListOperationalScriptsContext(ctx, c.String("id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive script data", err)
 	}
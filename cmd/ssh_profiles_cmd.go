@@ -38,7 +38,9 @@ func SSHProfileList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	sshProfileSvc, formatter := WireUpSSHProfile(c)
 
-	sshProfiles, err := sshProfileSvc.ListSSHProfiles()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	sshProfiles, err := sshProfileSvc.ListSSHProfilesContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive sshProfile data", err)
 	}
@@ -90,21 +92,19 @@ func SSHProfileCreate(c *cli.Context) error {
 	sshProfileSvc, formatter := WireUpSSHProfile(c)
 
 	checkRequiredFlags(c, []string{"name", "public-key"}, formatter)
-	sshProfileIn := map[string]interface{}{
-		"name":       c.String("name"),
-		"public_key": c.String("public-key"),
-	}
-	if c.String("private-key") != "" {
-		sshProfileIn["private_key"] = c.String("private-key")
+	sshProfileIn := &types.SSHProfileCreateInput{
+		Name:       c.String("name"),
+		PublicKey:  c.String("public-key"),
+		PrivateKey: c.String("private-key"),
 	}
 
 	labelIDsByName, labelNamesByID := LabelLoadsMapping(c)
 
 	if c.IsSet("labels") {
-		sshProfileIn["label_ids"] = LabelResolution(c, c.String("labels"), &labelNamesByID, &labelIDsByName)
+		sshProfileIn.LabelIDs = LabelResolution(c, c.String("labels"), &labelNamesByID, &labelIDsByName)
 	}
 
-	sshProfile, err := sshProfileSvc.CreateSSHProfile(&sshProfileIn)
+	sshProfile, err := sshProfileSvc.CreateSSHProfileWithInput(sshProfileIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't create sshProfile", err)
 	}
@@ -122,7 +122,12 @@ func SSHProfileUpdate(c *cli.Context) error {
 	sshProfileSvc, formatter := WireUpSSHProfile(c)
 
 	checkRequiredFlags(c, []string{"id"}, formatter)
-	sshProfile, err := sshProfileSvc.UpdateSSHProfile(c.String("id"), utils.FlagConvertParams(c))
+	sshProfileIn := &types.SSHProfileUpdateInput{
+		Name:       c.String("name"),
+		PublicKey:  c.String("public-key"),
+		PrivateKey: c.String("private-key"),
+	}
+	sshProfile, err := sshProfileSvc.UpdateSSHProfileWithInput(c.String("id"), sshProfileIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't update sshProfile", err)
 	}
@@ -4,6 +4,7 @@ package cmd
 
 import (
 	"github.com/ingrammicro/cio/api/network"
+	"github.com/ingrammicro/cio/api/types"
 	"github.com/ingrammicro/cio/utils"
 	"github.com/ingrammicro/cio/utils/format"
 	"github.com/urfave/cli"
@@ -36,7 +37,9 @@ func SubnetList(c *cli.Context) error {
 	subnetSvc, formatter := WireUpSubnet(c)
 
 	checkRequiredFlags(c, []string{"vpc-id"}, formatter)
-	subnets, err := subnetSvc.ListSubnets(c.String("vpc-id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	subnets, err := subnetSvc.ListSubnetsContext(ctx, c.String("vpc-id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive Subnet data", err)
 	}
@@ -71,14 +74,14 @@ func SubnetCreate(c *cli.Context) error {
 
 	checkRequiredFlags(c, []string{"vpc-id", "name", "cidr", "type"}, formatter)
 
-	subnetIn := map[string]interface{}{
-		"name":             c.String("name"),
-		"cidr":             c.String("cidr"),
-		"cloud_account_id": c.String("cloud-account-id"),
-		"type":             c.String("type"),
+	subnetIn := &types.SubnetCreateInput{
+		Name:           c.String("name"),
+		CIDR:           c.String("cidr"),
+		CloudAccountID: c.String("cloud-account-id"),
+		Type:           c.String("type"),
 	}
 
-	subnet, err := subnetSvc.CreateSubnet(c.String("vpc-id"), &subnetIn)
+	subnet, err := subnetSvc.CreateSubnetWithInput(c.String("vpc-id"), subnetIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't create Subnet", err)
 	}
@@ -96,11 +99,11 @@ func SubnetUpdate(c *cli.Context) error {
 
 	checkRequiredFlags(c, []string{"id", "name"}, formatter)
 
-	subnetIn := map[string]interface{}{
-		"name": c.String("name"),
+	subnetIn := &types.SubnetUpdateInput{
+		Name: c.String("name"),
 	}
 
-	subnet, err := subnetSvc.UpdateSubnet(c.String("id"), &subnetIn)
+	subnet, err := subnetSvc.UpdateSubnetWithInput(c.String("id"), subnetIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't update Subnet", err)
 	}
@@ -130,7 +133,9 @@ func SubnetServerList(c *cli.Context) error {
 	subnetSvc, formatter := WireUpSubnet(c)
 
 	checkRequiredFlags(c, []string{"id"}, formatter)
-	servers, err := subnetSvc.ListSubnetServers(c.String("id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	servers, err := subnetSvc.ListSubnetServersContext(ctx, c.String("id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive servers data", err)
 	}
@@ -147,7 +152,9 @@ func SubnetServerArrayList(c *cli.Context) error {
 	subnetSvc, formatter := WireUpSubnet(c)
 
 	checkRequiredFlags(c, []string{"id"}, formatter)
-	serverArrays, err := subnetSvc.ListSubnetServerArrays(c.String("id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	serverArrays, err := subnetSvc.ListSubnetServerArraysContext(ctx, c.String("id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive server arrays data", err)
 	}
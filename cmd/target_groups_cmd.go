@@ -36,7 +36,9 @@ func TargetGroupList(c *cli.Context) error {
 	svc, formatter := WireUpTargetGroup(c)
 
 	checkRequiredFlags(c, []string{"load-balancer-id"}, formatter)
-	targetGroups, err := svc.ListTargetGroups(c.String("load-balancer-id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	targetGroups, err := svc.ListTargetGroupsContext(ctx, c.String("load-balancer-id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive load balancer target groups data", err)
 	}
@@ -194,7 +196,9 @@ func TargetGroupListTargets(c *cli.Context) error {
 	svc, formatter := WireUpTargetGroup(c)
 
 	checkRequiredFlags(c, []string{"target-group-id"}, formatter)
-	targets, err := svc.ListTargets(c.String("target-group-id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	targets, err := svc.ListTargetsContext(ctx, c.String("target-group-id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive targets data", err)
 	}
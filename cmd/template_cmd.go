@@ -45,7 +45,9 @@ func TemplateList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	templateSvc, formatter := WireUpTemplate(c)
 
-	templates, err := templateSvc.ListTemplates()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	templates, err := templateSvc.ListTemplatesContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive template data", err)
 	}
@@ -248,7 +250,9 @@ func TemplateScriptList(c *cli.Context) error {
 	templateScriptSvc, formatter := WireUpTemplate(c)
 
 	checkRequiredFlags(c, []string{"template-id", "type"}, formatter)
-	templateScripts, err := templateScriptSvc.ListTemplateScripts(c.String("template-id"), c.String("type"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	templateScripts, err := templateScriptSvc.ListTemplateScriptsContext(ctx, c.String("template-id"), c.String("type"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive templateScript data", err)
 	}
@@ -402,7 +406,9 @@ func TemplateServersList(c *cli.Context) error {
 	templateSvc, formatter := WireUpTemplate(c)
 
 	checkRequiredFlags(c, []string{"template-id"}, formatter)
-	templateServers, err := templateSvc.ListTemplateServers(c.String("template-id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	templateServers, err := templateSvc.ListTemplateServersContext(ctx, c.String("template-id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive template servers data", err)
 	}
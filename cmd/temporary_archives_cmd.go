@@ -3,6 +3,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -100,7 +101,10 @@ func TemporaryArchiveExport(c *cli.Context) error {
 		return err
 	}
 
-	temporaryArchiveExport, err := svc.CreateTemporaryArchiveExport(&temporaryArchiveIn)
+	ctx, cancel := cmdContext()
+	defer cancel()
+
+	temporaryArchiveExport, err := svc.CreateTemporaryArchiveExportContext(ctx, &temporaryArchiveIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't create temporary archive", err)
 	}
@@ -109,26 +113,27 @@ func TemporaryArchiveExport(c *cli.Context) error {
 	log.Info("Task ID: ", temporaryArchiveExport.TaskID)
 	log.Info("Exporting...")
 	temporaryArchiveExportTask := new(types.TemporaryArchiveExportTask)
-	for {
-		temporaryArchiveExportTask, err = svc.GetTemporaryArchiveExportTask(temporaryArchiveExport.ID)
+	poller := utils.FixedPoller(time.Duration(timeLapseExportStatusCheck) * time.Second)
+	err = poller.Poll(ctx, func(ctx context.Context) (bool, error) {
+		temporaryArchiveExportTask, err = svc.GetTemporaryArchiveExportTaskContext(ctx, temporaryArchiveExport.ID)
 		if err != nil {
 			formatter.PrintFatal("Couldn't get temporary archive", err)
 		}
 		log.Info("State: ", temporaryArchiveExportTask.State)
+		return temporaryArchiveExportTask.State == "finished", nil
+	})
+	if err != nil {
+		formatter.PrintFatal("Interrupted while exporting temporary archive", err)
+	}
 
-		if temporaryArchiveExportTask.State == "finished" {
-			if err = formatter.PrintItem(*temporaryArchiveExportTask); err != nil {
-				formatter.PrintFatal(PrintFormatError, err)
-			}
-			if temporaryArchiveExportTask.ErrorMessage != "" {
-				formatter.PrintFatal(
-					"Couldn't export infrastructure file",
-					fmt.Errorf("%s", temporaryArchiveExportTask.ErrorMessage),
-				)
-			}
-			break
-		}
-		time.Sleep(time.Duration(timeLapseExportStatusCheck) * time.Second)
+	if err = formatter.PrintItem(*temporaryArchiveExportTask); err != nil {
+		formatter.PrintFatal(PrintFormatError, err)
+	}
+	if temporaryArchiveExportTask.ErrorMessage != "" {
+		formatter.PrintFatal(
+			"Couldn't export infrastructure file",
+			fmt.Errorf("%s", temporaryArchiveExportTask.ErrorMessage),
+		)
 	}
 	return downloadTemporaryArchive(c, svc, config, temporaryArchiveExportTask)
 }
@@ -161,17 +166,20 @@ func TemporaryArchiveImport(c *cli.Context) error {
 
 	timeLapseImportStatusCheck := getTimeLapseImportStatusCheckParam(c)
 
-	temporaryArchive, err := svc.CreateTemporaryArchive(&temporaryArchiveIn)
+	ctx, cancel := cmdContext()
+	defer cancel()
+
+	temporaryArchive, err := svc.CreateTemporaryArchiveContext(ctx, &temporaryArchiveIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't create temporary archive", err)
 	}
 
-	err = svc.UploadTemporaryArchive(sourceFilePath, temporaryArchive.UploadURL)
+	err = svc.UploadTemporaryArchiveContext(ctx, sourceFilePath, temporaryArchive.UploadURL)
 	if err != nil {
 		formatter.PrintFatal("Couldn't upload temporary archive", err)
 	}
 
-	temporaryArchiveImport, err := svc.CreateTemporaryArchiveImport(temporaryArchive.ID, &temporaryArchiveIn)
+	temporaryArchiveImport, err := svc.CreateTemporaryArchiveImportContext(ctx, temporaryArchive.ID, &temporaryArchiveIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't create temporary archive import", err)
 	}
@@ -180,26 +188,27 @@ func TemporaryArchiveImport(c *cli.Context) error {
 	log.Info("Archive ID: ", temporaryArchiveImport.ArchiveID)
 	log.Info("Label: ", temporaryArchiveImport.LabelName)
 	log.Info("Importing...")
-	for {
-		temporaryArchiveImport, err = svc.GetTemporaryArchiveImport(temporaryArchive.ID)
+	poller := utils.FixedPoller(time.Duration(timeLapseImportStatusCheck) * time.Second)
+	err = poller.Poll(ctx, func(ctx context.Context) (bool, error) {
+		temporaryArchiveImport, err = svc.GetTemporaryArchiveImportContext(ctx, temporaryArchive.ID)
 		if err != nil {
 			formatter.PrintFatal("Couldn't get temporary archive import", err)
 		}
 		log.Info("State: ", temporaryArchiveImport.State)
+		return temporaryArchiveImport.State == "finished", nil
+	})
+	if err != nil {
+		formatter.PrintFatal("Interrupted while importing temporary archive", err)
+	}
 
-		if temporaryArchiveImport.State == "finished" {
-			if err = formatter.PrintItem(*temporaryArchiveImport); err != nil {
-				formatter.PrintFatal(PrintFormatError, err)
-			}
-			if temporaryArchiveImport.ErrorMessage != "" {
-				formatter.PrintFatal(
-					"Couldn't import infrastructure file",
-					fmt.Errorf("%s", temporaryArchiveImport.ErrorMessage),
-				)
-			}
-			break
-		}
-		time.Sleep(time.Duration(timeLapseImportStatusCheck) * time.Second)
+	if err = formatter.PrintItem(*temporaryArchiveImport); err != nil {
+		formatter.PrintFatal(PrintFormatError, err)
+	}
+	if temporaryArchiveImport.ErrorMessage != "" {
+		formatter.PrintFatal(
+			"Couldn't import infrastructure file",
+			fmt.Errorf("%s", temporaryArchiveImport.ErrorMessage),
+		)
 	}
 	return nil
 }
@@ -38,7 +38,9 @@ func VolumeList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	volumeSvc, formatter := WireUpVolume(c)
 
-	volumes, err := volumeSvc.ListVolumes(c.String("server-id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	volumes, err := volumeSvc.ListVolumesContext(ctx, c.String("server-id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive volume data", err)
 	}
@@ -91,23 +93,26 @@ func VolumeCreate(c *cli.Context) error {
 
 	checkRequiredFlags(c, []string{"name", "size", "cloud-account-id", "storage-plan-id"}, formatter)
 
-	volumeIn := map[string]interface{}{
-		"name":             c.String("name"),
-		"size":             c.Int("size"),
-		"cloud_account_id": c.String("cloud-account-id"),
-		"storage_plan_id":  c.String("storage-plan-id"),
+	volumeIn := &types.VolumeCreateInput{
+		Name:           c.String("name"),
+		Size:           c.Int("size"),
+		CloudAccountID: c.String("cloud-account-id"),
+		StoragePlanID:  c.String("storage-plan-id"),
 	}
 
 	labelIDsByName, labelNamesByID := LabelLoadsMapping(c)
 
 	if c.IsSet("labels") {
-		volumeIn["label_ids"] = LabelResolution(c, c.String("labels"), &labelNamesByID, &labelIDsByName)
+		volumeIn.LabelIDs = LabelResolution(c, c.String("labels"), &labelNamesByID, &labelIDsByName)
 	}
 
-	volume, err := volumeSvc.CreateVolume(&volumeIn)
+	volume, err := volumeSvc.CreateVolumeWithInput(volumeIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't create volume", err)
 	}
+	if r := waitIfRequested(c, volume.ID, false, formatter); r != nil {
+		volume = r.(*types.Volume)
+	}
 
 	volume.FillInLabelNames(labelNamesByID)
 	if err = formatter.PrintItem(*volume); err != nil {
@@ -123,11 +128,11 @@ func VolumeUpdate(c *cli.Context) error {
 
 	checkRequiredFlags(c, []string{"id", "name"}, formatter)
 
-	volumeIn := map[string]interface{}{
-		"name": c.String("name"),
+	volumeIn := &types.VolumeUpdateInput{
+		Name: c.String("name"),
 	}
 
-	volume, err := volumeSvc.UpdateVolume(c.String("id"), &volumeIn)
+	volume, err := volumeSvc.UpdateVolumeWithInput(c.String("id"), volumeIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't update volume", err)
 	}
@@ -187,6 +192,7 @@ func VolumeDelete(c *cli.Context) error {
 	if err != nil {
 		formatter.PrintFatal("Couldn't delete volume", err)
 	}
+	waitIfRequested(c, c.String("id"), true, formatter)
 	return nil
 }
 
@@ -38,7 +38,9 @@ func VPCList(c *cli.Context) error {
 	debugCmdFuncInfo(c)
 	vpcSvc, formatter := WireUpVPC(c)
 
-	vpcs, err := vpcSvc.ListVPCs()
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	vpcs, err := vpcSvc.ListVPCsContext(ctx)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive VPC data", err)
 	}
@@ -91,23 +93,26 @@ func VPCCreate(c *cli.Context) error {
 
 	checkRequiredFlags(c, []string{"name", "cidr", "cloud-account-id", "realm-provider-name"}, formatter)
 
-	vpcIn := map[string]interface{}{
-		"name":                c.String("name"),
-		"cidr":                c.String("cidr"),
-		"cloud_account_id":    c.String("cloud-account-id"),
-		"realm_provider_name": c.String("realm-provider-name"),
+	vpcIn := &types.VPCCreateInput{
+		Name:              c.String("name"),
+		CIDR:              c.String("cidr"),
+		CloudAccountID:    c.String("cloud-account-id"),
+		RealmProviderName: c.String("realm-provider-name"),
 	}
 
 	labelIDsByName, labelNamesByID := LabelLoadsMapping(c)
 
 	if c.IsSet("labels") {
-		vpcIn["label_ids"] = LabelResolution(c, c.String("labels"), &labelNamesByID, &labelIDsByName)
+		vpcIn.LabelIDs = LabelResolution(c, c.String("labels"), &labelNamesByID, &labelIDsByName)
 	}
 
-	vpc, err := vpcSvc.CreateVPC(&vpcIn)
+	vpc, err := vpcSvc.CreateVPCWithInput(vpcIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't create VPC", err)
 	}
+	if r := waitIfRequested(c, vpc.ID, false, formatter); r != nil {
+		vpc = r.(*types.Vpc)
+	}
 
 	vpc.FillInLabelNames(labelNamesByID)
 	if err = formatter.PrintItem(*vpc); err != nil {
@@ -123,11 +128,11 @@ func VPCUpdate(c *cli.Context) error {
 
 	checkRequiredFlags(c, []string{"id", "name"}, formatter)
 
-	vpcIn := map[string]interface{}{
-		"name": c.String("name"),
+	vpcIn := &types.VPCUpdateInput{
+		Name: c.String("name"),
 	}
 
-	vpc, err := vpcSvc.UpdateVPC(c.String("id"), &vpcIn)
+	vpc, err := vpcSvc.UpdateVPCWithInput(c.String("id"), vpcIn)
 	if err != nil {
 		formatter.PrintFatal("Couldn't update VPC", err)
 	}
@@ -150,6 +155,7 @@ func VPCDelete(c *cli.Context) error {
 	if err != nil {
 		formatter.PrintFatal("Couldn't delete VPC", err)
 	}
+	waitIfRequested(c, c.String("id"), true, formatter)
 	return nil
 }
 
@@ -92,7 +92,9 @@ func VPNPlanList(c *cli.Context) error {
 	vpcSvc, formatter := WireUpVPN(c)
 	checkRequiredFlags(c, []string{"vpc-id"}, formatter)
 
-	vpns, err := vpcSvc.ListVPNPlans(c.String("vpc-id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	vpns, err := vpcSvc.ListVPNPlansContext(ctx, c.String("vpc-id"))
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive VPN data", err)
 	}
@@ -0,0 +1,331 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ingrammicro/cio/utils"
+	"github.com/ingrammicro/cio/utils/format"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// DefaultWaitTimeout is how long commands wait by default for resources to reach the expected state
+const DefaultWaitTimeout = 15 * time.Minute
+
+// resourceGetter gets a resource whose state is waited for
+type resourceGetter func(ctx context.Context, c *cli.Context, id string) (interface{}, error)
+
+// resourceGetters maps command paths to the retrieval of their resources, which can be waited for
+var resourceGetters = map[string]resourceGetter{
+	"cloud servers": func(ctx context.Context, c *cli.Context, id string) (interface{}, error) {
+		svc, _ := WireUpServer(c)
+		return svc.GetServerContext(ctx, id)
+	},
+	"storage volumes": func(ctx context.Context, c *cli.Context, id string) (interface{}, error) {
+		svc, _ := WireUpVolume(c)
+		return svc.GetVolumeContext(ctx, id)
+	},
+	"network floating-ips": func(ctx context.Context, c *cli.Context, id string) (interface{}, error) {
+		svc, _ := WireUpFloatingIP(c)
+		return svc.GetFloatingIPContext(ctx, id)
+	},
+	"network load-balancers": func(ctx context.Context, c *cli.Context, id string) (interface{}, error) {
+		svc, _ := WireUpLoadBalancer(c)
+		return svc.GetLoadBalancerContext(ctx, id)
+	},
+	"network vpcs": func(ctx context.Context, c *cli.Context, id string) (interface{}, error) {
+		svc, _ := WireUpVPC(c)
+		return svc.GetVPCContext(ctx, id)
+	},
+	"network dns-domains": func(ctx context.Context, c *cli.Context, id string) (interface{}, error) {
+		svc, _ := WireUpDomain(c)
+		return svc.GetDomainContext(ctx, id)
+	},
+	"kubernetes clusters": func(ctx context.Context, c *cli.Context, id string) (interface{}, error) {
+		svc, _ := WireUpCluster(c)
+		return svc.GetClusterContext(ctx, id)
+	},
+	"kubernetes node-pools": func(ctx context.Context, c *cli.Context, id string) (interface{}, error) {
+		svc, _ := WireUpNodePool(c)
+		return svc.GetNodePoolContext(ctx, id)
+	},
+}
+
+// resourceStates lists the states a kind of resource is known to go through while in progress, and those it is known
+// to end in when failed. Any other state is taken as settled
+type resourceStates struct {
+	InProgress []string
+	Failed     []string
+}
+
+// lifecycleStates are the states of resources which are just commissioned and decommissioned
+var lifecycleStates = resourceStates{
+	InProgress: []string{"commissioning", "decommissioning"},
+	Failed:     []string{"error", "stalled", "commission_stalled", "decommission_stalled"},
+}
+
+// with returns a copy of the states along with the given in progress states and their stalled counterparts
+func (rs resourceStates) with(inProgress map[string]string) resourceStates {
+	extended := resourceStates{
+		InProgress: append([]string{}, rs.InProgress...),
+		Failed:     append([]string{}, rs.Failed...),
+	}
+	for state, stalled := range inProgress {
+		extended.InProgress = append(extended.InProgress, state)
+		extended.Failed = append(extended.Failed, stalled)
+	}
+	return extended
+}
+
+// resourceStatesByPath maps command paths to the states of the resources that can be waited for
+var resourceStatesByPath = map[string]resourceStates{
+	"cloud servers": lifecycleStates.with(map[string]string{
+		"booting":   "boot_stalled",
+		"stopping":  "stop_stalled",
+		"rebooting": "reboot_stalled",
+	}),
+	"storage volumes": lifecycleStates.with(map[string]string{
+		"attaching": "attach_stalled",
+		"detaching": "detach_stalled",
+	}),
+	"network floating-ips": lifecycleStates.with(map[string]string{
+		"attaching": "attach_stalled",
+		"detaching": "detach_stalled",
+	}),
+	"network load-balancers": lifecycleStates.with(map[string]string{
+		"updating": "update_stalled",
+	}),
+	"network vpcs":        lifecycleStates,
+	"network dns-domains": lifecycleStates,
+	"kubernetes clusters": lifecycleStates.with(map[string]string{
+		"updating": "update_stalled",
+	}),
+	"kubernetes node-pools": lifecycleStates.with(map[string]string{
+		"updating": "update_stalled",
+	}),
+}
+
+// inProgress returns whether the state is a transitional one, such as booting or decommissioning
+func (rs resourceStates) inProgress(state string) bool {
+	return containsString(rs.InProgress, state)
+}
+
+// failed returns whether the state is one the resource ends in when failed, such as error or boot_stalled
+func (rs resourceStates) failed(state string) bool {
+	return containsString(rs.Failed, state)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// waitCondition is what a resource is waited for: reaching a given state, being deleted or, by default, settling in
+// any state neither in progress nor failed
+type waitCondition struct {
+	State   string
+	Deleted bool
+}
+
+// parseWaitCondition parses the --for flag: state=<state>, deleted, or empty to wait for the resource to settle
+func parseWaitCondition(value string) (waitCondition, error) {
+	switch {
+	case value == "":
+		return waitCondition{}, nil
+	case value == "deleted" || value == "delete":
+		return waitCondition{Deleted: true}, nil
+	case strings.HasPrefix(value, "state="):
+		if state := strings.TrimPrefix(value, "state="); state != "" {
+			return waitCondition{State: state}, nil
+		}
+	}
+	return waitCondition{}, fmt.Errorf("invalid condition %q, expected state=<state> or deleted", value)
+}
+
+func (wc waitCondition) String() string {
+	if wc.Deleted {
+		return "deleted"
+	}
+	if wc.State != "" {
+		return fmt.Sprintf("state=%s", wc.State)
+	}
+	return "settled"
+}
+
+// met returns whether the resource state meets the condition. Resources settle in any state neither in progress nor
+// failed
+func (wc waitCondition) met(state string, states resourceStates) bool {
+	if wc.Deleted {
+		return false
+	}
+	if wc.State != "" {
+		return state == wc.State
+	}
+	return state != "" && !states.inProgress(state) && !states.failed(state)
+}
+
+// resourceStringField returns the value of the named string field of a resource, or "" if it has none
+func resourceStringField(resource interface{}, name string) string {
+	r := reflect.Indirect(reflect.ValueOf(resource))
+	if r.Kind() != reflect.Struct {
+		return ""
+	}
+	if f := r.FieldByName(name); f.IsValid() && f.Kind() == reflect.String {
+		return f.String()
+	}
+	return ""
+}
+
+// waitForResource polls the resource of the command path until it meets the condition, returning it as polled last,
+// or nil if deleted. Resources ending in a failed state, or reporting an error event, are taken as failed
+func waitForResource(
+	ctx context.Context,
+	c *cli.Context,
+	path string,
+	id string,
+	condition waitCondition,
+	timeout time.Duration,
+) (interface{}, error) {
+	getter, ok := resourceGetters[path]
+	if !ok {
+		return nil, fmt.Errorf("%s can't be waited for", path)
+	}
+
+	states := resourceStatesByPath[path]
+
+	log.Infof("Waiting for %s %s to be %s...", path, id, condition)
+	var resource interface{}
+	poller := utils.Poller{Timeout: timeout}
+	err := poller.Poll(ctx, func(ctx context.Context) (bool, error) {
+		r, err := getter(ctx, c, id)
+		if err != nil {
+			if condition.Deleted && utils.IsNotFound(err) {
+				resource = nil
+				return true, nil
+			}
+			return false, err
+		}
+		resource = r
+
+		state := resourceStringField(r, "State")
+		log.Info("State: ", state)
+		if condition.met(state, states) {
+			return true, nil
+		}
+		if states.failed(state) {
+			return false, fmt.Errorf("failed in state %s", state)
+		}
+		errorEventID := resourceStringField(r, "ErrorEventID")
+		if errorEventID != "" && !states.inProgress(state) {
+			return false, fmt.Errorf("failed in state %s, see event %s", state, errorEventID)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return resource, fmt.Errorf("%s %s is not %s: %w", path, id, condition, err)
+	}
+	return resource, nil
+}
+
+// WaitFlags returns the given flags along with the --wait and --wait-timeout flags understood by commands changing
+// the state of resources
+func WaitFlags(flags ...cli.Flag) []cli.Flag {
+	return append(flags,
+		cli.BoolFlag{
+			Name:  "wait",
+			Usage: "Waits for the resource to settle, or to be deleted, before returning",
+		},
+		cli.DurationFlag{
+			Name:  "wait-timeout",
+			Usage: "Maximum time waited for the resource when --wait is given",
+			Value: DefaultWaitTimeout,
+		},
+	)
+}
+
+// waitIfRequested waits for the resource of the command group to settle, or to be deleted, when the --wait flag is
+// given. It returns the resource as polled last, or nil if not waited for or deleted
+func waitIfRequested(c *cli.Context, id string, deleted bool, f format.Formatter) interface{} {
+	if !c.Bool("wait") {
+		return nil
+	}
+
+	ctx, cancel := cmdContext()
+	defer cancel()
+	resource, err := waitForResource(
+		ctx,
+		c,
+		commandGroupPath(c),
+		id,
+		waitCondition{Deleted: deleted},
+		c.Duration("wait-timeout"),
+	)
+	if err != nil {
+		f.PrintFatal("Couldn't wait for resource", err)
+	}
+	return resource
+}
+
+// Wait subcommand function
+func Wait(c *cli.Context) error {
+	debugCmdFuncInfo(c)
+	formatter := format.GetFormatter()
+
+	checkRequiredFlags(c, []string{"id"}, formatter)
+	condition, err := parseWaitCondition(c.String("for"))
+	if err != nil {
+		formatter.PrintFatal("Couldn't wait for resource", err)
+	}
+
+	ctx, cancel := cmdContext()
+	defer cancel()
+	resource, err := waitForResource(ctx, c, commandGroupPath(c), c.String("id"), condition, c.Duration("timeout"))
+	if err != nil {
+		formatter.PrintFatal("Couldn't wait for resource", err)
+	}
+
+	if resource != nil {
+		if err = formatter.PrintItem(reflect.Indirect(reflect.ValueOf(resource)).Interface()); err != nil {
+			formatter.PrintFatal(PrintFormatError, err)
+		}
+	}
+	return nil
+}
+
+// WaitCommand returns the wait subcommand of the resources of a command group
+func WaitCommand(resource string) cli.Command {
+	return cli.Command{
+		Name: "wait",
+		Usage: fmt.Sprintf(
+			"Waits for the %s identified by the given id to reach a state, or to be deleted.",
+			resource,
+		),
+		Action: Wait,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "id",
+				Usage: fmt.Sprintf("Identifier of the %s", resource),
+			},
+			cli.StringFlag{
+				Name: "for",
+				Usage: "Condition waited for: state=<state>, or deleted. " +
+					"By default, any state neither in progress, such as booting, nor failed, such as error or stalled",
+			},
+			cli.DurationFlag{
+				Name:  "timeout",
+				Usage: "Maximum time waited for the condition",
+				Value: DefaultWaitTimeout,
+			},
+		},
+	}
+}
@@ -37,7 +37,13 @@ func WizCloudProviderList(c *cli.Context) error {
 
 	checkRequiredFlags(c, []string{"app-id", "location-id"}, formatter)
 
-	cloudProviders, err := cloudProviderSvc.ListWizardCloudProviders(c.String("app-id"), c.String("location-id"))
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	cloudProviders, err := cloudProviderSvc.ListWizardCloudProvidersContext(
+		ctx,
+		c.String("app-id"),
+		c.String("location-id"),
+	)
 	if err != nil {
 		formatter.PrintFatal("Couldn't receive cloudProvider data", err)
 	}
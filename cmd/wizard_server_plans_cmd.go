@@ -37,7 +37,10 @@ func WizServerPlanList(c *cli.Context) error {
 
 	checkRequiredFlags(c, []string{"app-id", "location-id", "cloud-provider-id"}, formatter)
 
-	serverPlans, err := serverPlanSvc.ListWizardServerPlans(
+	ctx, cancel := pageContext(c)
+	defer cancel()
+	serverPlans, err := serverPlanSvc.ListWizardServerPlansContext(
+		ctx,
 		c.String("app-id"),
 		c.String("location-id"),
 		c.String("cloud-provider-id"),
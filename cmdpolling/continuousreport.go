@@ -3,11 +3,12 @@
 package cmdpolling
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
-	"time"
 
+	"github.com/ingrammicro/cio/api/polling"
 	"github.com/ingrammicro/cio/cmd"
 	"github.com/ingrammicro/cio/utils"
 	"github.com/ingrammicro/cio/utils/format"
@@ -15,16 +16,13 @@ import (
 	"github.com/urfave/cli"
 )
 
-const (
-	RetriesNumber        = 5
-	DefaultThresholdTime = 10
-)
+const DefaultThresholdTime = 10
 
 func cmdContinuousReportRun(c *cli.Context) error {
 	log.Debug("cmdContinuousReportRun")
 
 	formatter := format.GetFormatter()
-	pollingSvc := cmd.WireUpPolling(c)
+	outbox := cmd.WireUpOutbox(c)
 
 	// cli command argument
 	var cmdArg string
@@ -44,28 +42,13 @@ func cmdContinuousReportRun(c *cli.Context) error {
 	// Custom method for chunks processing
 	fn := func(chunk string) error {
 		log.Debug("sendChunks")
-		err := utils.Retry(RetriesNumber, time.Second, func() error {
-			log.Debug("Sending: ", chunk)
-
-			commandIn := map[string]interface{}{
-				"stdout": chunk,
-			}
+		log.Debug("Sending: ", chunk)
 
-			_, statusCode, err := pollingSvc.ReportBootstrapLog(&commandIn)
-			switch {
-			// 0<100 error cases??
-			case statusCode == 0:
-				return fmt.Errorf("communication error %v %v", statusCode, err)
-			case statusCode >= 500:
-				return fmt.Errorf("server error %v %v", statusCode, err)
-			case statusCode >= 400:
-				return fmt.Errorf("client error %v %v", statusCode, err)
-			default:
-				return nil
-			}
-		})
-
-		if err != nil {
+		// chunks failing to be sent are kept in the outbox, to be sent once the platform is reachable
+		commandIn := map[string]interface{}{
+			"stdout": chunk,
+		}
+		if err := outbox.Post(context.Background(), polling.APIPathCommandPollingBootstrapLogs, &commandIn); err != nil {
 			return fmt.Errorf("cannot send the chunk data, %v", err)
 		}
 		return nil
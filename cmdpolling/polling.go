@@ -4,6 +4,7 @@ package cmdpolling
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
 	"strings"
@@ -21,9 +22,22 @@ import (
 const (
 	DefaultPollingPingTimingIntervalLong  = 30
 	DefaultPollingPingTimingIntervalShort = 5
+	DefaultPollingConcurrency             = 1
+	DefaultPollingOutputTime              = 10
+	DefaultPollingMaxOutput               = 1024 * 1024
 	ProcessIdFile                         = "cio-polling.pid"
 )
 
+// commandOptions tell how to run polling commands
+type commandOptions struct {
+	// timeout is the time after which commands not giving their own timeout are killed, if positive
+	timeout time.Duration
+	// outputTime is the maximum time the output of commands is held before being reported while they run
+	outputTime time.Duration
+	// maxOutput is the size, in bytes, past which the output of each stream of commands is dropped
+	maxOutput int
+}
+
 // Handle signals
 func handleSysSignals(cancelFunc context.CancelFunc) {
 	log.Debug("handleSysSignals")
@@ -60,12 +74,31 @@ func cmdStart(c *cli.Context) error {
 	}
 	log.Debug("Ping short time interval:", pollingPingTimingIntervalShort)
 
+	concurrency := c.Int("concurrency")
+	if concurrency <= 0 {
+		concurrency = DefaultPollingConcurrency
+	}
+	log.Debug("Concurrency:", concurrency)
+
+	options := commandOptions{
+		timeout:    time.Duration(c.Int64("commandTimeout")) * time.Second,
+		outputTime: time.Duration(c.Int64("outputTime")) * time.Second,
+		maxOutput:  c.Int("maxOutput"),
+	}
+	if options.outputTime <= 0 {
+		options.outputTime = DefaultPollingOutputTime * time.Second
+	}
+	if options.maxOutput <= 0 {
+		options.maxOutput = DefaultPollingMaxOutput
+	}
+	log.Debugf("Command options: %+v", options)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	go handleSysSignals(cancel)
 
-	pingRoutine(ctx, c, pollingPingTimingIntervalLong, pollingPingTimingIntervalShort)
+	pingRoutine(ctx, c, pollingPingTimingIntervalLong, pollingPingTimingIntervalShort, concurrency, options)
 
 	return nil
 }
@@ -83,29 +116,53 @@ func cmdStop(c *cli.Context) error {
 	return nil
 }
 
-// Main polling background routine
-func pingRoutine(ctx context.Context, c *cli.Context, longTimePeriod int64, shortTimePeriod int64) {
+// Main polling background routine. Up to concurrency commands are run at once, each one by its own routine, as
+// told by options
+func pingRoutine(
+	ctx context.Context,
+	c *cli.Context,
+	longTimePeriod int64,
+	shortTimePeriod int64,
+	concurrency int,
+	options commandOptions,
+) {
 	log.Debug("pingRoutine")
 
 	formatter := format.GetFormatter()
 	pollingSvc := cmd.WireUpPolling(c)
-	commandProcessed := make(chan bool, 1)
+	outbox := cmd.WireUpOutbox(c)
+	commandProcessed := make(chan bool, concurrency)
 
 	// initialization
-	isRunningCommandRoutine := false
+	runningCommandRoutines := 0
 	longTicker := time.NewTicker(time.Duration(longTimePeriod) * time.Second)
 	currentTicker := longTicker
+	useShortTicker := func() {
+		if currentTicker != longTicker {
+			currentTicker.Stop()
+		}
+		log.Debug("Ticker assigned: short")
+		currentTicker = time.NewTicker(time.Duration(shortTimePeriod) * time.Second)
+	}
 	for {
 		log.Debug("Requesting for candidate commands status")
-		ping, status, err := pollingSvc.Ping()
+		ping, status, err := pollingSvc.PingContext(ctx)
 		if err != nil {
 			formatter.PrintError("Couldn't receive polling ping data", err)
 		} else {
-			// One command is available, and no process running
-			if status == 201 && ping.PendingCommands && !isRunningCommandRoutine {
+			// the platform is reachable again, so the reports kept meanwhile are sent before any other
+			if err = outbox.Flush(ctx); err != nil {
+				formatter.PrintError("Couldn't send the reports kept in the outbox", err)
+			}
+			// One command is available, and some routine is free to process it
+			if status == 201 && ping.PendingCommands && runningCommandRoutines < concurrency {
 				log.Debug("Detected a candidate command")
-				isRunningCommandRoutine = true
-				go processingCommandRoutine(pollingSvc, formatter, commandProcessed)
+				runningCommandRoutines++
+				go processingCommandRoutine(ctx, pollingSvc, outbox, formatter, options, commandProcessed)
+				// more commands may be pending, which the free routines should not wait long for
+				if runningCommandRoutines < concurrency {
+					useShortTicker()
+				}
 			}
 		}
 
@@ -113,12 +170,8 @@ func pingRoutine(ctx context.Context, c *cli.Context, longTimePeriod int64, shor
 
 		select {
 		case <-commandProcessed:
-			isRunningCommandRoutine = false
-			if currentTicker != longTicker {
-				currentTicker.Stop()
-			}
-			log.Debug("Ticker assigned: short")
-			currentTicker = time.NewTicker(time.Duration(shortTimePeriod) * time.Second)
+			runningCommandRoutines--
+			useShortTicker()
 		case <-currentTicker.C:
 			if currentTicker != longTicker {
 				currentTicker.Stop()
@@ -128,30 +181,65 @@ func pingRoutine(ctx context.Context, c *cli.Context, longTimePeriod int64, shor
 		case <-ctx.Done():
 			log.Debug(ctx.Err())
 			log.Debug("closing polling")
+			// running commands are killed along with ctx, but their temporary files are still to be removed
+			for ; runningCommandRoutines > 0; runningCommandRoutines-- {
+				<-commandProcessed
+			}
 			return
 		}
 	}
 }
 
-// Subsidiary routine for commands processing
+// Subsidiary routine for commands processing. Commands are killed after their own timeout or, if they give none,
+// after the one of options, and their output is reported while they run
 func processingCommandRoutine(
+	ctx context.Context,
 	pollingSvc *polling.PollingService,
+	outbox *utils.Outbox,
 	formatter format.Formatter,
+	options commandOptions,
 	commandProcessed chan bool,
 ) {
 	log.Debug("processingCommandRoutine")
 
 	// 1. Request for the new command available
 	log.Debug("Retrieving available command")
-	command, status, err := pollingSvc.GetNextCommand()
+	command, status, err := pollingSvc.GetNextCommandContext(ctx)
 	if err != nil {
 		formatter.PrintError("Couldn't receive polling command candidate data", err)
 	}
 
 	// 2. Execute the retrieved command
 	if status == 200 {
-		log.Debug("Running the retrieved command")
-		command.ExitCode, command.Stdout, command.Stderr, _, _ = utils.RunTracedCmd(command.Script)
+		timeout := options.timeout
+		if command.Timeout > 0 {
+			timeout = time.Duration(command.Timeout) * time.Second
+		}
+		log.Debug("Running the retrieved command, timeout: ", timeout)
+		streaming := &utils.CmdStreaming{
+			Report: func(stream string, chunk string) error {
+				// transient failures are already retried by the concerto service
+				logIn := map[string]interface{}{
+					stream: chunk,
+				}
+				_, status, err := pollingSvc.ReportCommandLogContext(ctx, command.ID, &logIn)
+				if err == nil && status >= 300 {
+					err = fmt.Errorf("unexpected status code %d", status)
+				}
+				if err != nil {
+					return fmt.Errorf("cannot send the command output, %v", err)
+				}
+				return nil
+			},
+			ThresholdTime: options.outputTime,
+			MaxOutput:     options.maxOutput,
+		}
+		command.ExitCode, command.Stdout, command.Stderr, _, _ = utils.RunStreamedCmdContext(
+			ctx,
+			command.Script,
+			timeout,
+			streaming,
+		)
 
 		// 3. then status is propagated to IMCO
 		log.Debug("Reporting command execution status")
@@ -164,15 +252,12 @@ func processingCommandRoutine(
 			"exit_code": command.ExitCode,
 		}
 
-		_, status, err := pollingSvc.UpdateCommand(command.ID, &commandIn)
+		// results failing to be sent are kept in the outbox, to be sent once the platform is reachable
+		err = outbox.Put(ctx, fmt.Sprintf(polling.APIPathCommandPollingCommand, command.ID), &commandIn)
 		if err != nil {
 			formatter.PrintError("Couldn't send polling command report data", err)
-		}
-
-		if status == 200 {
-			log.Debug("Command execution results successfully reported")
 		} else {
-			log.Error("Cannot report the command execution results")
+			log.Debug("Command execution results reported, or kept in the outbox to be reported")
 		}
 	} else {
 		log.Error("Cannot retrieve the next command")
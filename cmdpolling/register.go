@@ -54,9 +54,6 @@ func obtainServerKeys(config *utils.Config) (rootCAcert string, cert string, key
 	}
 	payload := make(map[string]interface{})
 	body, status, err := cs.Post("/command_polling/api_key", &payload)
-	if err != nil {
-		return
-	}
 	if status == 403 {
 		err = fmt.Errorf("server responded with 403 code: the polling token is not valid, maybe it expired")
 		return
@@ -65,6 +62,9 @@ func obtainServerKeys(config *utils.Config) (rootCAcert string, cert string, key
 		err = fmt.Errorf("server responded with %d code: %s", status, string(body))
 		return
 	}
+	if err != nil {
+		return
+	}
 	responseData := make(map[string]interface{})
 	err = json.Unmarshal(body, &responseData)
 	if err != nil {
@@ -29,6 +29,25 @@ func SubCommands() []cli.Command {
 					Usage: "Polling ping short time interval (seconds)",
 					Value: DefaultPollingPingTimingIntervalShort,
 				},
+				cli.IntFlag{
+					Name:  "concurrency",
+					Usage: "Maximum number of commands run at once",
+					Value: DefaultPollingConcurrency,
+				},
+				cli.Int64Flag{
+					Name:  "commandTimeout",
+					Usage: "Time (seconds) after which commands not giving their own timeout are killed, 0 for none",
+				},
+				cli.Int64Flag{
+					Name:  "outputTime",
+					Usage: "Maximum time (seconds) the output of commands is held before being reported while they run",
+					Value: DefaultPollingOutputTime,
+				},
+				cli.IntFlag{
+					Name:  "maxOutput",
+					Usage: "Size (bytes) past which the output of commands is truncated",
+					Value: DefaultPollingMaxOutput,
+				},
 			},
 		},
 		{
@@ -0,0 +1,29 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package completion
+
+import (
+	"github.com/ingrammicro/cio/cmd"
+	"github.com/urfave/cli"
+)
+
+// SubCommands returns completion commands
+func SubCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "bash",
+			Usage:  "Prints the bash completion script. Load it with: source <(cio completion bash)",
+			Action: cmd.CompletionBash,
+		},
+		{
+			Name:   "zsh",
+			Usage:  "Prints the zsh completion script. Load it with: source <(cio completion zsh)",
+			Action: cmd.CompletionZsh,
+		},
+		{
+			Name:   "fish",
+			Usage:  "Prints the fish completion script. Load it with: cio completion fish | source",
+			Action: cmd.CompletionFish,
+		},
+	}
+}
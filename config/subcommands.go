@@ -0,0 +1,83 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package config
+
+import (
+	"github.com/ingrammicro/cio/cmd"
+	"github.com/urfave/cli"
+)
+
+// SubCommands returns config commands
+func SubCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "init",
+			Usage:  "Writes the configuration file, asking for its values",
+			Action: cmd.ConfigInit,
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "non-interactive",
+					Usage: "Write the default values, as given by env/args, without asking for them",
+				},
+				cli.BoolFlag{
+					Name:  "force",
+					Usage: "Overwrite the configuration file if it already exists",
+				},
+			},
+		},
+		{
+			Name:   "validate",
+			Usage:  "Checks that certificates are valid and match, and that the API endpoint is reachable",
+			Action: cmd.ConfigValidate,
+		},
+		{
+			Name:   "show",
+			Usage:  "Shows the effective configuration, merging the configuration file, profiles and env/args",
+			Action: cmd.ConfigShow,
+		},
+		{
+			Name:   "list-contexts",
+			Usage:  "Lists the contexts of the profiles file",
+			Action: cmd.ConfigListContexts,
+		},
+		{
+			Name:   "current-context",
+			Usage:  "Shows the context in use",
+			Action: cmd.ConfigCurrentContext,
+		},
+		{
+			Name:      "use-context",
+			Usage:     "Sets the context used by default",
+			ArgsUsage: "context",
+			Action:    cmd.ConfigUseContext,
+		},
+		{
+			Name:      "set-context",
+			Usage:     "Adds a context to the profiles file, or updates the given values of an existing one",
+			ArgsUsage: "context",
+			Action:    cmd.ConfigSetContext,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "server",
+					Usage: "Concerto API endpoint",
+				},
+				cli.StringFlag{
+					Name:  "cert",
+					Usage: "Client certificate path",
+				},
+				cli.StringFlag{
+					Name:  "key",
+					Usage: "Private key path",
+				},
+				cli.StringFlag{
+					Name:  "ca-cert",
+					Usage: "CA certificate path",
+				},
+				cli.StringFlag{
+					Name:  "formatter",
+					Usage: "Output formatter used by default",
+				},
+			},
+		},
+	}
+}
@@ -3,11 +3,11 @@
 package dispatcher
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
-	"time"
 
 	"github.com/ingrammicro/cio/api/dispatcher"
 	"github.com/ingrammicro/cio/cmd"
@@ -94,6 +94,7 @@ func downloadAttachments(
 
 func execute(c *cli.Context, phase string, scriptCharacterizationUUID string) {
 	dispatcherSvc, config, formatter := cmd.WireUpDispatcher(c)
+	outbox := cmd.WireUpOutbox(c)
 	scriptChars := getDispatcherScriptCharacterization(dispatcherSvc, formatter, phase, scriptCharacterizationUUID)
 
 	for _, sc := range scriptChars {
@@ -132,23 +133,9 @@ func execute(c *cli.Context, phase string, scriptCharacterizationUUID string) {
 			"script_conclusion": scriptConclusionIn,
 		}
 
-		err = utils.Retry(5, time.Second, func() error {
-			log.Info("Calling ReportScriptConclusions")
-
-			_, statusCode, err := dispatcherSvc.ReportScriptConclusions(&scriptConclusionRootIn)
-			switch {
-			// 0<100 error cases??
-			case statusCode == 0:
-				return fmt.Errorf("communication error %v %v", statusCode, err)
-			case statusCode >= 500:
-				return fmt.Errorf("server error %v %v", statusCode, err)
-			case statusCode >= 400:
-				return fmt.Errorf("client error %v %v", statusCode, err)
-			default:
-				return nil
-			}
-		})
-
+		// conclusions failing to be sent are kept in the outbox, to be sent once the platform is reachable
+		log.Info("Calling ReportScriptConclusions")
+		err = outbox.Post(context.Background(), dispatcher.APIPathBlueprintScriptConclusions, &scriptConclusionRootIn)
 		if err != nil {
 			formatter.PrintFatal("Couldn't send script_conclusions report data", err)
 		}
@@ -3,15 +3,26 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
+	"time"
 
+	"github.com/ingrammicro/cio/api/types"
 	"github.com/ingrammicro/cio/cmd"
+	"github.com/ingrammicro/cio/utils"
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 )
 
 const CurrentFirewallDriverDebugTrace = "Current firewall driver %s"
 
+// DefaultConfirmTimeout is the default time, in seconds, given to confirm that the API is still reachable after
+// applying a policy, before it is rolled back
+const DefaultConfirmTimeout = 60
+
+// confirmRetryWait is the time waited between attempts to confirm that the API is reachable
+var confirmRetryWait = 5 * time.Second
+
 func cmdList(c *cli.Context) error {
 	log.Debugf(CurrentFirewallDriverDebugTrace, driverName())
 	return cmd.FirewallRuleList(c)
@@ -22,11 +33,67 @@ func cmdApply(c *cli.Context) error {
 	policy := cmd.FirewallPolicyGet(c)
 	// Only apply firewall if we get a non-empty set of rules
 	if len(policy.Rules) > 0 {
-		return Apply(*policy)
+		timeout := time.Duration(c.Int("confirm-timeout")) * time.Second
+		if timeout <= 0 {
+			timeout = DefaultConfirmTimeout * time.Second
+		}
+		svc, _ := cmd.WireUpFirewall(c)
+		return ApplyConfirmed(*policy, func(ctx context.Context) error {
+			// any response, even an error one, tells that the API is reachable
+			_, err := svc.GetPolicyContext(ctx)
+			if _, ok := utils.AsAPIError(err); ok {
+				return nil
+			}
+			return err
+		}, timeout)
 	}
 	return flush()
 }
 
+// ApplyConfirmed applies the policy, and then calls confirm until it succeeds, which should tell that the API is still
+// reachable. Unless it does before timeout, the rules of the firewall are rolled back to those it had before, when
+// supported by the driver
+func ApplyConfirmed(policy types.Policy, confirm func(ctx context.Context) error, timeout time.Duration) error {
+	rollback, err := snapshot()
+	if err != nil {
+		return fmt.Errorf("cannot save the current firewall rules: %v", err)
+	}
+	if err = Apply(policy); err != nil {
+		return err
+	}
+	if rollback == nil {
+		return nil
+	}
+	return confirmOrRollback(confirm, timeout, rollback)
+}
+
+// confirmOrRollback calls confirm until it succeeds, or else calls rollback once timeout has passed
+func confirmOrRollback(confirm func(ctx context.Context) error, timeout time.Duration, rollback func() error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	for {
+		err := confirm(ctx)
+		if err == nil {
+			log.Debug("Firewall policy confirmed, the API is reachable")
+			return nil
+		}
+		log.Warnf("Couldn't reach the API after applying the firewall policy: %v", err)
+
+		select {
+		case <-ctx.Done():
+			if rollbackErr := rollback(); rollbackErr != nil {
+				return fmt.Errorf(
+					"cannot reach the API after applying the firewall policy (%v), and cannot roll it back: %v",
+					err,
+					rollbackErr,
+				)
+			}
+			return fmt.Errorf("cannot reach the API after applying the firewall policy, which was rolled back: %v", err)
+		case <-time.After(confirmRetryWait):
+		}
+	}
+}
+
 func cmdFlush(c *cli.Context) error {
 	log.Debugf(CurrentFirewallDriverDebugTrace, driverName())
 	return flush()
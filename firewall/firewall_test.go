@@ -0,0 +1,48 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package firewall
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfirmOrRollback(t *testing.T) {
+	assert := assert.New(t)
+
+	confirmRetryWait = time.Millisecond
+	defer func() { confirmRetryWait = 5 * time.Second }()
+
+	attempts, rollbacks := 0, 0
+	rollback := func() error {
+		rollbacks++
+		return nil
+	}
+	err := confirmOrRollback(func(ctx context.Context) error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("connection refused")
+		}
+		return nil
+	}, time.Second, rollback)
+	assert.Nil(err, "Policies should be kept once the API is reachable")
+	assert.Equal(3, attempts, "The API should be retried until it is reachable")
+	assert.Zero(rollbacks)
+
+	err = confirmOrRollback(func(ctx context.Context) error {
+		return errors.New("connection refused")
+	}, 20*time.Millisecond, rollback)
+	assert.NotNil(err)
+	assert.Equal(1, rollbacks, "Policies should be rolled back when the API is not reachable in time")
+
+	err = confirmOrRollback(func(ctx context.Context) error {
+		return errors.New("connection refused")
+	}, 20*time.Millisecond, func() error {
+		return errors.New("iptables-restore failed")
+	})
+	assert.Contains(err.Error(), "cannot roll it back", "Failed rollbacks should be reported")
+}
@@ -7,62 +7,151 @@ package firewall
 
 import (
 	"fmt"
-	"os"
+	"strings"
 
 	"github.com/ingrammicro/cio/api/types"
-
-	"github.com/ingrammicro/cio/utils"
 	log "github.com/sirupsen/logrus"
 )
 
-func driverName() string {
+// iptablesDriver applies policies with iptables, to the CONCERTO chain of the filter table. Policies are rendered as
+// iptables-restore payloads, so that either every change is applied, or none is
+type iptablesDriver struct{}
+
+func (iptablesDriver) name() string {
 	return "iptables"
 }
 
-func Apply(policy types.Policy) error {
-	var exitCode int
-	utils.RunCmd("/sbin/iptables -w -N CONCERTO")
-	utils.RunCmd("/sbin/iptables -w -F CONCERTO")
-	utils.RunCmd("/sbin/iptables -w -P INPUT DROP")
+func (iptablesDriver) apply(policy types.Policy) error {
+	current, err := saveIptables()
+	if err != nil {
+		return err
+	}
+	payload, err := iptablesRestorePayload(policy, current)
+	if err != nil {
+		return err
+	}
+	return restoreIptables(payload, true)
+}
 
-	_, exitCode, _, _ = utils.RunCmd("/sbin/iptables -w -C INPUT -i lo -j ACCEPT")
-	if exitCode != 0 {
-		utils.RunCmd("/sbin/iptables -w -A INPUT -i lo -j ACCEPT")
+func (iptablesDriver) flush() error {
+	setTrustedZone()
+	return removeIptablesChain()
+}
+
+// snapshot saves the filter table, so that restoring it replaces the rules applied since
+func (iptablesDriver) snapshot() (func() error, error) {
+	current, err := saveIptables()
+	if err != nil {
+		return nil, err
 	}
+	return func() error {
+		return restoreIptables(current, false)
+	}, nil
+}
 
-	_, exitCode, _, _ = utils.RunCmd("/sbin/iptables -w -C INPUT -m state --state ESTABLISHED,RELATED -j ACCEPT")
-	if exitCode != 0 {
-		utils.RunCmd("/sbin/iptables -w -A INPUT -m state --state ESTABLISHED,RELATED -j ACCEPT")
+// removeIptablesChain removes the CONCERTO chain, letting in the traffic it filtered. Without the chain, the filter
+// table is left untouched, so that the policy set by anyone else is kept
+func removeIptablesChain() error {
+	current, err := saveIptables()
+	if err != nil {
+		return err
+	}
+	payload := iptablesFlushPayload(current)
+	if payload == "" {
+		return nil
 	}
+	return restoreIptables(payload, true)
+}
 
+// iptablesRestorePayload returns the iptables-restore payload applying the policy to the filter table, whose current
+// rules, as saved by iptables-save, tell whether INPUT already jumps to the CONCERTO chain. Inbound traffic is dropped
+// unless it is loopback, belongs to an established connection, or is let in by the policy rules. As iptables filters
+// IPv4 only, the rules for IPv6 are skipped
+func iptablesRestorePayload(policy types.Policy, current string) (string, error) {
+	var rules []string
 	for _, rule := range policy.Rules {
-		utils.RunCmd(
-			fmt.Sprintf(
-				"/sbin/iptables -w -A CONCERTO -s %s -p %s --dport %d:%d -j ACCEPT",
-				rule.Cidr,
-				rule.Protocol,
-				rule.MinPort,
-				rule.MaxPort,
-			),
-		)
+		hr, err := parseRule(rule)
+		if err != nil {
+			return "", err
+		}
+		if hr.ipv6() {
+			log.Warnf("Skipping firewall rule for %s, as iptables only filters IPv4", rule.Cidr)
+			continue
+		}
+		r := fmt.Sprintf("-A CONCERTO -s %s -p %s", hr.network, hr.protocol)
+		if hr.ports {
+			r = fmt.Sprintf("%s -m %s --dport %d:%d", r, hr.protocol, hr.minPort, hr.maxPort)
+		}
+		rules = append(rules, r+" -j ACCEPT")
+	}
+
+	var b strings.Builder
+	b.WriteString("*filter\n")
+	b.WriteString(":INPUT DROP [0:0]\n")
+	// declaring a chain which exists flushes it
+	b.WriteString(":CONCERTO - [0:0]\n")
+	b.WriteString("-A CONCERTO -i lo -j ACCEPT\n")
+	b.WriteString("-A CONCERTO -m state --state ESTABLISHED,RELATED -j ACCEPT\n")
+	for _, r := range rules {
+		b.WriteString(r + "\n")
+	}
+	if iptablesCountRule(current, "-A INPUT -j CONCERTO") == 0 {
+		b.WriteString("-A INPUT -j CONCERTO\n")
 	}
+	b.WriteString("COMMIT\n")
+	return b.String(), nil
+}
 
-	_, exitCode, _, _ = utils.RunCmd("/sbin/iptables -w -C INPUT -j CONCERTO")
-	if exitCode != 0 {
-		log.Debugln("Concerto Chain is not existent adding it to INPUT")
-		utils.RunCmd("/sbin/iptables -w -A INPUT -j CONCERTO")
+// iptablesFlushPayload returns the iptables-restore payload removing the CONCERTO chain from the filter table, along
+// with the INPUT policy set with it, or "" if there is no such chain
+func iptablesFlushPayload(current string) string {
+	if !iptablesHasChain(current, "CONCERTO") {
+		return ""
 	}
+	var b strings.Builder
+	b.WriteString("*filter\n")
+	b.WriteString(":INPUT ACCEPT [0:0]\n")
+	for i := iptablesCountRule(current, "-A INPUT -j CONCERTO"); i > 0; i-- {
+		b.WriteString("-D INPUT -j CONCERTO\n")
+	}
+	b.WriteString("-F CONCERTO\n")
+	b.WriteString("-X CONCERTO\n")
+	b.WriteString("COMMIT\n")
+	return b.String()
+}
+
+// iptablesCountRule counts the lines of the filter table rules, as saved by iptables-save, which are the given rule
+func iptablesCountRule(current string, rule string) int {
+	count := 0
+	for _, line := range strings.Split(current, "\n") {
+		if strings.TrimSpace(line) == rule {
+			count++
+		}
+	}
+	return count
+}
+
+// iptablesHasChain tells whether the filter table rules, as saved by iptables-save, declare the given chain
+func iptablesHasChain(current string, chain string) bool {
+	for _, line := range strings.Split(current, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), ":"+chain+" ") {
+			return true
+		}
+	}
+	return false
+}
 
-	return nil
+// saveIptables returns the rules of the filter table, as saved by iptables-save
+func saveIptables() (string, error) {
+	return runForOutput("iptables-save", "-t", "filter")
 }
 
-func flush() error {
-	if _, err := os.Stat("/etc/redhat-release"); err == nil {
-		utils.RunCmd("firewall-cmd --set-default-zone=trusted")
+// restoreIptables applies an iptables-restore payload, atomically. Unless noflush, the tables of the payload are
+// replaced with its rules, instead of having them added
+func restoreIptables(payload string, noflush bool) error {
+	var args []string
+	if noflush {
+		args = append(args, "--noflush")
 	}
-	utils.RunCmd("/sbin/iptables -w -P INPUT ACCEPT")
-	utils.RunCmd("/sbin/iptables -w -F CONCERTO")
-	utils.RunCmd("/sbin/iptables -w -D INPUT -j CONCERTO")
-	utils.RunCmd("/sbin/iptables -w -X CONCERTO")
-	return nil
+	return runWithInput(payload, "iptables-restore", args...)
 }
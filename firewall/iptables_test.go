@@ -0,0 +1,89 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+//go:build linux
+// +build linux
+
+package firewall
+
+import (
+	"testing"
+
+	"github.com/ingrammicro/cio/api/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const savedWithoutChain = `# Generated by iptables-save v1.8.7 on Mon Jan  2 10:00:00 2023
+*filter
+:INPUT ACCEPT [0:0]
+:FORWARD ACCEPT [0:0]
+:OUTPUT ACCEPT [0:0]
+-A INPUT -p tcp -m tcp --dport 8080 -j ACCEPT
+COMMIT
+# Completed on Mon Jan  2 10:00:00 2023
+`
+
+const savedWithChain = `*filter
+:INPUT DROP [0:0]
+:FORWARD ACCEPT [0:0]
+:OUTPUT ACCEPT [0:0]
+:CONCERTO - [0:0]
+:CONCERTO_EXTRA - [0:0]
+-A INPUT -j CONCERTO_EXTRA
+-A INPUT -j CONCERTO
+-A INPUT -j CONCERTO
+-A CONCERTO -s 10.0.0.0/8 -p tcp -m tcp --dport 22:22 -j ACCEPT
+COMMIT
+`
+
+func TestIptablesRestorePayload(t *testing.T) {
+	assert := assert.New(t)
+
+	policy := types.Policy{
+		Rules: []types.PolicyRule{
+			{Cidr: "0.0.0.0/0", Protocol: "tcp", MinPort: 22, MaxPort: 22},
+			{Cidr: "10.0.0.1/8", Protocol: "UDP", MinPort: 1000, MaxPort: 2000},
+			{Cidr: "192.168.1.10", Protocol: "icmp"},
+			{Cidr: "2001:db8::/32", Protocol: "tcp", MinPort: 443, MaxPort: 443},
+		},
+	}
+	payload, err := iptablesRestorePayload(policy, savedWithoutChain)
+	assert.Nil(err)
+	assert.Equal(`*filter
+:INPUT DROP [0:0]
+:CONCERTO - [0:0]
+-A CONCERTO -i lo -j ACCEPT
+-A CONCERTO -m state --state ESTABLISHED,RELATED -j ACCEPT
+-A CONCERTO -s 0.0.0.0/0 -p tcp -m tcp --dport 22:22 -j ACCEPT
+-A CONCERTO -s 10.0.0.0/8 -p udp -m udp --dport 1000:2000 -j ACCEPT
+-A CONCERTO -s 192.168.1.10/32 -p icmp -j ACCEPT
+-A INPUT -j CONCERTO
+COMMIT
+`, payload, "IPv6 rules should be skipped")
+
+	payload, err = iptablesRestorePayload(policy, savedWithChain)
+	assert.Nil(err)
+	assert.NotContains(payload, "-A INPUT -j CONCERTO\n", "INPUT should only jump once to the CONCERTO chain")
+
+	_, err = iptablesRestorePayload(types.Policy{
+		Rules: []types.PolicyRule{{Cidr: "0.0.0.0/0 -j DROP", Protocol: "tcp", MinPort: 22, MaxPort: 22}},
+	}, savedWithoutChain)
+	assert.NotNil(err, "Invalid rules should be rejected, rather than rendered")
+}
+
+func TestIptablesFlushPayload(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(`*filter
+:INPUT ACCEPT [0:0]
+-D INPUT -j CONCERTO
+-D INPUT -j CONCERTO
+-F CONCERTO
+-X CONCERTO
+COMMIT
+`, iptablesFlushPayload(savedWithChain))
+
+	assert.Empty(
+		iptablesFlushPayload(savedWithoutChain),
+		"The filter table should be left untouched without the CONCERTO chain",
+	)
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+//go:build linux
+// +build linux
+
+package firewall
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/ingrammicro/cio/api/types"
+	"github.com/ingrammicro/cio/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// driver applies policies with one of the firewalls of the host
+type driver interface {
+	name() string
+	apply(policy types.Policy) error
+	flush() error
+	// snapshot saves the current rules, returning the function restoring them
+	snapshot() (func() error, error)
+}
+
+var protocolRegexp = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// portProtocols are the protocols whose rules filter destination ports
+var portProtocols = map[string]bool{"tcp": true, "udp": true, "udplite": true, "sctp": true, "dccp": true}
+
+// hostRule is a policy rule checked to be safe to render for the firewall of the host
+type hostRule struct {
+	network  *net.IPNet
+	protocol string
+	// ports tells whether the rule filters destination ports, from minPort to maxPort
+	ports   bool
+	minPort int
+	maxPort int
+}
+
+// parseRule checks a policy rule, whose CIDR may also be a single address
+func parseRule(rule types.PolicyRule) (*hostRule, error) {
+	_, network, err := net.ParseCIDR(rule.Cidr)
+	if err != nil {
+		ip := net.ParseIP(rule.Cidr)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid CIDR %q of firewall rule", rule.Cidr)
+		}
+		bits := 8 * net.IPv6len
+		if ip.To4() != nil {
+			ip, bits = ip.To4(), 8*net.IPv4len
+		}
+		network = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+	}
+
+	protocol := strings.ToLower(rule.Protocol)
+	if !protocolRegexp.MatchString(protocol) {
+		return nil, fmt.Errorf("invalid protocol %q of firewall rule", rule.Protocol)
+	}
+	hr := &hostRule{network: network, protocol: protocol, ports: portProtocols[protocol]}
+	if !hr.ports {
+		return hr, nil
+	}
+
+	hr.minPort, hr.maxPort = rule.MinPort, rule.MaxPort
+	if hr.maxPort == 0 {
+		hr.maxPort = hr.minPort
+	}
+	if hr.minPort < 0 || hr.maxPort > 65535 || hr.minPort > hr.maxPort {
+		return nil, fmt.Errorf("invalid port range %d-%d of firewall rule", rule.MinPort, rule.MaxPort)
+	}
+	return hr, nil
+}
+
+// ipv6 tells whether the rule is for IPv6 traffic
+func (hr *hostRule) ipv6() bool {
+	return hr.network.IP.To4() == nil
+}
+
+var hostDriver struct {
+	sync.Once
+	driver
+}
+
+// currentDriver returns the driver of the firewall the host uses: nftables when its iptables command is missing or is
+// the nf_tables shim, iptables otherwise
+func currentDriver() driver {
+	hostDriver.Do(func() {
+		hostDriver.driver = iptablesDriver{}
+		if _, ok := lookupCommand("nft"); !ok {
+			return
+		}
+		iptables, ok := lookupCommand("iptables")
+		if !ok {
+			hostDriver.driver = nftablesDriver{}
+			return
+		}
+		output, err := exec.Command(iptables, "-V").CombinedOutput()
+		if err != nil {
+			log.Debugf("Cannot tell the iptables backend, assuming legacy: %v", err)
+			return
+		}
+		if strings.Contains(string(output), "nf_tables") {
+			hostDriver.driver = nftablesDriver{}
+		}
+	})
+	return hostDriver.driver
+}
+
+// lookupCommand returns the path of a command, looked for in the system directories too, as they may be out of PATH
+func lookupCommand(name string) (string, bool) {
+	if path, err := exec.LookPath(name); err == nil {
+		return path, true
+	}
+	for _, dir := range []string{"/usr/sbin", "/sbin"} {
+		path := filepath.Join(dir, name)
+		if fi, err := os.Stat(path); err == nil && !fi.IsDir() && fi.Mode()&0111 != 0 {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+func driverName() string {
+	return currentDriver().name()
+}
+
+func Apply(policy types.Policy) error {
+	return currentDriver().apply(policy)
+}
+
+func flush() error {
+	return currentDriver().flush()
+}
+
+func snapshot() (func() error, error) {
+	return currentDriver().snapshot()
+}
+
+// setTrustedZone opens the firewalld default zone on Red Hat hosts, so that it does not filter the traffic let in
+func setTrustedZone() {
+	if _, err := os.Stat("/etc/redhat-release"); err == nil {
+		utils.RunCmd("firewall-cmd --set-default-zone=trusted")
+	}
+}
+
+// runWithInput runs a command of the host, found with lookupCommand, writing input to its standard input
+func runWithInput(input string, name string, args ...string) error {
+	command, ok := lookupCommand(name)
+	if !ok {
+		return fmt.Errorf("cannot find the %s command", name)
+	}
+	log.Infof("Command: %s %s", command, strings.Join(args, " "))
+	log.Debugf("Input:\n%s", input)
+	cmd := exec.Command(command, args...)
+	cmd.Stdin = strings.NewReader(input)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("cannot run %s: %v: %s", name, err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// runForOutput runs a command of the host, found with lookupCommand, returning its standard output
+func runForOutput(name string, args ...string) (string, error) {
+	command, ok := lookupCommand(name)
+	if !ok {
+		return "", fmt.Errorf("cannot find the %s command", name)
+	}
+	log.Infof("Command: %s %s", command, strings.Join(args, " "))
+	output, err := exec.Command(command, args...).Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return "", fmt.Errorf("cannot run %s: %v: %s", name, err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return "", fmt.Errorf("cannot run %s: %v", name, err)
+	}
+	return string(output), nil
+}
@@ -38,3 +38,8 @@ func flush() error {
 	fmt.Println("iptables -P INPUT DROP")
 	return nil
 }
+
+// snapshot returns no rollback, as it is not supported by this driver
+func snapshot() (func() error, error) {
+	return nil, nil
+}
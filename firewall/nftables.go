@@ -0,0 +1,142 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+//go:build linux
+// +build linux
+
+package firewall
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ingrammicro/cio/api/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// nftablesTable is the table of the concerto rules. Being of the inet family, it filters both IPv4 and IPv6
+const nftablesTable = "inet concerto"
+
+// nftablesFlushRuleset removes the table of the concerto rules. It is declared first, so that deleting it does not fail
+// when missing
+const nftablesFlushRuleset = "table " + nftablesTable + "\ndelete table " + nftablesTable + "\n"
+
+// nftablesDriver applies policies with nftables, to a table of its own, replaced as a whole in a single transaction
+type nftablesDriver struct{}
+
+func (nftablesDriver) name() string {
+	return "nftables"
+}
+
+func (nftablesDriver) apply(policy types.Policy) error {
+	ruleset, err := nftablesRuleset(policy)
+	if err != nil {
+		return err
+	}
+	if err = runNft(ruleset); err != nil {
+		return err
+	}
+	removeLegacyChain()
+	return nil
+}
+
+// snapshot saves the concerto table, if any, so that restoring it replaces the one applied since, or removes it
+func (nftablesDriver) snapshot() (func() error, error) {
+	table, err := runForOutput("nft", "list", "tables", "inet")
+	if err != nil {
+		return nil, err
+	}
+	if !strings.Contains(table+"\n", "table "+nftablesTable+"\n") {
+		return func() error {
+			return runNft(nftablesFlushRuleset)
+		}, nil
+	}
+	if table, err = runForOutput("nft", "list", "table", "inet", "concerto"); err != nil {
+		return nil, err
+	}
+	return func() error {
+		return runNft(nftablesFlushRuleset + table)
+	}, nil
+}
+
+func (nftablesDriver) flush() error {
+	setTrustedZone()
+	if err := runNft(nftablesFlushRuleset); err != nil {
+		return err
+	}
+	removeLegacyChain()
+	return nil
+}
+
+// removeLegacyChain removes the CONCERTO chain the iptables driver may have left, before the host was found to use
+// nftables, which would otherwise keep dropping the traffic the concerto table lets in
+func removeLegacyChain() {
+	if _, ok := lookupCommand("iptables-restore"); ok {
+		if err := removeIptablesChain(); err != nil {
+			log.Warnf("Couldn't remove the CONCERTO chain of iptables: %v", err)
+		}
+	}
+}
+
+// nftablesRuleset returns the nftables ruleset applying the policy, which replaces the previous one, if any. Inbound
+// traffic is dropped unless it is loopback, belongs to an established connection, or is let in by the policy rules.
+// As iptables filters IPv4 only, IPv6 traffic is only filtered when the policy has rules for it
+func nftablesRuleset(policy types.Policy) (string, error) {
+	var rules []string
+	filterIPv6 := false
+	for _, rule := range policy.Rules {
+		r, ipv6, err := nftablesRule(rule)
+		if err != nil {
+			return "", err
+		}
+		rules = append(rules, r)
+		filterIPv6 = filterIPv6 || ipv6
+	}
+
+	var b strings.Builder
+	b.WriteString(nftablesFlushRuleset)
+	fmt.Fprintf(&b, "table %s {\n", nftablesTable)
+	b.WriteString("\tchain concerto {\n")
+	for _, r := range rules {
+		fmt.Fprintf(&b, "\t\t%s\n", r)
+	}
+	b.WriteString("\t}\n\n")
+	b.WriteString("\tchain input {\n")
+	b.WriteString("\t\ttype filter hook input priority 0; policy drop;\n")
+	b.WriteString("\t\tiifname \"lo\" accept\n")
+	b.WriteString("\t\tct state established,related accept\n")
+	if filterIPv6 {
+		// without neighbor discovery, no IPv6 traffic would get through
+		b.WriteString("\t\ticmpv6 type { nd-neighbor-solicit, nd-neighbor-advert, nd-router-advert } accept\n")
+	} else {
+		b.WriteString("\t\tmeta nfproto ipv6 accept\n")
+	}
+	b.WriteString("\t\tjump concerto\n")
+	b.WriteString("\t}\n")
+	b.WriteString("}\n")
+	return b.String(), nil
+}
+
+// nftablesRule returns the nftables rule letting in the traffic of a policy rule, and whether it is IPv6 traffic
+func nftablesRule(rule types.PolicyRule) (string, bool, error) {
+	hr, err := parseRule(rule)
+	if err != nil {
+		return "", false, err
+	}
+	family := "ip"
+	if hr.ipv6() {
+		family = "ip6"
+	}
+	if !hr.ports {
+		return fmt.Sprintf("%s saddr %s meta l4proto %s accept", family, hr.network, hr.protocol), hr.ipv6(), nil
+	}
+	ports := fmt.Sprintf("%d-%d", hr.minPort, hr.maxPort)
+	if hr.minPort == hr.maxPort {
+		ports = fmt.Sprint(hr.minPort)
+	}
+	return fmt.Sprintf("%s saddr %s %s dport %s accept", family, hr.network, hr.protocol, ports), hr.ipv6(), nil
+}
+
+// runNft applies a ruleset with nft, which does it atomically: either every change is applied, or none is
+func runNft(ruleset string) error {
+	return runWithInput(ruleset, "nft", "-f", "-")
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+//go:build linux
+// +build linux
+
+package firewall
+
+import (
+	"testing"
+
+	"github.com/ingrammicro/cio/api/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNftablesRuleset(t *testing.T) {
+	assert := assert.New(t)
+
+	policy := types.Policy{
+		Rules: []types.PolicyRule{
+			{Cidr: "0.0.0.0/0", Protocol: "tcp", MinPort: 22, MaxPort: 22},
+			{Cidr: "10.0.0.1/8", Protocol: "UDP", MinPort: 1000, MaxPort: 2000},
+			{Cidr: "192.168.1.10", Protocol: "icmp"},
+		},
+	}
+	ruleset, err := nftablesRuleset(policy)
+	assert.Nil(err)
+	assert.Equal(`table inet concerto
+delete table inet concerto
+table inet concerto {
+	chain concerto {
+		ip saddr 0.0.0.0/0 tcp dport 22 accept
+		ip saddr 10.0.0.0/8 udp dport 1000-2000 accept
+		ip saddr 192.168.1.10/32 meta l4proto icmp accept
+	}
+
+	chain input {
+		type filter hook input priority 0; policy drop;
+		iifname "lo" accept
+		ct state established,related accept
+		meta nfproto ipv6 accept
+		jump concerto
+	}
+}
+`, ruleset)
+}
+
+func TestNftablesRulesetIPv6(t *testing.T) {
+	assert := assert.New(t)
+
+	policy := types.Policy{
+		Rules: []types.PolicyRule{
+			{Cidr: "2001:db8::/32", Protocol: "tcp", MinPort: 443, MaxPort: 443},
+		},
+	}
+	ruleset, err := nftablesRuleset(policy)
+	assert.Nil(err)
+	assert.Contains(ruleset, "\t\tip6 saddr 2001:db8::/32 tcp dport 443 accept\n")
+	assert.NotContains(ruleset, "meta nfproto ipv6 accept", "IPv6 traffic should be filtered when there are rules for it")
+	assert.Contains(ruleset, "\t\ticmpv6 type { nd-neighbor-solicit, nd-neighbor-advert, nd-router-advert } accept\n",
+		"IPv6 neighbor discovery should be let in")
+}
+
+func TestNftablesRulesetInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	rules := []types.PolicyRule{
+		{Cidr: "10.0.0.0/33", Protocol: "tcp", MinPort: 22, MaxPort: 22},
+		{Cidr: "0.0.0.0/0; flush ruleset", Protocol: "tcp", MinPort: 22, MaxPort: 22},
+		{Cidr: "0.0.0.0/0", Protocol: "tcp accept;", MinPort: 22, MaxPort: 22},
+		{Cidr: "0.0.0.0/0", Protocol: "tcp", MinPort: 2000, MaxPort: 1000},
+		{Cidr: "0.0.0.0/0", Protocol: "udp", MinPort: 1, MaxPort: 70000},
+	}
+	for _, rule := range rules {
+		_, err := nftablesRuleset(types.Policy{Rules: []types.PolicyRule{rule}})
+		assert.NotNil(err, "Rule %+v should be rejected", rule)
+	}
+}
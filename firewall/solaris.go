@@ -75,3 +75,8 @@ func flush() error {
 	}
 	return nil
 }
+
+// snapshot returns no rollback, as it is not supported by this driver
+func snapshot() (func() error, error) {
+	return nil, nil
+}
@@ -36,6 +36,13 @@ func SubCommands() []cli.Command {
 			Name:   "apply",
 			Usage:  "Applies selected firewall rules in host",
 			Action: cmdApply,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "confirm-timeout",
+					Usage: "Seconds to wait for the API to be reachable after applying the rules, before rolling them back",
+					Value: DefaultConfirmTimeout,
+				},
+			},
 		},
 		{
 			Name:   "check",
@@ -14,6 +14,7 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists all existing clusters",
 			Action: cmd.ClusterList,
+			Flags:  cmd.PageFlags(),
 		},
 		{
 			Name:   "show",
@@ -30,7 +31,7 @@ func SubCommands() []cli.Command {
 			Name:   "create",
 			Usage:  "Creates a new cluster",
 			Action: cmd.ClusterCreate,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "name",
 					Usage: "Logical name of the cluster",
@@ -67,7 +68,7 @@ func SubCommands() []cli.Command {
 					Name:  "labels",
 					Usage: "A list of comma separated label names to be associated with cluster",
 				},
-			},
+			),
 		},
 		{
 			Name:   "update",
@@ -96,12 +97,12 @@ func SubCommands() []cli.Command {
 			Name:   "delete",
 			Usage:  "Deletes a cluster",
 			Action: cmd.ClusterDelete,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Cluster Id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "retry",
@@ -178,5 +179,6 @@ func SubCommands() []cli.Command {
 				},
 			},
 		},
+		cmd.WaitCommand("cluster"),
 	}
 }
@@ -14,12 +14,12 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists all existing node pools in a cluster",
 			Action: cmd.NodePoolList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Cluster Id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
@@ -36,7 +36,7 @@ func SubCommands() []cli.Command {
 			Name:   "create",
 			Usage:  "Creates a new node pool",
 			Action: cmd.NodePoolCreate,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Cluster Id",
@@ -78,7 +78,7 @@ func SubCommands() []cli.Command {
 					Name:  "pods-per-node",
 					Usage: "Amount of pods each node of the node pool will have if the node pool plan supports it",
 				},
-			},
+			),
 		},
 		{
 			Name:   "update",
@@ -111,12 +111,12 @@ func SubCommands() []cli.Command {
 			Name:   "delete",
 			Usage:  "Deletes a node pool",
 			Action: cmd.NodePoolDelete,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Node pool Id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "retry",
@@ -140,5 +140,6 @@ func SubCommands() []cli.Command {
 				},
 			},
 		},
+		cmd.WaitCommand("node pool"),
 	}
 }
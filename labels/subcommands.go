@@ -14,6 +14,7 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists the current labels existing in the platform for the user",
 			Action: cmd.LabelList,
+			Flags:  cmd.PageFlags(),
 		},
 	}
 }
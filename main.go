@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/ingrammicro/cio/agentsecret"
 	"github.com/ingrammicro/cio/audit"
@@ -16,7 +17,10 @@ import (
 	"github.com/ingrammicro/cio/cloud"
 	"github.com/ingrammicro/cio/cloudapplications"
 	"github.com/ingrammicro/cio/cloudspecificextensions"
+	"github.com/ingrammicro/cio/cmd"
 	"github.com/ingrammicro/cio/cmdpolling"
+	"github.com/ingrammicro/cio/completion"
+	"github.com/ingrammicro/cio/config"
 	"github.com/ingrammicro/cio/converge"
 	"github.com/ingrammicro/cio/dispatcher"
 	"github.com/ingrammicro/cio/firewall"
@@ -43,6 +47,11 @@ var serverCommands = []cli.Command{
 		Usage:       "Manages registration and configuration within an imported brownfield Host",
 		Subcommands: append(brownfield.SubCommands()),
 	},
+	{
+		Name:        "completion",
+		Usage:       "Prints shell completion scripts",
+		Subcommands: append(completion.SubCommands()),
+	},
 	{
 		Name:   "converge",
 		Usage:  "Converges Host to original Blueprint",
@@ -71,6 +80,12 @@ var serverCommands = []cli.Command{
 }
 
 var clientCommands = []cli.Command{
+	{
+		Name:   "apply",
+		Usage:  "Creates or updates the resources described by YAML manifests, so that they match them",
+		Action: cmd.Apply,
+		Flags:  cmd.ApplyFlags,
+	},
 	{
 		Name:        "blueprint",
 		ShortName:   "bl",
@@ -103,12 +118,36 @@ var clientCommands = []cli.Command{
 		Usage:       "Manages cloud specific extensions -CSEs- templates and deployments",
 		Subcommands: append(cloudspecificextensions.SubCommands()),
 	},
+	{
+		Name:        "completion",
+		ShortName:   "comp",
+		Usage:       "Prints shell completion scripts",
+		Subcommands: append(completion.SubCommands()),
+	},
+	{
+		Name:        "config",
+		ShortName:   "cfg",
+		Usage:       "Manages the CLI configuration and its contexts",
+		Subcommands: append(config.SubCommands()),
+	},
+	{
+		Name:   "diff",
+		Usage:  "Prints what applying YAML manifests would change, exiting with 3 if anything would",
+		Action: cmd.Diff,
+		Flags:  cmd.DiffFlags,
+	},
 	{
 		Name:        "events",
 		ShortName:   "ev",
 		Usage:       "Events allow the user to track their actions and the state of their servers",
 		Subcommands: append(audit.SubCommands()),
 	},
+	{
+		Name:   "export",
+		Usage:  "Writes YAML manifests of the existing resources, which can be applied to recreate them",
+		Action: cmd.Export,
+		Flags:  cmd.ExportFlags,
+	},
 	{
 		Name:        "labels",
 		ShortName:   "lbl",
@@ -177,6 +216,11 @@ var appFlags = []cli.Flag{
 		Name:   "concerto-endpoint",
 		Usage:  "Concerto Endpoint",
 	},
+	cli.StringFlag{
+		EnvVar: "CONCERTO_PROFILE",
+		Name:   "profile",
+		Usage:  "Context of the profiles file to use instead of the current one",
+	},
 	cli.StringFlag{
 		EnvVar: "CONCERTO_URL",
 		Name:   "concerto-url",
@@ -197,12 +241,102 @@ var appFlags = []cli.Flag{
 		Name:   "concerto-server-id",
 		Usage:  "Concerto Server ID",
 	},
+	cli.IntFlag{
+		EnvVar: "CONCERTO_RETRY_MAX_ATTEMPTS",
+		Name:   "retry-max-attempts",
+		Usage:  "Maximum number of attempts for failed API requests, 1 disables retries (default 5)",
+	},
+	cli.IntFlag{
+		EnvVar: "CONCERTO_RETRY_WAIT_MIN",
+		Name:   "retry-wait-min",
+		Usage:  "Wait -milliseconds- before retrying a failed API request for the first time (default 1000)",
+	},
+	cli.IntFlag{
+		EnvVar: "CONCERTO_RETRY_WAIT_MAX",
+		Name:   "retry-wait-max",
+		Usage:  "Maximum wait -milliseconds- between retries of a failed API request (default 30000)",
+	},
+	cli.Float64Flag{
+		EnvVar: "CONCERTO_RATE_LIMIT",
+		Name:   "rate-limit",
+		Usage:  "Maximum API requests per second, shared by every request of the process (unlimited by default)",
+	},
+	cli.IntFlag{
+		EnvVar: "CONCERTO_RATE_LIMIT_BURST",
+		Name:   "rate-limit-burst",
+		Usage:  "API requests which can be sent at once under the rate limit (default the rate limit, rounded up)",
+	},
+	cli.IntFlag{
+		EnvVar: "CONCERTO_MAX_CONCURRENT_REQUESTS",
+		Name:   "max-concurrent-requests",
+		Usage:  "Maximum API requests in flight at once, shared by every request of the process (unlimited by default)",
+	},
+	cli.StringFlag{
+		EnvVar: "CONCERTO_PROXY",
+		Name:   "proxy",
+		Usage:  "Proxy URL of API requests (default the one given by HTTPS_PROXY)",
+	},
+	cli.StringFlag{
+		EnvVar: "CONCERTO_NO_PROXY",
+		Name:   "no-proxy",
+		Usage:  "Comma separated hosts, domains and CIDR ranges reached without proxy (default the ones given by NO_PROXY)",
+	},
+	cli.IntFlag{
+		EnvVar: "CONCERTO_API_TIMEOUT",
+		Name:   "api-timeout",
+		Usage:  "Timeout of API requests, in seconds (default 30)",
+	},
+	cli.IntFlag{
+		EnvVar: "CONCERTO_FILE_TIMEOUT",
+		Name:   "file-timeout",
+		Usage:  "Timeout of file downloads and uploads, in seconds (default 3600)",
+	},
+	cli.StringFlag{
+		EnvVar: "CONCERTO_PINNED_CA",
+		Name:   "pinned-ca",
+		Usage:  "CA cert file the platform certificate is verified against when authenticating by token",
+	},
+	cli.StringFlag{
+		EnvVar: "CONCERTO_PINNED_FINGERPRINT",
+		Name:   "pinned-fingerprint",
+		Usage:  "SHA-256 fingerprint, in hexadecimal, the platform certificate must match when authenticating by token",
+	},
+	cli.StringFlag{
+		EnvVar: "CONCERTO_RECORD_FIXTURES",
+		Name:   "record-fixtures",
+		Usage:  "File where API requests, and the responses they get, are recorded as fixtures",
+	},
+	cli.StringFlag{
+		EnvVar: "CONCERTO_REPLAY_FIXTURES",
+		Name:   "replay-fixtures",
+		Usage:  "File of recorded fixtures answering API requests instead of the platform",
+	},
 	cli.StringFlag{
 		EnvVar: "CONCERTO_FORMATTER",
 		Name:   "formatter",
-		Usage:  "Output formatter [ text | json ] ",
+		Usage:  "Output formatter [ text | json | yaml | csv | ndjson | template | jsonpath=<template> ] ",
 		Value:  "text",
 	},
+	cli.StringFlag{
+		EnvVar: "CONCERTO_TEMPLATE",
+		Name:   "template",
+		Usage:  "Template used by the template and jsonpath formatters, e.g. '{{.ID}} {{.Name}}' or '{.items[*].id}'",
+	},
+	cli.StringFlag{
+		EnvVar: "CONCERTO_COLUMNS",
+		Name:   "columns",
+		Usage:  "A list of comma separated columns printed by the text and csv formatters for lists, e.g. ID,NAME,STATE",
+	},
+	cli.StringFlag{
+		EnvVar: "CONCERTO_SORT_BY",
+		Name:   "sort-by",
+		Usage:  "Column by which the text and csv formatters sort lists",
+	},
+	cli.BoolFlag{
+		EnvVar: "CONCERTO_WIDE",
+		Name:   "wide",
+		Usage:  "Print every column available in lists with the text formatter",
+	},
 }
 
 func excludeFlags(visibleFlags []cli.Flag, arr []string) (flags []cli.Flag) {
@@ -248,12 +382,23 @@ func prepareFlags(c *cli.Context) error {
 		return err
 	}
 
-	// validate formatter
-	if c.String("formatter") != "text" && c.String("formatter") != "json" {
-		log.Errorf("Unrecognized formatter %s. Please, use one of [ text | json ]", c.String("formatter"))
-		return fmt.Errorf("unrecognized formatter %s. Please, use one of [ text | json ]", c.String("formatter"))
+	// validate formatter, which defaults to the one of the profile in use
+	formatterSpec := c.String("formatter")
+	if !c.IsSet("formatter") && config.Formatter != "" {
+		formatterSpec = config.Formatter
 	}
-	format.InitializeFormatter(c.String("formatter"), os.Stdout)
+	if c.String("template") != "" && !strings.Contains(formatterSpec, "=") {
+		formatterSpec = fmt.Sprintf("%s=%s", formatterSpec, c.String("template"))
+	}
+	if err := format.InitializeFormatter(formatterSpec, os.Stdout); err != nil {
+		log.Errorf("Invalid formatter: %s", err)
+		return err
+	}
+	listOptions := format.ListOptions{SortBy: c.String("sort-by"), Wide: c.Bool("wide")}
+	if c.String("columns") != "" {
+		listOptions.Columns = strings.Split(c.String("columns"), ",")
+	}
+	format.SetListOptions(listOptions)
 
 	if config.IsAgentMode() {
 		log.Debug("Setting server commands to concerto")
@@ -301,6 +446,15 @@ func main() {
 
 	app.Before = prepareFlags
 
+	// completion of subcommands, flags and resource IDs
+	app.EnableBashCompletion = true
+	app.BashComplete = cmd.Complete
+	cmd.SetCompletions(clientCommands)
+	cmd.SetCompletions(serverCommands)
+
+	// resource names are accepted wherever IDs are
+	cmd.SetIDResolution(clientCommands)
+
 	err := app.Run(os.Args)
 	if err != nil {
 		log.Fatal(err)
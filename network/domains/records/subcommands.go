@@ -14,12 +14,12 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists all DNS records of a domain",
 			Action: cmd.DomainListRecords,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "domain-id",
 					Usage: "Identifier of the DNS domain",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
@@ -15,12 +15,12 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists all DNS domains",
 			Action: cmd.DomainList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "labels",
 					Usage: "A list of comma separated label as a query filter",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
@@ -37,7 +37,7 @@ func SubCommands() []cli.Command {
 			Name:   "create",
 			Usage:  "Creates a new DNS domain",
 			Action: cmd.DomainCreate,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "name",
 					Usage: "Name of the DNS domain",
@@ -50,18 +50,18 @@ func SubCommands() []cli.Command {
 					Name:  "labels",
 					Usage: "A list of comma separated label names to be associated with domain",
 				},
-			},
+			),
 		},
 		{
 			Name:   "delete",
 			Usage:  "Deletes a DNS domain",
 			Action: cmd.DomainDelete,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Domain Id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "retry",
@@ -79,5 +79,6 @@ func SubCommands() []cli.Command {
 			Usage:       "Provides information about DNS records",
 			Subcommands: append(records.SubCommands()),
 		},
+		cmd.WaitCommand("DNS domain"),
 	}
 }
@@ -14,7 +14,7 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists all existing floating IPs",
 			Action: cmd.FloatingIPList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "server-id",
 					Usage: "Identifier of a server to return only the floating IPs that are attached with that server",
@@ -23,7 +23,7 @@ func SubCommands() []cli.Command {
 					Name:  "labels",
 					Usage: "A list of comma separated label as a query filter",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
@@ -40,7 +40,7 @@ func SubCommands() []cli.Command {
 			Name:   "create",
 			Usage:  "Creates a new floating IP",
 			Action: cmd.FloatingIPCreate,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "name",
 					Usage: "Name of the floating IP",
@@ -57,7 +57,7 @@ func SubCommands() []cli.Command {
 					Name:  "labels",
 					Usage: "A list of comma separated label names to be associated with floating IP",
 				},
-			},
+			),
 		},
 		{
 			Name:   "update",
@@ -104,12 +104,12 @@ func SubCommands() []cli.Command {
 			Name:   "delete",
 			Usage:  "Deletes a floating IP",
 			Action: cmd.FloatingIPDelete,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Floating IP Id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "discard",
@@ -164,5 +164,6 @@ func SubCommands() []cli.Command {
 				},
 			},
 		},
+		cmd.WaitCommand("floating IP"),
 	}
 }
@@ -14,12 +14,12 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists all certificates of a load balancer",
 			Action: cmd.CertificateList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "load-balancer-id",
 					Usage: "Identifier of the load balancer",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
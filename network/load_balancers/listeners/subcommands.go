@@ -14,12 +14,12 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists all listeners of a load balancer",
 			Action: cmd.ListenerList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "load-balancer-id",
 					Usage: "Identifier of the load balancer",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
@@ -96,12 +96,12 @@ func SubCommands() []cli.Command {
 			Name:   "list-rules",
 			Usage:  "Lists all rules of a listener",
 			Action: cmd.ListenerListRules,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "listener-id",
 					Usage: "Identifier of the listener",
 				},
-			},
+			),
 		},
 		{
 			Name:   "create-rule",
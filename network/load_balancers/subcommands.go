@@ -17,6 +17,7 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists all existing load balancers",
 			Action: cmd.LoadBalancerList,
+			Flags:  cmd.PageFlags(),
 		},
 		{
 			Name:   "show",
@@ -33,7 +34,7 @@ func SubCommands() []cli.Command {
 			Name:   "create",
 			Usage:  "Creates a new load balancer",
 			Action: cmd.LoadBalancerCreate,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "name",
 					Usage: "Name of the load balancer",
@@ -58,7 +59,7 @@ func SubCommands() []cli.Command {
 					Name:  "labels",
 					Usage: "A list of comma separated label names to be associated with load balancer",
 				},
-			},
+			),
 		},
 		{
 			Name:   "update",
@@ -79,12 +80,12 @@ func SubCommands() []cli.Command {
 			Name:   "delete",
 			Usage:  "Deletes a load balancer",
 			Action: cmd.LoadBalancerDelete,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Load balancer Id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "retry",
@@ -165,5 +166,6 @@ func SubCommands() []cli.Command {
 				},
 			},
 		},
+		cmd.WaitCommand("load balancer"),
 	}
 }
@@ -14,12 +14,12 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists all target groups of a load balancer",
 			Action: cmd.TargetGroupList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "load-balancer-id",
 					Usage: "Identifier of the load balancer",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
@@ -152,12 +152,12 @@ func SubCommands() []cli.Command {
 			Name:   "list-targets",
 			Usage:  "Lists all targets in a target group",
 			Action: cmd.TargetGroupListTargets,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "target-group-id",
 					Usage: "Identifier of the target group",
 				},
-			},
+			),
 		},
 		{
 			Name:   "create-target",
@@ -14,12 +14,12 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists all Subnets of a VPC",
 			Action: cmd.SubnetList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "vpc-id",
 					Usage: "VPC Id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
@@ -85,23 +85,23 @@ func SubCommands() []cli.Command {
 			Name:   "list-servers",
 			Usage:  "Lists servers belonging to the subnet identified by the given id",
 			Action: cmd.SubnetServerList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Subnet Id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "list-server-arrays",
 			Usage:  "Lists server arrays belonging to the subnet identified by the given id",
 			Action: cmd.SubnetServerArrayList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Subnet Id",
 				},
-			},
+			),
 		},
 	}
 }
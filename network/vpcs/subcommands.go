@@ -14,12 +14,12 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists all existing VPCs",
 			Action: cmd.VPCList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "labels",
 					Usage: "A list of comma separated label as a query filter",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
@@ -36,7 +36,7 @@ func SubCommands() []cli.Command {
 			Name:   "create",
 			Usage:  "Creates a new VPC",
 			Action: cmd.VPCCreate,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "name",
 					Usage: "Name of the VPC",
@@ -57,7 +57,7 @@ func SubCommands() []cli.Command {
 					Name:  "labels",
 					Usage: "A list of comma separated label names to be associated with VPC",
 				},
-			},
+			),
 		},
 		{
 			Name:   "update",
@@ -78,12 +78,12 @@ func SubCommands() []cli.Command {
 			Name:   "delete",
 			Usage:  "Deletes a VPC",
 			Action: cmd.VPCDelete,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "VPC Id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "discard",
@@ -138,5 +138,6 @@ func SubCommands() []cli.Command {
 				},
 			},
 		},
+		cmd.WaitCommand("VPC"),
 	}
 }
@@ -63,12 +63,12 @@ func SubCommands() []cli.Command {
 			Name:   "list-plans",
 			Usage:  "Lists VPN plans of the specified VPC",
 			Action: cmd.VPNPlanList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "vpc-id",
 					Usage: "VPC Id",
 				},
-			},
+			),
 		},
 	}
 }
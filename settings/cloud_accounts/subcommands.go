@@ -14,6 +14,7 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists the cloud accounts of the account group.",
 			Action: cmd.CloudAccountList,
+			Flags:  cmd.PageFlags(),
 		},
 		{
 			Name:   "show",
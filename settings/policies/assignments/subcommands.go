@@ -14,12 +14,12 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "lists policy assignments for a given cloud account",
 			Action: cmd.PolicyAssignmentList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "cloud-account-id",
 					Usage: "Identifier of the cloud account",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
@@ -14,6 +14,7 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists policy definitions",
 			Action: cmd.PolicyDefinitionList,
+			Flags:  cmd.PageFlags(),
 		},
 		{
 			Name:   "show",
@@ -90,12 +91,12 @@ func SubCommands() []cli.Command {
 			Name:   "list-assignments",
 			Usage:  "lists policy assignments",
 			Action: cmd.PolicyDefinitionListAssignments,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Policy Id",
 				},
-			},
+			),
 		},
 	}
 }
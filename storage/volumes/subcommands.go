@@ -14,7 +14,7 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists all existing volumes",
 			Action: cmd.VolumeList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "server-id",
 					Usage: "Identifier of a server to return only the volumes that are attached with that server",
@@ -23,7 +23,7 @@ func SubCommands() []cli.Command {
 					Name:  "labels",
 					Usage: "A list of comma separated label as a query filter",
 				},
-			},
+			),
 		},
 		{
 			Name:   "show",
@@ -40,7 +40,7 @@ func SubCommands() []cli.Command {
 			Name:   "create",
 			Usage:  "Creates a new volume",
 			Action: cmd.VolumeCreate,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "name",
 					Usage: "Name of the volume",
@@ -61,7 +61,7 @@ func SubCommands() []cli.Command {
 					Name:  "labels",
 					Usage: "A list of comma separated label names to be associated with volume",
 				},
-			},
+			),
 		},
 		{
 			Name:   "update",
@@ -108,12 +108,12 @@ func SubCommands() []cli.Command {
 			Name:   "delete",
 			Usage:  "Deletes a volume",
 			Action: cmd.VolumeDelete,
-			Flags: []cli.Flag{
+			Flags: cmd.WaitFlags(
 				cli.StringFlag{
 					Name:  "id",
 					Usage: "Volume Id",
 				},
-			},
+			),
 		},
 		{
 			Name:   "discard",
@@ -168,5 +168,6 @@ func SubCommands() []cli.Command {
 				},
 			},
 		},
+		cmd.WaitCommand("volume"),
 	}
 }
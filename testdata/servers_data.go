@@ -20,6 +20,7 @@ func GetServerData() []*types.Server {
 			ServerPlanID:      "fakeServerPlanID0",
 			SSHProfileID:      "fakeSSHProfileID0",
 			FirewallProfileID: "fakeFirewallProfileID0",
+			CloudAccountID:    "fakeCloudAccountID0",
 		},
 		{
 			ID:                "fakeID1",
@@ -31,6 +32,7 @@ func GetServerData() []*types.Server {
 			ServerPlanID:      "fakeServerPlanID1",
 			SSHProfileID:      "fakeSSHProfileID1",
 			FirewallProfileID: "fakeFirewallProfileID1",
+			CloudAccountID:    "fakeCloudAccountID1",
 		},
 	}
 }
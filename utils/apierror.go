@@ -0,0 +1,117 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// RequestIDHeader is the header of the responses giving the ID the platform assigned to the request
+const RequestIDHeader = "X-Request-Id"
+
+// APIError is the error of an API request the platform failed, as described by the status code and body of its
+// response: the "error" message and the validation "errors" by field, if any
+type APIError struct {
+	StatusCode int                 `json:"status_code"`
+	Message    string              `json:"message,omitempty"`
+	Errors     map[string][]string `json:"errors,omitempty"`
+	RequestID  string              `json:"request_id,omitempty"`
+	Body       []byte              `json:"-"`
+}
+
+// NewAPIError returns the error described by the status code and body of a failed response. The request ID is taken
+// from the body, if given there
+func NewAPIError(status int, body []byte) *APIError {
+	apiError := &APIError{StatusCode: status, Body: body}
+
+	var content map[string]interface{}
+	if err := json.Unmarshal(body, &content); err != nil {
+		return apiError
+	}
+	if fieldErrors, ok := content["errors"].(map[string]interface{}); ok {
+		apiError.Errors = make(map[string][]string)
+		for field, value := range fieldErrors {
+			if messages, ok := value.([]interface{}); ok {
+				for _, message := range messages {
+					apiError.Errors[field] = append(apiError.Errors[field], fmt.Sprint(message))
+				}
+			} else {
+				apiError.Errors[field] = []string{fmt.Sprint(value)}
+			}
+		}
+	}
+	if message, ok := content["error"].(string); ok {
+		apiError.Message = message
+	}
+	if requestID, ok := content["request_id"].(string); ok {
+		apiError.RequestID = requestID
+	}
+	return apiError
+}
+
+// NewAPIErrorFromResponse is like NewAPIError, but the request ID is also taken from the headers of the response
+func NewAPIErrorFromResponse(response *http.Response, body []byte) *APIError {
+	apiError := NewAPIError(response.StatusCode, body)
+	if requestID := response.Header.Get(RequestIDHeader); requestID != "" {
+		apiError.RequestID = requestID
+	}
+	if apiError.Message == "" && len(apiError.Errors) == 0 && len(body) == 0 {
+		apiError.Message = response.Status
+	}
+	return apiError
+}
+
+// Error returns the validation errors, sorted by field, or else the message, or else the raw body
+func (e *APIError) Error() string {
+	detail := string(e.Body)
+	if len(e.Errors) > 0 {
+		fields := make([]string, 0, len(e.Errors))
+		for field := range e.Errors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		detail = ""
+		for _, field := range fields {
+			detail = fmt.Sprintf("%s#%s:%s", detail, field, strings.Join(e.Errors[field], ","))
+		}
+	} else if e.Message != "" {
+		detail = e.Message
+	}
+	return fmt.Sprintf("HTTP request failed: (%d) [%s]", e.StatusCode, detail)
+}
+
+// AsAPIError returns the API error err is, or wraps, if any
+func AsAPIError(err error) (*APIError, bool) {
+	var apiError *APIError
+	if errors.As(err, &apiError) {
+		return apiError, true
+	}
+	return nil, false
+}
+
+// hasStatus tells whether err is, or wraps, an API error with the given status code
+func hasStatus(err error, status int) bool {
+	apiError, ok := AsAPIError(err)
+	return ok && apiError.StatusCode == status
+}
+
+// IsNotFound tells whether err is, or wraps, the API error of a missing resource (404)
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict tells whether err is, or wraps, the API error of a request conflicting with the state of the resource
+// (409)
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsValidationError tells whether err is, or wraps, the API error of a request with invalid fields (422)
+func IsValidationError(err error) bool {
+	return hasStatus(err, http.StatusUnprocessableEntity)
+}
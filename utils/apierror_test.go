@@ -0,0 +1,73 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckStandardStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(CheckStandardStatus(200, []byte(`{"id":"1"}`)), "Successful responses should not be errors")
+
+	err := CheckStandardStatus(422, []byte(`{"errors":{"name":["taken","too short"],"plan_id":["invalid"]}}`))
+	apiError, ok := AsAPIError(err)
+	assert.True(ok, "Failed responses should be API errors")
+	assert.Equal(422, apiError.StatusCode)
+	assert.Equal(map[string][]string{"name": {"taken", "too short"}, "plan_id": {"invalid"}}, apiError.Errors)
+	assert.Equal("HTTP request failed: (422) [#name:taken,too short#plan_id:invalid]", err.Error())
+	assert.True(IsValidationError(err))
+	assert.False(IsNotFound(err))
+
+	err = CheckStandardStatus(404, []byte(`{"error":"Not found","request_id":"req-1"}`))
+	assert.Equal("HTTP request failed: (404) [Not found]", err.Error())
+	assert.True(IsNotFound(err))
+	assert.True(IsNotFound(fmt.Errorf("couldn't get server: %w", err)), "Wrapped API errors should be recognized")
+	apiError, _ = AsAPIError(err)
+	assert.Equal("req-1", apiError.RequestID)
+
+	err = CheckStandardStatus(409, []byte("conflict"))
+	assert.Equal("HTTP request failed: (409) [conflict]", err.Error(), "Raw bodies should be kept when not JSON")
+	assert.True(IsConflict(err))
+	apiError, _ = AsAPIError(err)
+	assert.Equal([]byte("conflict"), apiError.Body)
+
+	assert.False(IsNotFound(fmt.Errorf("HTTP request failed: (404) [Not found]")), "Only API errors should be recognized")
+	assert.False(IsNotFound(nil))
+}
+
+func TestNewAPIErrorFromResponse(t *testing.T) {
+	assert := assert.New(t)
+
+	response := &http.Response{StatusCode: 404, Status: "404 Not Found", Header: http.Header{}}
+	response.Header.Set(RequestIDHeader, "req-2")
+	apiError := NewAPIErrorFromResponse(response, nil)
+	assert.Equal("req-2", apiError.RequestID, "The request ID should be taken from the headers")
+	assert.Equal("HTTP request failed: (404) [404 Not Found]", apiError.Error())
+}
+
+func TestAPIErrorRequestIDFromHeaders(t *testing.T) {
+	assert := assert.New(t)
+
+	hcs, server := newRetryTestService(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(RequestIDHeader, "req-3")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"errors":{"name":["taken"]}}`))
+	})
+	defer server.Close()
+
+	data, status, err := hcs.Get("/cloud/servers")
+	assert.Equal(http.StatusUnprocessableEntity, status)
+	assert.Equal(`{"errors":{"name":["taken"]}}`, string(data), "The body should be returned as it was sent")
+	apiError, ok := AsAPIError(err)
+	if assert.True(ok, "Failed responses should be API errors") {
+		assert.Equal("req-3", apiError.RequestID, "The request ID should be taken from the headers")
+		assert.Equal(map[string][]string{"name": {"taken"}}, apiError.Errors)
+		assert.Equal(data, apiError.Body)
+	}
+}
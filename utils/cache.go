@@ -0,0 +1,78 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// FileCache stores JSON encoded values as files of a directory, which are only read back until they expire
+type FileCache struct {
+	dir string
+	ttl time.Duration
+}
+
+// NewFileCache creates a new FileCache whose values expire after ttl
+func NewFileCache(dir string, ttl time.Duration) *FileCache {
+	return &FileCache{
+		dir: dir,
+		ttl: ttl,
+	}
+}
+
+func (fc *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(fc.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get decodes the value stored for key into v, returning whether there was a value not expired yet
+func (fc *FileCache) Get(key string, v interface{}) bool {
+	file := fc.path(key)
+	fi, err := os.Stat(file)
+	if err != nil || time.Since(fi.ModTime()) > fc.ttl {
+		return false
+	}
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		log.Debugf("Ignoring corrupted cache file %s: %v", file, err)
+		return false
+	}
+	return true
+}
+
+// Set stores the value for key. Cache files are only readable by the current user
+func (fc *FileCache) Set(key string, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(fc.dir, 0700); err != nil {
+		return err
+	}
+
+	// written aside and renamed, so that concurrent readers never see partial values
+	tmp, err := ioutil.TempFile(fc.dir, "tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), fc.path(key))
+}
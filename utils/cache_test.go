@@ -0,0 +1,58 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileCache(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := filepath.Join(t.TempDir(), "cache")
+	cache := NewFileCache(dir, time.Minute)
+
+	var values []string
+	assert.False(cache.Get("key", &values), "Empty cache shouldn't have values")
+
+	assert.Nil(cache.Set("key", []string{"a", "b"}))
+	assert.True(cache.Get("key", &values), "Cache should have the value just set")
+	assert.Equal([]string{"a", "b"}, values)
+	assert.False(cache.Get("other", &values), "Cache shouldn't have values for other keys")
+
+	files, err := os.ReadDir(dir)
+	assert.Nil(err)
+	assert.Len(files, 1, "Cache should have a single file, with no temporary ones left")
+	fi, err := os.Stat(filepath.Join(dir, files[0].Name()))
+	assert.Nil(err)
+	assert.Equal(os.FileMode(0600), fi.Mode().Perm(), "Cache files should only be readable by their owner")
+}
+
+func TestFileCacheExpiry(t *testing.T) {
+	assert := assert.New(t)
+
+	cache := NewFileCache(t.TempDir(), time.Minute)
+	assert.Nil(cache.Set("key", "value"))
+
+	old := time.Now().Add(-2 * time.Minute)
+	assert.Nil(os.Chtimes(cache.path("key"), old, old))
+
+	var value string
+	assert.False(cache.Get("key", &value), "Expired values shouldn't be returned")
+}
+
+func TestFileCacheCorrupted(t *testing.T) {
+	assert := assert.New(t)
+
+	cache := NewFileCache(t.TempDir(), time.Minute)
+	assert.Nil(cache.Set("key", "value"))
+	assert.Nil(os.WriteFile(cache.path("key"), []byte("{"), 0600))
+
+	var value string
+	assert.False(cache.Get("key", &value), "Corrupted values shouldn't be returned")
+}
@@ -38,37 +38,48 @@ const nixServerKeyPath = "/etc/cio/client_ssl/private/key.pem"
 
 // Config stores configuration file contents
 type Config struct {
-	XMLName              xml.Name        `xml:"concerto"`
-	APIEndpoint          string          `xml:"server,attr"`
-	LogFile              string          `xml:"log_file,attr"`
-	LogLevel             string          `xml:"log_level,attr"`
-	Certificate          Cert            `xml:"ssl"`
-	BootstrapConfig      BootstrapConfig `xml:"bootstrap"`
-	ConfLocation         string
-	ConfFile             string
-	confFileLastLoadedAt time.Time
-	IsHost               bool
-	ConcertoURL          string
-	BrownfieldToken      string
-	CommandPollingToken  string
-	ServerID             string
-	CurrentUserName      string
-	CurrentUserIsAdmin   bool
+	XMLName              xml.Name        `xml:"concerto"                json:"-"                   show:"noshow"`
+	APIEndpoint          string          `xml:"server,attr"             json:"server"              header:"SERVER"`
+	LogFile              string          `xml:"log_file,attr"           json:"log_file"            header:"LOG_FILE"`
+	LogLevel             string          `xml:"log_level,attr"          json:"log_level"           header:"LOG_LEVEL"`
+	Certificate          Cert            `xml:"ssl"                     json:"ssl"`
+	BootstrapConfig      BootstrapConfig `xml:"bootstrap"               json:"bootstrap"`
+	Retry                RetryConfig     `xml:"retry"                   json:"retry"`
+	RateLimit            RateLimitConfig `xml:"rate_limit"              json:"rate_limit"`
+	Transport            TransportConfig `xml:"transport"               json:"transport"`
+	ConfLocation         string          `json:"conf_location"          header:"CONF_LOCATION"`
+	ConfFile             string          `json:"conf_file"              header:"CONF_FILE"`
+	ProfilesFile         string          `json:"profiles_file"          header:"PROFILES_FILE"`
+	Profile              string          `json:"profile"                header:"PROFILE"`
+	Formatter            string          `json:"formatter"              header:"FORMATTER"`
+	RecordFixtures       string          `json:"record_fixtures"        header:"RECORD_FIXTURES"`
+	ReplayFixtures       string          `json:"replay_fixtures"        header:"REPLAY_FIXTURES"`
+	confFileLastLoadedAt time.Time       `show:"noshow"`
+	IsHost               bool            `json:"is_host"                header:"IS_HOST"`
+	ConcertoURL          string          `json:"concerto_url"           header:"CONCERTO_URL"`
+	BrownfieldToken      string          `json:"brownfield_token"       header:"BROWNFIELD_TOKEN"`
+	CommandPollingToken  string          `json:"command_polling_token"  header:"COMMAND_POLLING_TOKEN"`
+	ServerID             string          `json:"server_id"              header:"SERVER_ID"`
+	CurrentUserName      string          `json:"current_user_name"      header:"CURRENT_USER_NAME"`
+	CurrentUserIsAdmin   bool            `json:"current_user_is_admin"  header:"CURRENT_USER_IS_ADMIN"`
 }
 
-// Cert stores cert files location
+// Cert stores cert files location, and the CA file and fingerprint the platform certificate is pinned to when
+// authenticating by token
 type Cert struct {
-	Cert string `xml:"cert,attr"`
-	Key  string `xml:"key,attr"`
-	Ca   string `xml:"server_ca,attr"`
+	Cert        string `xml:"cert,attr"        json:"cert,omitempty"        header:"CERT"        show:"nolist"`
+	Key         string `xml:"key,attr"         json:"key,omitempty"         header:"KEY"         show:"nolist"`
+	Ca          string `xml:"server_ca,attr"   json:"server_ca,omitempty"   header:"CA_CERT"     show:"nolist"`
+	PinnedCa    string `xml:"pinned_ca,attr"   json:"pinned_ca,omitempty"   header:"PINNED_CA"   show:"nolist"`
+	Fingerprint string `xml:"fingerprint,attr" json:"fingerprint,omitempty" header:"FINGERPRINT" show:"nolist"`
 }
 
 // BootstrapConfig stores configuration specific to the bootstrap command
 type BootstrapConfig struct {
-	IntervalSeconds      int  `xml:"interval,attr"`
-	SplaySeconds         int  `xml:"splay,attr"`
-	ApplyAfterIterations int  `xml:"apply_after_iterations,attr"`
-	RunOnce              bool `xml:"run_once,attr"`
+	IntervalSeconds      int  `xml:"interval,attr" json:"interval" header:"BOOTSTRAP_INTERVAL"`
+	SplaySeconds         int  `xml:"splay,attr" json:"splay" header:"BOOTSTRAP_SPLAY"`
+	ApplyAfterIterations int  `xml:"apply_after_iterations,attr" json:"apply_after_iterations" header:"BOOTSTRAP_APPLY_AFTER"`
+	RunOnce              bool `xml:"run_once,attr" json:"run_once" header:"BOOTSTRAP_RUN_ONCE"`
 }
 
 var cachedConfig *Config
@@ -260,6 +271,11 @@ func (config *Config) readConcertoConfig(c *cli.Context) error {
 		log.Debugf("Configuration File %s does not exist. Reading environment variables", config.ConfFile)
 	}
 
+	// overwrite with the selected profile, if any
+	if err := config.applyProfile(c); err != nil {
+		return err
+	}
+
 	// overwrite with environment/arguments vars
 	if overwEP := c.String("concerto-endpoint"); overwEP != "" {
 		log.Debug("Concerto APIEndpoint taken from env/args")
@@ -281,6 +297,76 @@ func (config *Config) readConcertoConfig(c *cli.Context) error {
 		config.Certificate.Ca = overwCa
 	}
 
+	if overwRetryMaxAttempts := c.Int("retry-max-attempts"); overwRetryMaxAttempts > 0 {
+		log.Debug("Retry max attempts taken from env/args")
+		config.Retry.MaxAttempts = overwRetryMaxAttempts
+	}
+
+	if overwRetryWaitMin := c.Int("retry-wait-min"); overwRetryWaitMin > 0 {
+		log.Debug("Retry minimum wait taken from env/args")
+		config.Retry.WaitMin = overwRetryWaitMin
+	}
+
+	if overwRetryWaitMax := c.Int("retry-wait-max"); overwRetryWaitMax > 0 {
+		log.Debug("Retry maximum wait taken from env/args")
+		config.Retry.WaitMax = overwRetryWaitMax
+	}
+
+	if overwRateLimit := c.Float64("rate-limit"); overwRateLimit > 0 {
+		log.Debug("Rate limit taken from env/args")
+		config.RateLimit.RequestsPerSecond = overwRateLimit
+	}
+
+	if overwRateLimitBurst := c.Int("rate-limit-burst"); overwRateLimitBurst > 0 {
+		log.Debug("Rate limit burst taken from env/args")
+		config.RateLimit.Burst = overwRateLimitBurst
+	}
+
+	if overwMaxConcurrentRequests := c.Int("max-concurrent-requests"); overwMaxConcurrentRequests > 0 {
+		log.Debug("Maximum concurrent requests taken from env/args")
+		config.RateLimit.MaxConcurrent = overwMaxConcurrentRequests
+	}
+
+	if overwProxy := c.String("proxy"); overwProxy != "" {
+		log.Debug("Proxy taken from env/args")
+		config.Transport.Proxy = overwProxy
+	}
+
+	if overwNoProxy := c.String("no-proxy"); overwNoProxy != "" {
+		log.Debug("Hosts excluded from proxy taken from env/args")
+		config.Transport.NoProxy = overwNoProxy
+	}
+
+	if overwAPITimeout := c.Int("api-timeout"); overwAPITimeout > 0 {
+		log.Debug("API requests timeout taken from env/args")
+		config.Transport.APITimeout = overwAPITimeout
+	}
+
+	if overwFileTimeout := c.Int("file-timeout"); overwFileTimeout > 0 {
+		log.Debug("File transfers timeout taken from env/args")
+		config.Transport.FileTimeout = overwFileTimeout
+	}
+
+	if overwPinnedCa := c.String("pinned-ca"); overwPinnedCa != "" {
+		log.Debug("Pinned CA cert taken from env/args")
+		config.Certificate.PinnedCa = overwPinnedCa
+	}
+
+	if overwFingerprint := c.String("pinned-fingerprint"); overwFingerprint != "" {
+		log.Debug("Pinned certificate fingerprint taken from env/args")
+		config.Certificate.Fingerprint = overwFingerprint
+	}
+
+	if overwRecordFixtures := c.String("record-fixtures"); overwRecordFixtures != "" {
+		log.Debug("Fixtures recording file taken from env/args")
+		config.RecordFixtures = overwRecordFixtures
+	}
+
+	if overwReplayFixtures := c.String("replay-fixtures"); overwReplayFixtures != "" {
+		log.Debug("Fixtures replaying file taken from env/args")
+		config.ReplayFixtures = overwReplayFixtures
+	}
+
 	// if endpoint empty set default
 	// we can't set the default from flags, because it would overwrite config file
 	if config.APIEndpoint == "" {
@@ -357,6 +443,7 @@ func (config *Config) evaluateConcertoConfigFile(c *cli.Context) error {
 
 	}
 	config.ConfLocation = path.Dir(config.ConfFile)
+	config.ProfilesFile = filepath.Join(config.ConfLocation, profilesFileName)
 	return nil
 }
 
@@ -0,0 +1,159 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Statuses of the configuration checks
+const (
+	ConfigCheckOK      = "ok"
+	ConfigCheckWarning = "warning"
+	ConfigCheckError   = "error"
+)
+
+// certificateExpiryWarning is how long before the certificates expire their checks start warning about it
+const certificateExpiryWarning = 30 * 24 * time.Hour
+
+// ConfigCheck stores the result of one of the checks run by ValidateConfig
+type ConfigCheck struct {
+	Name    string `json:"name"    header:"CHECK"`
+	Status  string `json:"status"  header:"STATUS"`
+	Message string `json:"message" header:"MESSAGE"`
+}
+
+// ConfigChecksFailed returns how many checks ended up in error
+func ConfigChecksFailed(checks []ConfigCheck) int {
+	failed := 0
+	for _, check := range checks {
+		if check.Status == ConfigCheckError {
+			failed++
+		}
+	}
+	return failed
+}
+
+// ValidateConfig checks that the configured certificate, key and CA certificate are readable, valid and match each
+// other, and that the API endpoint is reachable with them
+func ValidateConfig(ctx context.Context, config *Config) []ConfigCheck {
+	log.Debug("ValidateConfig")
+
+	checks := make([]ConfigCheck, 0)
+	if config.IsConfigReady() {
+		checks = append(checks, ConfigCheck{"configuration", ConfigCheckOK, config.ConfFile})
+	} else {
+		checks = append(checks, ConfigCheck{
+			"configuration",
+			ConfigCheckError,
+			fmt.Sprintf("%s: server, cert, key and server_ca must be set", ConfigurationIsIncomplete),
+		})
+	}
+
+	checks = append(checks, checkCertificateFile("ca_cert", config.Certificate.Ca))
+	checks = append(checks, checkCertificateFile("cert", config.Certificate.Cert))
+	checks = append(checks, checkKeyPair(config.Certificate.Cert, config.Certificate.Key))
+	checks = append(checks, checkEndpoint(ctx, config, ConfigChecksFailed(checks) == 0))
+	return checks
+}
+
+// checkCertificateFile checks the certificates of a PEM file, reporting the earliest expiry
+func checkCertificateFile(name string, file string) ConfigCheck {
+	if file == "" {
+		return ConfigCheck{name, ConfigCheckError, "not configured"}
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return ConfigCheck{name, ConfigCheckError, fmt.Sprintf("cannot read %s: %v", file, err)}
+	}
+
+	var expiring *x509.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return ConfigCheck{name, ConfigCheckError, fmt.Sprintf("cannot parse %s: %v", file, err)}
+		}
+		if expiring == nil || cert.NotAfter.Before(expiring.NotAfter) {
+			expiring = cert
+		}
+	}
+	if expiring == nil {
+		return ConfigCheck{name, ConfigCheckError, fmt.Sprintf("no PEM certificate found in %s", file)}
+	}
+	return checkCertificateExpiry(name, file, expiring, time.Now())
+}
+
+// checkCertificateExpiry checks that a certificate is valid at the given time, warning when it is about to expire
+func checkCertificateExpiry(name string, file string, cert *x509.Certificate, now time.Time) ConfigCheck {
+	if now.Before(cert.NotBefore) {
+		return ConfigCheck{
+			name,
+			ConfigCheckError,
+			fmt.Sprintf("%s is not valid until %s", file, cert.NotBefore.Format(time.RFC3339)),
+		}
+	}
+	if now.After(cert.NotAfter) {
+		return ConfigCheck{
+			name,
+			ConfigCheckError,
+			fmt.Sprintf("%s expired on %s", file, cert.NotAfter.Format(time.RFC3339)),
+		}
+	}
+	msg := fmt.Sprintf("%s expires on %s", file, cert.NotAfter.Format(time.RFC3339))
+	if cert.NotAfter.Sub(now) < certificateExpiryWarning {
+		return ConfigCheck{name, ConfigCheckWarning, msg}
+	}
+	return ConfigCheck{name, ConfigCheckOK, msg}
+}
+
+// checkKeyPair checks that the key is readable and matches the certificate
+func checkKeyPair(certFile string, keyFile string) ConfigCheck {
+	if keyFile == "" {
+		return ConfigCheck{"key", ConfigCheckError, "not configured"}
+	}
+	if _, err := ioutil.ReadFile(keyFile); err != nil {
+		return ConfigCheck{"key", ConfigCheckError, fmt.Sprintf("cannot read %s: %v", keyFile, err)}
+	}
+	if _, err := tls.LoadX509KeyPair(certFile, keyFile); err != nil {
+		return ConfigCheck{"key", ConfigCheckError, fmt.Sprintf("%s doesn't match %s: %v", keyFile, certFile, err)}
+	}
+	return ConfigCheck{"key", ConfigCheckOK, fmt.Sprintf("%s matches %s", keyFile, certFile)}
+}
+
+// checkEndpoint checks that the API endpoint answers using the configured certificates. Any HTTP response is taken as
+// reachable, as authorization is out of the scope of the check
+func checkEndpoint(ctx context.Context, config *Config, ready bool) ConfigCheck {
+	if !ready {
+		return ConfigCheck{"endpoint", ConfigCheckError, "skipped, as the configuration is not valid"}
+	}
+	hcs, err := NewHTTPConcertoService(config)
+	if err != nil {
+		return ConfigCheck{"endpoint", ConfigCheckError, err.Error()}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, config.APIEndpoint, nil)
+	if err != nil {
+		return ConfigCheck{"endpoint", ConfigCheckError, err.Error()}
+	}
+	res, err := hcs.client.Do(req)
+	if err != nil {
+		return ConfigCheck{"endpoint", ConfigCheckError, fmt.Sprintf("%s is not reachable: %v", config.APIEndpoint, err)}
+	}
+	res.Body.Close()
+	return ConfigCheck{
+		"endpoint",
+		ConfigCheckOK,
+		fmt.Sprintf("%s is reachable (HTTP status %d)", config.APIEndpoint, res.StatusCode),
+	}
+}
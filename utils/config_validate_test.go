@@ -0,0 +1,123 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeTestKeyPair writes a self-signed certificate valid from notBefore to notAfter, and its key, into dir
+func writeTestKeyPair(t *testing.T, dir string, name string, notBefore time.Time, notAfter time.Time) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err, "Couldn't generate key")
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err, "Couldn't create certificate")
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err, "Couldn't marshal key")
+
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	assert.Nil(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.Nil(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return certFile, keyFile
+}
+
+func configCheck(checks []ConfigCheck, name string) ConfigCheck {
+	for _, check := range checks {
+		if check.Name == name {
+			return check
+		}
+	}
+	return ConfigCheck{}
+}
+
+func TestValidateConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	assert.Nil(os.WriteFile(
+		caFile,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+		0600,
+	))
+	certFile, keyFile := writeTestKeyPair(t, dir, "client", time.Now().Add(-time.Hour), time.Now().AddDate(1, 0, 0))
+
+	config := &Config{
+		APIEndpoint: server.URL,
+		Certificate: Cert{Cert: certFile, Key: keyFile, Ca: caFile},
+	}
+	checks := ValidateConfig(context.Background(), config)
+	assert.Len(checks, 5)
+	assert.Equal(0, ConfigChecksFailed(checks), "Configuration should be valid: %+v", checks)
+	assert.Contains(configCheck(checks, "endpoint").Message, "HTTP status 401")
+}
+
+func TestValidateConfigErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	certFile, _ := writeTestKeyPair(t, dir, "client", time.Now().Add(-time.Hour), time.Now().AddDate(1, 0, 0))
+	_, otherKeyFile := writeTestKeyPair(t, dir, "other", time.Now().Add(-time.Hour), time.Now().AddDate(1, 0, 0))
+
+	config := &Config{
+		APIEndpoint: "https://127.0.0.1:1/v3",
+		Certificate: Cert{Cert: certFile, Key: otherKeyFile, Ca: filepath.Join(dir, "missing.pem")},
+	}
+	checks := ValidateConfig(context.Background(), config)
+	assert.Equal(ConfigCheckOK, configCheck(checks, "configuration").Status)
+	assert.Equal(ConfigCheckError, configCheck(checks, "ca_cert").Status, "Missing CA should be an error")
+	assert.Equal(ConfigCheckOK, configCheck(checks, "cert").Status)
+	assert.Equal(ConfigCheckError, configCheck(checks, "key").Status, "Mismatching key should be an error")
+	assert.Equal(ConfigCheckError, configCheck(checks, "endpoint").Status, "Endpoint check should be skipped")
+	assert.Equal(3, ConfigChecksFailed(checks))
+
+	checks = ValidateConfig(context.Background(), &Config{})
+	assert.Equal(ConfigCheckError, configCheck(checks, "configuration").Status, "Empty configuration is incomplete")
+}
+
+func TestCheckCertificateExpiry(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Now()
+	cert := &x509.Certificate{NotBefore: now.Add(-time.Hour), NotAfter: now.AddDate(0, 0, 90)}
+	assert.Equal(ConfigCheckOK, checkCertificateExpiry("cert", "cert.pem", cert, now).Status)
+	assert.Equal(ConfigCheckWarning, checkCertificateExpiry("cert", "cert.pem", cert, now.AddDate(0, 0, 80)).Status)
+	assert.Equal(ConfigCheckError, checkCertificateExpiry("cert", "cert.pem", cert, now.AddDate(0, 0, 91)).Status)
+	assert.Equal(ConfigCheckError, checkCertificateExpiry("cert", "cert.pem", cert, now.Add(-2*time.Hour)).Status)
+}
+
+func TestCheckCertificateFileWithoutCertificates(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	_, keyFile := writeTestKeyPair(t, dir, "client", time.Now().Add(-time.Hour), time.Now().AddDate(1, 0, 0))
+	assert.Equal(ConfigCheckError, checkCertificateFile("cert", keyFile).Status)
+	assert.Equal(ConfigCheckError, checkCertificateFile("cert", "").Status)
+}
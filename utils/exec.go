@@ -5,6 +5,8 @@ package utils
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -12,6 +14,7 @@ import (
 	"os/exec"
 	"runtime"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -21,14 +24,21 @@ import (
 const (
 	TimeStampLayout          = "2006-01-02T15:04:05.000000-07:00"
 	TimeLayoutYYYYMMDDHHMMSS = "20060102150405"
-	RetriesFactor            = 3
 )
 
+// TimedOutExitCode is the exit code of the commands killed for running longer than their timeout, as given by the
+// timeout utility
+const TimedOutExitCode = 124
+
 const shellPath = "/bin/sh"
 
 const startingTimeMsg = "Starting Time: %s"
 const endTimeMsg = "End Time: %s"
 const exitCodeMsg = "Exit Code: %d"
+const timedOutMsg = "\n[killed on timeout, after running for %s]\n"
+
+// outputDrainTime is how long the output of commands is still read once they are gone
+const outputDrainTime = time.Second
 
 func extractExitCode(err error) int {
 	if err != nil {
@@ -195,7 +205,39 @@ func deleteTmpCommandFilename(cmdFileName string) {
 func RunTracedCmd(
 	command string,
 ) (exitCode int, stdOut string, stdErr string, startedAt time.Time, finishedAt time.Time) {
-	log.Debug("RunTracedCmd")
+	return RunTracedCmdContext(context.Background(), command, 0)
+}
+
+// CmdStreaming tells RunStreamedCmdContext how to report the output of commands while they run
+type CmdStreaming struct {
+	// Report is given the chunks of each output stream, named "stdout" or "stderr"
+	Report func(stream string, chunk string) error
+	// ThresholdTime is the maximum time the output is held before being reported
+	ThresholdTime time.Duration
+	// MaxOutput is the size, in bytes, past which the output of each stream is dropped, if positive
+	MaxOutput int
+}
+
+// RunTracedCmdContext is like RunTracedCmd, but the command is killed, along with every process it started, as soon as
+// ctx is done or, if positive, the timeout expires. Commands killed on timeout exit with TimedOutExitCode, and a note
+// telling so is appended to their error output
+func RunTracedCmdContext(
+	ctx context.Context,
+	command string,
+	timeout time.Duration,
+) (exitCode int, stdOut string, stdErr string, startedAt time.Time, finishedAt time.Time) {
+	return RunStreamedCmdContext(ctx, command, timeout, nil)
+}
+
+// RunStreamedCmdContext is like RunTracedCmdContext, but the output is also reported, in chunks, as the command writes
+// it, and capped, as told by streaming, if given
+func RunStreamedCmdContext(
+	ctx context.Context,
+	command string,
+	timeout time.Duration,
+	streaming *CmdStreaming,
+) (exitCode int, stdOut string, stdErr string, startedAt time.Time, finishedAt time.Time) {
+	log.Debug("RunStreamedCmdContext")
 
 	// Saves script/command in a temp file
 	var cmd, cmdFileName = createCommandWithFilename(command)
@@ -203,50 +245,75 @@ func RunTracedCmd(
 	// Removes temp file
 	defer deleteTmpCommandFilename(cmdFileName)
 
-	stdoutIn, err := cmd.StdoutPipe()
-	if err != nil {
-		log.Error("cmd.StdoutPipe() failed: ", err)
+	// the whole tree is killed on timeout, as processes started by the command may hold its output open
+	startInProcessGroup(cmd)
+	stdoutStream := &outputStream{echo: os.Stdout}
+	stderrStream := &outputStream{echo: os.Stderr}
+	if streaming != nil {
+		stdoutStream.maxSize, stderrStream.maxSize = streaming.MaxOutput, streaming.MaxOutput
+		stdoutStream.report = func(chunk string) error {
+			return streaming.Report("stdout", chunk)
+		}
+		stderrStream.report = func(chunk string) error {
+			return streaming.Report("stderr", chunk)
+		}
 	}
 
-	stderrIn, err := cmd.StderrPipe()
-	if err != nil {
-		log.Error("cmd.StderrPipe() failed: ", err)
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
 	}
 
-	var errStdout, errStderr error
-	var stdoutBuf, stderrBuf bytes.Buffer
-	stdout := io.MultiWriter(os.Stdout, &stdoutBuf)
-	stderr := io.MultiWriter(os.Stderr, &stderrBuf)
-
-	if err = cmd.Start(); err != nil {
+	startedAt = time.Now()
+	outputs, err := startWithOutputs(cmd, stdoutStream, stderrStream)
+	if err != nil {
 		log.Error("cmd.Start() failed: ", err)
+	} else {
+		// output is reported every threshold time, even if the command writes no more for a while
+		stopShipping := func() {}
+		if streaming != nil && streaming.ThresholdTime > 0 {
+			stopShipping = shipOutput(streaming.ThresholdTime, stdoutStream, stderrStream)
+		}
+		done := make(chan error, 1)
+		go func() {
+			done <- cmd.Wait()
+		}()
+		select {
+		case err = <-done:
+		case <-ctx.Done():
+			log.Warn("Killing the command: ", ctx.Err())
+			if killErr := killProcessTree(cmd); killErr != nil {
+				log.Error("Cannot kill the command: ", killErr)
+			}
+			select {
+			case err = <-done:
+			case <-time.After(outputDrainTime):
+				log.Error("The command didn't end once killed")
+				err = ctx.Err()
+			}
+		}
+		if err != nil {
+			log.Error("cmd.Wait() failed: ", err)
+		}
+		outputs.close()
+		stopShipping()
 	}
+	finishedAt = time.Now()
 
-	go func() {
-		_, errStdout = io.Copy(stdout, stdoutIn)
-	}()
-
-	go func() {
-		_, errStderr = io.Copy(stderr, stderrIn)
-	}()
-
-	if err = cmd.Wait(); err != nil {
-		log.Error("cmd.Wait() failed: ", err)
+	exitCode = extractExitCode(err)
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		exitCode = TimedOutExitCode
+		stderrStream.note(fmt.Sprintf(timedOutMsg, finishedAt.Sub(startedAt).Round(time.Millisecond)))
 	}
-
-	if errStdout != nil {
-		log.Error("failed to capture stdout: ", errStdout)
+	if err := stdoutStream.ship(); err != nil {
+		log.Error("Cannot report the last output chunk: ", err)
 	}
-
-	if errStderr != nil {
-		log.Error("failed to capture stderr: ", errStderr)
+	if err := stderrStream.ship(); err != nil {
+		log.Error("Cannot report the last error output chunk: ", err)
 	}
-
-	exitCode = extractExitCode(err)
-	stdOut = string(stdoutBuf.Bytes())
-	stdErr = string(stderrBuf.Bytes())
-	startedAt = time.Now()
-	finishedAt = time.Now()
+	stdOut = stdoutStream.String()
+	stdErr = stderrStream.String()
 
 	log.Infof(exitCodeMsg, exitCode)
 	log.Debugf("Stdout: %s", stdOut)
@@ -256,6 +323,65 @@ func RunTracedCmd(
 	return
 }
 
+// commandOutputs are the pipes the output of a command is read from
+type commandOutputs struct {
+	readers []*os.File
+	copying sync.WaitGroup
+}
+
+// startWithOutputs starts the command writing its output to pipes, which are copied to the given writers. The command
+// is given the pipes themselves, so that waiting for it doesn't wait for the processes it leaves running, such as
+// daemons, which may hold them open
+func startWithOutputs(cmd *exec.Cmd, stdout io.Writer, stderr io.Writer) (*commandOutputs, error) {
+	outputs := &commandOutputs{}
+	writers := make([]*os.File, 0, 2)
+	defer func() {
+		for _, w := range writers {
+			w.Close()
+		}
+	}()
+	for _, dst := range []io.Writer{stdout, stderr} {
+		r, w, err := os.Pipe()
+		if err != nil {
+			outputs.close()
+			return nil, err
+		}
+		outputs.readers = append(outputs.readers, r)
+		writers = append(writers, w)
+		outputs.copying.Add(1)
+		go func(dst io.Writer, r *os.File) {
+			defer outputs.copying.Done()
+			io.Copy(dst, r)
+		}(dst, r)
+	}
+	cmd.Stdout, cmd.Stderr = writers[0], writers[1]
+
+	if err := cmd.Start(); err != nil {
+		outputs.close()
+		return nil, err
+	}
+	return outputs, nil
+}
+
+// close stops reading the output once the command is gone. Output is still read for a while, as long as it is held
+// open by the processes the command left running, and then dropped
+func (o *commandOutputs) close() {
+	drained := make(chan struct{})
+	go func() {
+		o.copying.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+	case <-time.After(outputDrainTime):
+		log.Warn("The output of the command is held open by the processes it left running, no longer reading it")
+	}
+	for _, r := range o.readers {
+		r.Close()
+	}
+	<-drained
+}
+
 // thresholdTime  > 0 continuous report
 // thresholdLines > 0 bootstrapping
 func RunContinuousCmd(
@@ -284,9 +410,7 @@ func RunContinuousCmd(
 		return 1, fmt.Errorf("cannot start the specified command %v", err)
 	}
 
-	chunk := ""
-	nLines, nTime := 0, 0
-	timeStart := time.Now()
+	chunks := newChunker(report, time.Duration(thresholdTime)*time.Second, thresholdLines)
 	reader := bufio.NewReader(stdout)
 	line, incomplete, err := reader.ReadLine()
 	for ; err == nil; line, incomplete, err = reader.ReadLine() {
@@ -294,33 +418,23 @@ func RunContinuousCmd(
 		if incomplete {
 			eol = "[...]\n"
 		}
-		chunk = strings.Join([]string{chunk, string(line), eol}, "")
+		output := strings.Join([]string{string(line), eol}, "")
 		if incomplete {
 			for incomplete && err == nil {
 				_, incomplete, err = reader.ReadLine()
 			}
 		}
-		nLines++
-		nTime = int(time.Since(timeStart).Seconds())
-		if (thresholdTime > 0 && nTime >= thresholdTime) || (thresholdLines > 0 && nLines >= thresholdLines) {
-			if reportErr := report(chunk); reportErr == nil {
-				chunk = ""
-			}
-			nLines, nTime = 0, 0
-			timeStart = time.Now()
-		}
+		chunks.add(output)
 	}
 
 	if err != nil && err != io.EOF {
 		log.Error("==> Error: ", err.Error())
-		chunk = strings.Join([]string{chunk, err.Error()}, "")
+		chunks.chunk = strings.Join([]string{chunks.chunk, err.Error()}, "")
 	}
 
-	if len(chunk) > 0 {
-		log.Debug("Processing the last pending chunk")
-		if err := report(chunk); err != nil {
-			log.Error("Cannot process the last chunk", err.Error())
-		}
+	log.Debug("Processing the last pending chunk")
+	if err := chunks.send(); err != nil {
+		log.Error("Cannot process the last chunk", err.Error())
 	}
 
 	err = cmd.Wait()
@@ -328,17 +442,3 @@ func RunContinuousCmd(
 
 	return exitCode, nil
 }
-
-func Retry(attempts int, sleep time.Duration, fn func() error) error {
-	log.Debug("Retry")
-
-	if err := fn(); err != nil {
-		if attempts--; attempts > 0 {
-			log.Debug("Waiting to retry: ", sleep)
-			time.Sleep(sleep)
-			return Retry(attempts, RetriesFactor*sleep, fn)
-		}
-		return err
-	}
-	return nil
-}
@@ -0,0 +1,90 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunTracedCmdContext(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Commands are shell scripts")
+	}
+	assert := assert.New(t)
+
+	exitCode, stdOut, stdErr, _, _ := RunTracedCmdContext(
+		context.Background(),
+		"echo out; echo err >&2; exit 3",
+		time.Minute,
+	)
+	assert.Equal(3, exitCode)
+	assert.Equal("out\n", stdOut)
+	assert.Equal("err\n", stdErr)
+}
+
+func TestRunTracedCmdContextTimeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Commands are shell scripts")
+	}
+	assert := assert.New(t)
+
+	// the background process keeps the output open, so the command only ends when it is killed too
+	exitCode, stdOut, stdErr, startedAt, finishedAt := RunTracedCmdContext(
+		context.Background(),
+		"(sleep 30; echo late) & echo started; wait",
+		200*time.Millisecond,
+	)
+	assert.Equal(TimedOutExitCode, exitCode, "Timed out commands should exit with a distinct code")
+	assert.Equal("started\n", stdOut)
+	assert.Contains(stdErr, "killed on timeout")
+	assert.Less(int64(finishedAt.Sub(startedAt)), int64(10*time.Second), "Every process of the command should be killed")
+}
+
+func TestRunTracedCmdContextLeavingDaemons(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Commands are shell scripts")
+	}
+	assert := assert.New(t)
+
+	// the background process keeps the output open, but the command doesn't wait for it
+	exitCode, stdOut, _, startedAt, finishedAt := RunTracedCmdContext(context.Background(), "sleep 4 & echo hi", 0)
+	assert.Equal(0, exitCode)
+	assert.Equal("hi\n", stdOut)
+	assert.Less(int64(finishedAt.Sub(startedAt)), int64(3*time.Second),
+		"Commands should end without waiting for the processes they leave running")
+}
+
+func TestRunTracedCmdContextCancel(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Commands are shell scripts")
+	}
+	assert := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(200*time.Millisecond, cancel)
+	exitCode, _, stdErr, _, _ := RunTracedCmdContext(ctx, "sleep 30", 0)
+	assert.NotEqual(0, exitCode, "Cancelled commands should fail")
+	assert.NotEqual(TimedOutExitCode, exitCode, "Cancelled commands should not be taken as timed out")
+	assert.NotContains(stdErr, "killed on timeout")
+}
+
+func TestRunContinuousCmd(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Commands are shell scripts")
+	}
+	assert := assert.New(t)
+
+	var chunks []string
+	exitCode, err := RunContinuousCmd(func(chunk string) error {
+		chunks = append(chunks, chunk)
+		return nil
+	}, "echo one; echo two; echo three; exit 2", -1, 2)
+	assert.Nil(err)
+	assert.Equal(2, exitCode)
+	assert.Equal([]string{"one\ntwo\n", "three\n"}, chunks, "Output should be reported every two lines")
+}
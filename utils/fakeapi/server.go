@@ -0,0 +1,508 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+// Package fakeapi provides a fake IMCO API server, keeping its resources in memory, so that tools built on the
+// library, and cio commands, can be tested end-to-end without the platform
+package fakeapi
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ingrammicro/cio/utils"
+)
+
+// DefaultPageSize is the number of resources listed per page when the request doesn't tell
+const DefaultPageSize = 100
+
+// versionPath is the path of the API version served, which the endpoint ends with
+const versionPath = "/v3"
+
+// idRegexp matches the IDs of resources
+var idRegexp = regexp.MustCompile("^[0-9a-f]{24}$")
+
+// collectionRoots are the collections served, along with those nested in their resources. Every collection under
+// /network is served
+var collectionRoots = []string{"/cloud/servers", "/labels", "/blueprint/templates", "/network/"}
+
+// labelableTypes are the resource types of the labelable collections, as given when labelling their resources
+var labelableTypes = map[string]string{
+	"/cloud/servers":             "server",
+	"/blueprint/templates":       "template",
+	"/network/vpcs":              "vpc",
+	"/network/firewall_profiles": "firewall_profile",
+	"/network/floating_ips":      "floating_ip",
+	"/network/load_balancers":    "load_balancer",
+	"/network/dns_domains":       "domain",
+}
+
+// serverActions are the states servers get through the actions requested on them
+var serverActions = map[string]string{
+	"boot":     "operational",
+	"reboot":   "operational",
+	"shutdown": "inactive",
+}
+
+// failure is a response given to the next request to a path instead of serving it
+type failure struct {
+	status int
+	body   string
+}
+
+// Server is a fake IMCO API. Resources are created, listed -in pages linked by the Link header-, shown, updated and
+// deleted through the usual requests to their collections, which are created on first use. Resources of labelable
+// collections can be labelled, and servers booted, rebooted and shut down. Every response carries a request ID
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	resources   map[string]map[string]interface{}
+	collections map[string][]string
+	failures    map[string][]failure
+	lastID      int
+	requests    int
+}
+
+// NewServer starts a fake IMCO API server, served through HTTPS. It should be closed once done
+func NewServer() *Server {
+	s := &Server{
+		resources:   make(map[string]map[string]interface{}),
+		collections: make(map[string][]string),
+		failures:    make(map[string][]failure),
+	}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Endpoint returns the API endpoint of the server
+func (s *Server) Endpoint() string {
+	return s.URL + versionPath
+}
+
+// Config writes to the directory a client certificate, which the server accepts, and the CA certificate it is issued
+// by, and returns the configuration of the services requesting the server with them. Failed requests are not retried
+func (s *Server) Config(dir string) (*utils.Config, error) {
+	certFile := filepath.Join(dir, "cert.crt")
+	keyFile := filepath.Join(dir, "cert.key")
+	caFile := filepath.Join(dir, "ca_cert.pem")
+	if err := writePEM(caFile, "CERTIFICATE", s.Certificate().Raw); err != nil {
+		return nil, err
+	}
+	if err := writeClientCertificate(certFile, keyFile); err != nil {
+		return nil, err
+	}
+	return &utils.Config{
+		APIEndpoint: s.Endpoint(),
+		LogFile:     filepath.Join(dir, "client.log"),
+		LogLevel:    "info",
+		Certificate: utils.Cert{Cert: certFile, Key: keyFile, Ca: caFile},
+		Retry:       utils.RetryConfig{MaxAttempts: 1},
+	}, nil
+}
+
+// NewService returns a service requesting the server, with the certificates written to the directory by Config
+func (s *Server) NewService(dir string) (*utils.HTTPConcertoservice, error) {
+	config, err := s.Config(dir)
+	if err != nil {
+		return nil, err
+	}
+	return utils.NewHTTPConcertoService(config)
+}
+
+// WriteConfig writes to the directory a client.xml file, and the certificates it refers to, so that cio commands
+// request the server when given it through CONCERTO_CONFIG. It returns the path of the file
+func (s *Server) WriteConfig(dir string) (string, error) {
+	config, err := s.Config(dir)
+	if err != nil {
+		return "", err
+	}
+	configFile := filepath.Join(dir, "client.xml")
+	content := fmt.Sprintf(
+		"<concerto version=\"1.0\" server=\"%s\" log_file=\"%s\" log_level=\"%s\">\n"+
+			" <ssl cert=\"%s\" key=\"%s\" server_ca=\"%s\" />\n"+
+			" <retry max_attempts=\"%d\" />\n"+
+			"</concerto>\n",
+		config.APIEndpoint, config.LogFile, config.LogLevel,
+		config.Certificate.Cert, config.Certificate.Key, config.Certificate.Ca,
+		config.Retry.MaxAttempts,
+	)
+	if err := ioutil.WriteFile(configFile, []byte(content), 0600); err != nil {
+		return "", err
+	}
+	return configFile, nil
+}
+
+// Add creates a resource in the collection, as if requested, and returns it along with its ID
+func (s *Server) Add(collection string, resource map[string]interface{}) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return copyResource(s.create(collection, resource))
+}
+
+// Resource returns the resource at the path, such as /cloud/servers/<id>, or nil if there is none
+func (s *Server) Resource(path string) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return copyResource(s.resources[path])
+}
+
+// Fail makes the next request with the given method to the path, without query, fail with the status and body
+func (s *Server) Fail(method string, path string, status int, body string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := method + " " + path
+	s.failures[key] = append(s.failures[key], failure{status: status, body: body})
+}
+
+// serve answers every request
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+	w.Header().Set(utils.RequestIDHeader, fmt.Sprintf("fake-%d", s.requests))
+	w.Header().Set("Content-Type", utils.ContentTypeApplicationJson)
+
+	path := "/" + strings.Trim(strings.TrimPrefix(r.URL.Path, versionPath), "/")
+	key := r.Method + " " + path
+	if failures := s.failures[key]; len(failures) > 0 {
+		s.failures[key] = failures[1:]
+		w.WriteHeader(failures[0].status)
+		w.Write([]byte(failures[0].body))
+		return
+	}
+
+	path = s.canonical(path)
+	var payload map[string]interface{}
+	if r.Method == http.MethodPost || r.Method == http.MethodPut {
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil && !errors.Is(err, io.EOF) {
+			s.fail(w, http.StatusBadRequest, fmt.Sprintf("invalid JSON payload: %v", err))
+			return
+		}
+	}
+
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	switch {
+	case len(segments) >= 3 && segments[0] == "labels" && segments[2] == "resources":
+		s.serveLabelResources(w, r.Method, segments, payload)
+	case len(segments) == 4 && segments[0] == "cloud" && segments[1] == "servers" && serverActions[segments[3]] != "":
+		s.serveServerAction(w, path, segments[3])
+	case s.resources[path] != nil:
+		s.serveResource(w, r.Method, path, payload)
+	case s.isCollection(path):
+		s.serveCollection(w, r, path, payload)
+	default:
+		s.fail(w, http.StatusNotFound, "Not found")
+	}
+}
+
+// canonical returns the path the resource, or collection, at the path is kept at. Resources nested in another one can
+// also be referred to through their collection name alone, as in /network/subnets/<id>
+func (s *Server) canonical(path string) string {
+	i := strings.LastIndex(path, "/")
+	if i <= 0 || s.resources[path] != nil {
+		return path
+	}
+	parent, last := path[:i], path[i+1:]
+	if idRegexp.MatchString(last) {
+		suffix := parent[strings.LastIndex(parent, "/"):] + "/" + last
+		for resourcePath := range s.resources {
+			if strings.HasSuffix(resourcePath, suffix) {
+				return resourcePath
+			}
+		}
+		return path
+	}
+	if canonicalParent := s.canonical(parent); s.resources[canonicalParent] != nil {
+		return canonicalParent + "/" + last
+	}
+	return path
+}
+
+// isCollection tells whether the path is that of a served collection: a root one, or one nested in a resource
+func (s *Server) isCollection(path string) bool {
+	i := strings.LastIndex(path, "/")
+	if i < 0 || idRegexp.MatchString(path[i+1:]) {
+		return false
+	}
+	if s.resources[path[:i]] != nil {
+		return true
+	}
+	for _, root := range collectionRoots {
+		if path == root || (strings.HasSuffix(root, "/") && strings.HasPrefix(path, root)) {
+			return true
+		}
+	}
+	return false
+}
+
+// serveCollection lists the resources of the collection, or creates one
+func (s *Server) serveCollection(w http.ResponseWriter, r *http.Request, path string, payload map[string]interface{}) {
+	switch r.Method {
+	case http.MethodGet:
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page < 1 {
+			page = 1
+		}
+		size, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		if size < 1 {
+			size = DefaultPageSize
+		}
+		ids := s.collections[path]
+		resources := make([]map[string]interface{}, 0)
+		for i := (page - 1) * size; i < len(ids) && i < page*size; i++ {
+			resources = append(resources, s.resources[path+"/"+ids[i]])
+		}
+		if page*size < len(ids) {
+			next := fmt.Sprintf("%s%s?page=%d&per_page=%d", s.Endpoint(), path, page+1, size)
+			w.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"next\"", next))
+		}
+		s.respond(w, http.StatusOK, resources)
+	case http.MethodPost:
+		s.respond(w, http.StatusCreated, s.create(path, payload))
+	default:
+		s.fail(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// serveResource shows, updates or deletes a resource
+func (s *Server) serveResource(w http.ResponseWriter, method string, path string, payload map[string]interface{}) {
+	resource := s.resources[path]
+	switch method {
+	case http.MethodGet:
+		s.respond(w, http.StatusOK, resource)
+	case http.MethodPut:
+		for field, value := range payload {
+			if field != "id" {
+				resource[field] = value
+			}
+		}
+		s.respond(w, http.StatusOK, resource)
+	case http.MethodDelete:
+		s.delete(path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.fail(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// serveServerAction changes the state of a server as the action requests
+func (s *Server) serveServerAction(w http.ResponseWriter, path string, action string) {
+	server := s.resources[path[:strings.LastIndex(path, "/")]]
+	if server == nil {
+		s.fail(w, http.StatusNotFound, "Not found")
+		return
+	}
+	server["state"] = serverActions[action]
+	s.respond(w, http.StatusOK, server)
+}
+
+// serveLabelResources labels resources, or removes a label from a resource
+func (s *Server) serveLabelResources(
+	w http.ResponseWriter,
+	method string,
+	segments []string,
+	payload map[string]interface{},
+) {
+	labelID := segments[1]
+	if s.resources["/labels/"+labelID] == nil {
+		s.fail(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	switch {
+	case method == http.MethodPost && len(segments) == 3:
+		requested, _ := payload["resources"].([]interface{})
+		labeled := make([]map[string]interface{}, 0)
+		for _, r := range requested {
+			resource, _ := r.(map[string]interface{})
+			id, _ := resource["id"].(string)
+			resourceType, _ := resource["resource_type"].(string)
+			target := s.labelable(resourceType, id)
+			if target == nil {
+				s.fail(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", resourceType, id))
+				return
+			}
+			labelIDs := labelIDsOf(target)
+			if !utils.Contains(labelIDs, labelID) {
+				target["label_ids"] = append(labelIDs, labelID)
+			}
+			labeled = append(labeled, map[string]interface{}{"id": id, "resource_type": resourceType})
+		}
+		s.respond(w, http.StatusCreated, labeled)
+	case method == http.MethodDelete && len(segments) == 5:
+		target := s.labelable(segments[3], segments[4])
+		if target == nil {
+			s.fail(w, http.StatusNotFound, "Not found")
+			return
+		}
+		labelIDs := make([]string, 0)
+		for _, id := range labelIDsOf(target) {
+			if id != labelID {
+				labelIDs = append(labelIDs, id)
+			}
+		}
+		target["label_ids"] = labelIDs
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.fail(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// labelable returns the labelable resource of the type with the ID, if any
+func (s *Server) labelable(resourceType string, id string) map[string]interface{} {
+	for collection, collectionType := range labelableTypes {
+		if collectionType == resourceType {
+			return s.resources[collection+"/"+id]
+		}
+	}
+	return nil
+}
+
+// create adds a resource to the collection, with a new ID. Resources of labelable collections have label IDs, those
+// nested in another resource refer to it, and servers start inactive
+func (s *Server) create(collection string, fields map[string]interface{}) map[string]interface{} {
+	s.lastID++
+	id := fmt.Sprintf("%024x", s.lastID)
+	resource := copyResource(fields)
+	if resource == nil {
+		resource = make(map[string]interface{})
+	}
+	resource["id"] = id
+	if _, ok := labelableTypes[collection]; ok && resource["label_ids"] == nil {
+		resource["label_ids"] = []string{}
+	}
+	if collection == "/cloud/servers" && resource["state"] == nil {
+		resource["state"] = "inactive"
+	}
+	parent := collection[:strings.LastIndex(collection, "/")]
+	if s.resources[parent] != nil {
+		parentCollection := parent[:strings.LastIndex(parent, "/")]
+		field := strings.TrimSuffix(parentCollection[strings.LastIndex(parentCollection, "/")+1:], "s") + "_id"
+		if resource[field] == nil {
+			resource[field] = s.resources[parent]["id"]
+		}
+	}
+
+	s.resources[collection+"/"+id] = resource
+	s.collections[collection] = append(s.collections[collection], id)
+	return resource
+}
+
+// delete removes a resource, along with those nested in it
+func (s *Server) delete(path string) {
+	delete(s.resources, path)
+	collection, id := path[:strings.LastIndex(path, "/")], path[strings.LastIndex(path, "/")+1:]
+	ids := make([]string, 0)
+	for _, other := range s.collections[collection] {
+		if other != id {
+			ids = append(ids, other)
+		}
+	}
+	s.collections[collection] = ids
+
+	for nested := range s.collections {
+		if strings.HasPrefix(nested, path+"/") {
+			for _, nestedID := range s.collections[nested] {
+				s.delete(nested + "/" + nestedID)
+			}
+			delete(s.collections, nested)
+		}
+	}
+}
+
+// respond writes the value as the JSON body of the response
+func (s *Server) respond(w http.ResponseWriter, status int, value interface{}) {
+	body, err := json.Marshal(value)
+	if err != nil {
+		s.fail(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// fail writes an error response, as the platform does. The request ID is only given by the headers
+func (s *Server) fail(w http.ResponseWriter, status int, message string) {
+	body, _ := json.Marshal(map[string]string{"error": message})
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// labelIDsOf returns the label IDs of a resource
+func labelIDsOf(resource map[string]interface{}) []string {
+	labelIDs := make([]string, 0)
+	switch ids := resource["label_ids"].(type) {
+	case []string:
+		labelIDs = append(labelIDs, ids...)
+	case []interface{}:
+		for _, id := range ids {
+			if s, ok := id.(string); ok {
+				labelIDs = append(labelIDs, s)
+			}
+		}
+	}
+	return labelIDs
+}
+
+// copyResource returns a shallow copy of the resource
+func copyResource(resource map[string]interface{}) map[string]interface{} {
+	if resource == nil {
+		return nil
+	}
+	c := make(map[string]interface{}, len(resource))
+	for field, value := range resource {
+		c[field] = value
+	}
+	return c
+}
+
+// writePEM writes a PEM file with a single block
+func writePEM(file string, blockType string, bytes []byte) error {
+	return ioutil.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes}), 0600)
+}
+
+// writeClientCertificate writes a self-signed client certificate, and its key, which the server accepts
+func writeClientCertificate(certFile string, keyFile string) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "fakeapi client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return err
+	}
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+	if err = writePEM(certFile, "CERTIFICATE", cert); err != nil {
+		return err
+	}
+	return writePEM(keyFile, "EC PRIVATE KEY", keyBytes)
+}
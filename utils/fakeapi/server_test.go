@@ -0,0 +1,159 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package fakeapi
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/ingrammicro/cio/api/cloud"
+	"github.com/ingrammicro/cio/api/labels"
+	"github.com/ingrammicro/cio/api/network"
+	"github.com/ingrammicro/cio/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServers(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewServer()
+	defer s.Close()
+	hcs, err := s.NewService(t.TempDir())
+	assert.Nil(err, "The service should be created with the written certificates")
+	ss, _ := cloud.NewServerService(hcs)
+	ctx := context.Background()
+
+	server, err := ss.CreateServerContext(ctx, &map[string]interface{}{"name": "web", "template_id": "t1"})
+	assert.Nil(err)
+	assert.Equal("web", server.Name)
+	assert.Equal("inactive", server.State, "Servers should start inactive")
+
+	server, err = ss.BootServerContext(ctx, server.ID, &map[string]interface{}{})
+	assert.Nil(err)
+	assert.Equal("operational", server.State, "Servers should get operational once booted")
+
+	server, err = ss.UpdateServerContext(ctx, server.ID, &map[string]interface{}{"name": "www"})
+	assert.Nil(err)
+	assert.Equal("www", server.Name)
+	assert.Equal("t1", server.TemplateID, "Updates should keep the fields not given")
+
+	servers, err := ss.ListServersContext(ctx)
+	assert.Nil(err)
+	assert.Len(servers, 1)
+
+	assert.Nil(ss.DeleteServerContext(ctx, server.ID))
+	_, err = ss.GetServerContext(ctx, server.ID)
+	assert.True(utils.IsNotFound(err), "Deleted servers should not be found")
+	apiError, _ := utils.AsAPIError(err)
+	assert.NotEmpty(apiError.RequestID, "Responses should carry a request ID")
+}
+
+func TestPages(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewServer()
+	defer s.Close()
+	for i := 0; i < 5; i++ {
+		s.Add("/blueprint/templates", map[string]interface{}{"name": fmt.Sprintf("template-%d", i)})
+	}
+	hcs, err := s.NewService(t.TempDir())
+	assert.Nil(err)
+
+	ctx := utils.WithPageOptions(context.Background(), utils.PageOptions{Size: 2})
+	pager := utils.NewPager(ctx, hcs, "/blueprint/templates")
+	pages, names := 0, make([]string, 0)
+	for pager.Next() {
+		pages++
+		var page []map[string]interface{}
+		assert.Nil(pager.Decode(&page))
+		for _, template := range page {
+			names = append(names, template["name"].(string))
+		}
+	}
+	assert.Nil(pager.Err())
+	assert.Equal(3, pages, "Resources should be listed in pages linked to each other")
+	assert.Equal([]string{"template-0", "template-1", "template-2", "template-3", "template-4"}, names)
+}
+
+func TestNestedResources(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewServer()
+	defer s.Close()
+	hcs, err := s.NewService(t.TempDir())
+	assert.Nil(err)
+	vs, _ := network.NewVPCService(hcs)
+	ss, _ := network.NewSubnetService(hcs)
+	ctx := context.Background()
+
+	vpc, err := vs.CreateVPCContext(ctx, &map[string]interface{}{"name": "main", "cidr": "10.0.0.0/16"})
+	assert.Nil(err)
+	subnet, err := ss.CreateSubnetContext(ctx, vpc.ID, &map[string]interface{}{"name": "front", "cidr": "10.0.1.0/24"})
+	assert.Nil(err)
+	assert.Equal(vpc.ID, subnet.VpcID, "Nested resources should refer to the one they belong to")
+
+	subnet, err = ss.GetSubnetContext(ctx, subnet.ID)
+	assert.Nil(err, "Nested resources should also be found through their collection name")
+	assert.Equal("front", subnet.Name)
+
+	assert.Nil(vs.DeleteVPCContext(ctx, vpc.ID))
+	_, err = ss.GetSubnetContext(ctx, subnet.ID)
+	assert.True(utils.IsNotFound(err), "Nested resources should be deleted along with the one they belong to")
+}
+
+func TestLabels(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewServer()
+	defer s.Close()
+	server := s.Add("/cloud/servers", map[string]interface{}{"name": "web"})
+	hcs, err := s.NewService(t.TempDir())
+	assert.Nil(err)
+	ls, _ := labels.NewLabelService(hcs)
+	ctx := context.Background()
+
+	label, err := ls.CreateLabelContext(ctx, &map[string]interface{}{"name": "production"})
+	assert.Nil(err)
+	resources := map[string]interface{}{
+		"resources": []interface{}{map[string]string{"id": server["id"].(string), "resource_type": "server"}},
+	}
+	_, err = ls.AddLabelContext(ctx, label.ID, &resources)
+	assert.Nil(err)
+	assert.Equal([]string{label.ID}, labelIDsOf(s.Resource("/cloud/servers/"+server["id"].(string))))
+
+	assert.Nil(ls.RemoveLabelContext(ctx, label.ID, "server", server["id"].(string)))
+	assert.Empty(labelIDsOf(s.Resource("/cloud/servers/" + server["id"].(string))))
+
+	_, err = ls.AddLabelContext(ctx, "000000000000000000000000", &resources)
+	assert.True(utils.IsNotFound(err), "Missing labels should not be found")
+}
+
+func TestFail(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewServer()
+	defer s.Close()
+	hcs, err := s.NewService(t.TempDir())
+	assert.Nil(err)
+	ss, _ := cloud.NewServerService(hcs)
+
+	s.Fail("POST", "/cloud/servers", 422, `{"errors":{"name":["has already been taken"]}}`)
+	_, err = ss.CreateServerContext(context.Background(), &map[string]interface{}{"name": "web"})
+	assert.True(utils.IsValidationError(err), "Requests should fail as requested")
+	_, err = ss.CreateServerContext(context.Background(), &map[string]interface{}{"name": "web"})
+	assert.Nil(err, "Only the next request should fail")
+}
+
+func TestWriteConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewServer()
+	defer s.Close()
+	file, err := s.WriteConfig(t.TempDir())
+	assert.Nil(err)
+	content, err := ioutil.ReadFile(file)
+	assert.Nil(err)
+	assert.Contains(string(content), fmt.Sprintf("server=%q", s.Endpoint()), "The config should refer to the server")
+}
@@ -0,0 +1,242 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ReplayEndpoint is the API endpoint of the services replaying fixtures, which never receives any request
+const ReplayEndpoint = "https://fixtures.invalid/v3"
+
+// endpointPlaceholder stands for the API endpoint in the recorded headers, such as the Link to the next page
+const endpointPlaceholder = "{{endpoint}}"
+
+// fixtureHeaders are the response headers recorded, which clients rely on
+var fixtureHeaders = []string{"Content-Type", "Link", "Retry-After", RequestIDHeader}
+
+// Fixture is an API request and the response it got. The path is relative to the API endpoint, unless the request was
+// sent elsewhere, such as those of file transfers, whose bodies are not recorded
+type Fixture struct {
+	Method   string            `json:"method"`
+	Path     string            `json:"path"`
+	Payload  json.RawMessage   `json:"payload,omitempty"`
+	Status   int               `json:"status"`
+	Header   map[string]string `json:"header,omitempty"`
+	Body     json.RawMessage   `json:"body,omitempty"`
+	BodyText string            `json:"body_text,omitempty"`
+}
+
+// LoadFixtures reads the fixtures of a file written by SaveFixtures
+func LoadFixtures(file string) ([]*Fixture, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var fixtures []*Fixture
+	if err = json.Unmarshal(data, &fixtures); err != nil {
+		return nil, fmt.Errorf("cannot read fixtures from %s: %v", file, err)
+	}
+	return fixtures, nil
+}
+
+// SaveFixtures writes the fixtures to a file, as a JSON array
+func SaveFixtures(file string, fixtures []*Fixture) error {
+	data, err := json.MarshalIndent(fixtures, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, append(data, '\n'), 0600)
+}
+
+// relativePath returns the path and query of the URL relative to the API endpoint, given without trailing slash, or
+// the URL if outside of it
+func relativePath(endpoint string, url string) (string, bool) {
+	if endpoint != "" && strings.HasPrefix(url, endpoint) {
+		return "/" + strings.TrimLeft(strings.TrimPrefix(url, endpoint), "/"), true
+	}
+	return url, false
+}
+
+// FixtureRecorder is a transport recording every request sent through it, and the response it gets, to a fixtures
+// file. The file is written after every request, so that it is complete whenever the process exits. Recorded bodies
+// are kept as they are, including any secret they carry
+type FixtureRecorder struct {
+	mu        sync.Mutex
+	file      string
+	endpoint  string
+	fixtures  []*Fixture
+	transport http.RoundTripper
+}
+
+// NewFixtureRecorder returns a recorder of the requests to the API endpoint sent through the transport. Fixtures are
+// appended to those already in the file, if any, so that the requests of several processes can be recorded together
+func NewFixtureRecorder(file string, endpoint string, transport http.RoundTripper) *FixtureRecorder {
+	fixtures, err := LoadFixtures(file)
+	if err != nil && !os.IsNotExist(err) {
+		log.Warnf("Couldn't append fixtures to %s, which will be overwritten: %v", file, err)
+	}
+	return &FixtureRecorder{
+		file:      file,
+		endpoint:  strings.TrimSuffix(endpoint, "/"),
+		fixtures:  fixtures,
+		transport: transport,
+	}
+}
+
+// RoundTrip sends the request through the transport, and records it along with the response
+func (fr *FixtureRecorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	path, inEndpoint := relativePath(fr.endpoint, request.URL.String())
+	fixture := &Fixture{Method: request.Method, Path: path}
+	if inEndpoint && request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		payload, err := ioutil.ReadAll(body)
+		body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if json.Valid(payload) {
+			fixture.Payload = payload
+		}
+	}
+
+	response, err := fr.transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	fixture.Status = response.StatusCode
+	for _, name := range fixtureHeaders {
+		if value := response.Header.Get(name); value != "" {
+			if fixture.Header == nil {
+				fixture.Header = make(map[string]string)
+			}
+			fixture.Header[name] = strings.ReplaceAll(value, fr.endpoint, endpointPlaceholder)
+		}
+	}
+	if inEndpoint {
+		body, err := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		response.Body = ioutil.NopCloser(bytes.NewReader(body))
+		if json.Valid(body) {
+			fixture.Body = body
+		} else {
+			fixture.BodyText = string(body)
+		}
+	}
+
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	fr.fixtures = append(fr.fixtures, fixture)
+	if err := SaveFixtures(fr.file, fr.fixtures); err != nil {
+		log.Warnf("Couldn't record fixtures to %s: %v", fr.file, err)
+	}
+	return response, nil
+}
+
+// FixtureReplayer is a transport answering requests with the responses of the fixtures recorded for them, instead of
+// sending them. Fixtures are matched by method and path, and those of the same request are served in the order they
+// were recorded, the last one being served again once all have been, as when polling for a resource state
+type FixtureReplayer struct {
+	mu       sync.Mutex
+	endpoint string
+	fixtures []*Fixture
+	served   []bool
+}
+
+// NewFixtureReplayer returns a replayer of the fixtures of the requests to the API endpoint
+func NewFixtureReplayer(endpoint string, fixtures []*Fixture) *FixtureReplayer {
+	return &FixtureReplayer{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		fixtures: fixtures,
+		served:   make([]bool, len(fixtures)),
+	}
+}
+
+// RoundTrip returns the response of the next fixture of the request, or an error if none was recorded
+func (fr *FixtureReplayer) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Body != nil {
+		request.Body.Close()
+	}
+	path, _ := relativePath(fr.endpoint, request.URL.String())
+	fixture := fr.next(request.Method, path)
+	if fixture == nil {
+		return nil, fmt.Errorf("no fixture recorded for %s %s", request.Method, path)
+	}
+
+	// bodies are indented along with the fixtures file, so they are compacted back
+	var buffer bytes.Buffer
+	if len(fixture.Body) > 0 {
+		if err := json.Compact(&buffer, fixture.Body); err != nil {
+			return nil, err
+		}
+	}
+	body := buffer.Bytes()
+	if fixture.BodyText != "" {
+		body = []byte(fixture.BodyText)
+	}
+	response := &http.Response{
+		Status:        fmt.Sprintf("%d %s", fixture.Status, http.StatusText(fixture.Status)),
+		StatusCode:    fixture.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        make(http.Header),
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}
+	for name, value := range fixture.Header {
+		response.Header.Set(name, strings.ReplaceAll(value, endpointPlaceholder, fr.endpoint))
+	}
+	return response, nil
+}
+
+// next returns the fixture to serve for the request, if any
+func (fr *FixtureReplayer) next(method string, path string) *Fixture {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+
+	last := -1
+	for i, fixture := range fr.fixtures {
+		if fixture.Method != method || fixture.Path != path {
+			continue
+		}
+		if !fr.served[i] {
+			fr.served[i] = true
+			return fixture
+		}
+		last = i
+	}
+	if last < 0 {
+		return nil
+	}
+	return fr.fixtures[last]
+}
+
+// NewReplayConcertoService returns a service answering requests with the fixtures recorded in a file, for testing
+// without the platform. Failed requests are not retried
+func NewReplayConcertoService(file string) (*HTTPConcertoservice, error) {
+	fixtures, err := LoadFixtures(file)
+	if err != nil {
+		return nil, err
+	}
+	return &HTTPConcertoservice{
+		config: &Config{APIEndpoint: ReplayEndpoint, Retry: RetryConfig{MaxAttempts: 1}},
+		client: &http.Client{Transport: NewFixtureReplayer(ReplayEndpoint, fixtures)},
+	}, nil
+}
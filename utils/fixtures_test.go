@@ -0,0 +1,93 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordAndReplayFixtures(t *testing.T) {
+	assert := assert.New(t)
+
+	state := "pending"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the endpoint ends with slash, as usually configured, so paths start with two
+		r.URL.Path = path.Clean(r.URL.Path)
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/v3/cloud/servers" && r.URL.Query().Get("page") == "1":
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/v3/cloud/servers?page=2&per_page=1>; rel="next"`, r.Host))
+			w.Write([]byte(`[{"id":"1"}]`))
+		case r.Method == "GET" && r.URL.Path == "/v3/cloud/servers":
+			w.Write([]byte(`[{"id":"2"}]`))
+		case r.Method == "GET" && r.URL.Path == "/v3/cloud/servers/1":
+			w.Write([]byte(fmt.Sprintf(`{"id":"1","state":%q}`, state)))
+			state = "operational"
+		case r.Method == "POST":
+			w.Header().Set(RequestIDHeader, "r1")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"errors":{"name":["taken"]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	file := filepath.Join(t.TempDir(), "fixtures.json")
+	hcs := &HTTPConcertoservice{
+		config: &Config{APIEndpoint: server.URL + "/v3/", Retry: RetryConfig{MaxAttempts: 1}, RecordFixtures: file},
+	}
+	assert.Nil(hcs.setClients(nil))
+
+	exercise := func(cs ConcertoService) ([]string, []string, string) {
+		ids := make([]string, 0)
+		pager := NewPager(WithPageOptions(context.Background(), PageOptions{Size: 1}), cs, "/cloud/servers")
+		for pager.Next() {
+			var page []map[string]string
+			assert.Nil(pager.Decode(&page))
+			ids = append(ids, page[0]["id"])
+		}
+		assert.Nil(pager.Err())
+
+		states := make([]string, 0)
+		for i := 0; i < 3; i++ {
+			body, status, err := cs.Get("/cloud/servers/1")
+			assert.Nil(err)
+			assert.Equal(200, status)
+			states = append(states, string(body))
+		}
+
+		_, _, err := cs.Post("/cloud/servers", &map[string]interface{}{"name": "web"})
+		assert.NotNil(err)
+		return ids, states, err.Error()
+	}
+
+	recordedIDs, recordedStates, recordedErr := exercise(hcs)
+	assert.Equal([]string{"1", "2"}, recordedIDs)
+
+	fixtures, err := LoadFixtures(file)
+	assert.Nil(err, "Fixtures should be recorded")
+	assert.Len(fixtures, 6)
+	assert.Equal("/cloud/servers?page=1&per_page=1", fixtures[0].Path, "Paths should be relative to the endpoint")
+	assert.Contains(fixtures[0].Header["Link"], endpointPlaceholder, "Links should not refer to the endpoint")
+	assert.JSONEq(`{"name":"web"}`, string(fixtures[5].Payload), "Payloads should be recorded")
+	assert.Equal("r1", fixtures[5].Header[RequestIDHeader])
+
+	replay, err := NewReplayConcertoService(file)
+	assert.Nil(err)
+	replayedIDs, replayedStates, replayedErr := exercise(replay)
+	assert.Equal(recordedIDs, replayedIDs, "Pages should be replayed")
+	assert.Equal(recordedStates, replayedStates, "Responses should be replayed in order, repeating the last one")
+	assert.Equal(recordedErr, replayedErr, "Failed responses should be replayed")
+
+	_, _, err = replay.Get("/cloud/servers/2")
+	assert.NotNil(err, "Requests not recorded should fail")
+	assert.Contains(err.Error(), "no fixture recorded for GET /cloud/servers/2")
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package format
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// CSVFormatter prints items and lists as CSV, with a header row taken from the header tags. Unless columns are
+// selected, every column but the noshow ones is printed. Nested structs are flattened into their own columns, string
+// lists are joined with commas and any other composite value is encoded as JSON
+type CSVFormatter struct {
+	output io.Writer
+}
+
+// NewCSVFormatter creates a new CSVFormatter
+func NewCSVFormatter(out io.Writer) *CSVFormatter {
+	log.Debug("Creating CSV formatter")
+
+	return &CSVFormatter{
+		output: out,
+	}
+}
+
+// PrintItem prints an item
+func (f *CSVFormatter) PrintItem(item interface{}) error {
+	log.Debug("PrintItem")
+
+	items := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(item)), 0, 1)
+	return f.print(reflect.Append(items, reflect.ValueOf(item)))
+}
+
+// PrintList prints item list
+func (f *CSVFormatter) PrintList(items interface{}) error {
+	log.Debug("PrintList")
+
+	its := reflect.ValueOf(items)
+	if its.Kind() != reflect.Slice {
+		return fmt.Errorf("couldn't print list. Expected slice, but received %s", its.Kind().String())
+	}
+	return f.print(its)
+}
+
+// PrintError prints an error
+func (f *CSVFormatter) PrintError(context string, err error) {
+	log.Debug("PrintError")
+
+	f.output.Write([]byte(fmt.Sprintf("ERROR: %s\n -> %s\n", context, err)))
+}
+
+// PrintFatal prints an error and exists
+func (f *CSVFormatter) PrintFatal(context string, err error) {
+	log.Debug("PrintFatal")
+
+	f.PrintError(context, err)
+	osExit(1)
+}
+
+// print writes the header row and a row per item of the slice
+func (f *CSVFormatter) print(items reflect.Value) error {
+	columns := listColumns(items.Type().Elem(), nil)
+	if len(columns) == 0 {
+		return fmt.Errorf("couldn't print CSV. No columns found for %s", items.Type().Elem().String())
+	}
+	selected, err := selectListColumns(columns, ListOptions{Columns: listOptions.Columns, Wide: true})
+	if err != nil {
+		return err
+	}
+	var sortBy *listColumn
+	if listOptions.SortBy != "" {
+		column, err := findListColumn(columns, listOptions.SortBy)
+		if err != nil {
+			return err
+		}
+		sortBy = &column
+	}
+
+	w := csv.NewWriter(f.output)
+	record := make([]string, len(selected))
+	for i, column := range selected {
+		record[i] = column.header
+	}
+	w.Write(record)
+
+	for _, row := range listRows(items, sortBy) {
+		for i, column := range selected {
+			if record[i], err = formatCSVCell(row.FieldByIndex(column.index)); err != nil {
+				return err
+			}
+		}
+		w.Write(record)
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// formatCSVCell flattens a value into a single CSV cell
+func formatCSVCell(field reflect.Value) (string, error) {
+	if field.Type() == timeType {
+		return field.Interface().(time.Time).Format(time.RFC3339), nil
+	}
+
+	switch field.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if field.IsNil() {
+			return "", nil
+		}
+		return formatCSVCell(field.Elem())
+	case reflect.Slice:
+		if field.IsNil() {
+			return "", nil
+		}
+		if field.Type().Elem().Kind() == reflect.String {
+			values := make([]string, field.Len())
+			for i := range values {
+				values[i] = field.Index(i).String()
+			}
+			return strings.Join(values, ","), nil
+		}
+		return formatCSVCellJSON(field)
+	case reflect.Map:
+		if field.Len() == 0 {
+			return "", nil
+		}
+		return formatCSVCellJSON(field)
+	case reflect.Array, reflect.Struct:
+		return formatCSVCellJSON(field)
+	}
+	return fmt.Sprintf("%v", field.Interface()), nil
+}
+
+func formatCSVCellJSON(field reflect.Value) (string, error) {
+	b, err := json.Marshal(field.Interface())
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
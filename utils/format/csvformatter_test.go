@@ -0,0 +1,156 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package format
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ingrammicro/cio/api/blueprint"
+	"github.com/ingrammicro/cio/api/cloud"
+	"github.com/ingrammicro/cio/api/types"
+	"github.com/ingrammicro/cio/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintItemCSV(t *testing.T) {
+
+	assert := assert.New(t)
+	serversIn := testdata.GetServerData()
+	for _, serverIn := range serversIn {
+
+		serverOut := cloud.GetServerMocked(t, serverIn)
+
+		var b bytes.Buffer
+		mockOut := bufio.NewWriter(&b)
+		assert.Nil(InitializeFormatter("csv", mockOut), "CSV formatter error")
+		f := GetFormatter()
+		assert.NotNil(f, "Formatter")
+
+		err := f.PrintItem(*serverOut)
+		assert.Nil(err, "CSV formatter PrintItem error")
+		mockOut.Flush()
+
+		records, err := csv.NewReader(&b).ReadAll()
+		assert.Nil(err, "CSV output should be readable")
+		assert.Len(records, 2, "CSV output should have a header and a row")
+		assert.Equal("ID", records[0][0], "CSV header didn't match")
+		assert.Equal(serverOut.ID, records[1][0], "CSV row didn't match")
+	}
+}
+
+func TestPrintListCSV(t *testing.T) {
+
+	assert := assert.New(t)
+	templatesIn := testdata.GetTemplateData()
+	templatesIn[0].Labels = []string{"fakeLabel0", "fakeLabel1"}
+	templatesOut := blueprint.ListTemplatesMocked(t, templatesIn)
+
+	var b bytes.Buffer
+	mockOut := bufio.NewWriter(&b)
+	assert.Nil(InitializeFormatter("csv", mockOut), "CSV formatter error")
+	f := GetFormatter()
+	assert.NotNil(f, "Formatter")
+
+	err := f.PrintList(templatesOut)
+	assert.Nil(err, "CSV formatter PrintList error")
+	mockOut.Flush()
+
+	records, err := csv.NewReader(&b).ReadAll()
+	assert.Nil(err, "CSV output should be readable")
+	assert.Len(records, len(templatesOut)+1, "CSV output should have a header and a row per item")
+	assert.Equal(
+		[]string{
+			"ID", "NAME", "GENERIC_IMAGE_ID", "RUN_LIST", "CONFIGURATION_ATTRIBUTES", "RESOURCE_TYPE",
+			"COOKBOOK_VERSIONS", "STATE", "LABELS",
+		},
+		records[0],
+		"CSV header should include nolist columns but no noshow ones",
+	)
+	assert.Equal(
+		[]string{
+			"fakeID0", "fakeName0", "fakeGenericImageID0", "fakeRunList01,fakeRunList02",
+			`{"fakeConf01":"x","fakeConf02":"y"}`, "", "", "", "fakeLabel0,fakeLabel1",
+		},
+		records[1],
+		"CSV row didn't match",
+	)
+	assert.Equal("", records[3][4], "Empty maps should be printed as empty cells")
+}
+
+func TestPrintListColumnsCSV(t *testing.T) {
+
+	assert := assert.New(t)
+	eventsIn := testdata.GetEventData()
+	eventsIn[0].Timestamp = time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	eventsIn[1].Timestamp = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	defer SetListOptions(ListOptions{})
+	SetListOptions(ListOptions{Columns: []string{"timestamp", "id"}, SortBy: "TIMESTAMP"})
+
+	var b bytes.Buffer
+	f := NewCSVFormatter(&b)
+	err := f.PrintList(eventsIn)
+	assert.Nil(err, "CSV formatter PrintList error")
+
+	assert.Equal(
+		fmt.Sprintf(
+			"TIMESTAMP,ID\n2020-01-02T03:04:05Z,%s\n2021-01-02T03:04:05Z,%s\n",
+			eventsIn[1].ID,
+			eventsIn[0].ID,
+		),
+		b.String(),
+		"CSV output didn't match",
+	)
+}
+
+func TestPrintListErrorsCSV(t *testing.T) {
+
+	assert := assert.New(t)
+
+	var b bytes.Buffer
+	f := NewCSVFormatter(&b)
+
+	err := f.PrintList("not a list")
+	assert.Error(err, "Should have gotten an error printing a non list")
+
+	err = f.PrintList([]string{"no columns"})
+	assert.Error(err, "Should have gotten an error printing a list without columns")
+
+	defer SetListOptions(ListOptions{})
+	SetListOptions(ListOptions{Columns: []string{"unknown"}})
+	err = f.PrintList([]*types.Event{})
+	assert.Error(err, "Should have gotten an error printing an unknown column")
+}
+
+func TestPrintErrorCSV(t *testing.T) {
+
+	assert := assert.New(t)
+
+	var b bytes.Buffer
+	f := NewCSVFormatter(&b)
+
+	f.PrintError("testing errors", fmt.Errorf("this is a test error %s", "TEST"))
+	assert.Equal("ERROR: testing errors\n -> this is a test error TEST\n", b.String(), "Error output didn't match")
+}
+
+func TestPrintFatalCSV(t *testing.T) {
+
+	// Save current function and restore at the end:
+	oldOsExit := osExit
+	defer func() { osExit = oldOsExit }()
+
+	var got int
+	osExit = func(code int) {
+		got = code
+	}
+	var b bytes.Buffer
+	f := NewCSVFormatter(&b)
+	f.PrintFatal("testing fatal", fmt.Errorf("this is a test error %s", "TEST"))
+	if exp := 1; got != exp {
+		t.Errorf("Expected exit code: %d, got: %d", exp, got)
+	}
+}
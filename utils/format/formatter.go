@@ -3,8 +3,10 @@
 package format
 
 import (
+	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -24,13 +26,59 @@ type Formatter interface {
 
 var formatter Formatter
 
-// InitializeFormatter creates a singleton Formatter
-func InitializeFormatter(formatterType string, out io.Writer) {
-	if formatterType == "json" {
-		formatter = NewJSONFormatter(out)
-	} else {
+// ListOptions tunes the tables printed by TextFormatter and CSVFormatter. Columns are referred by their header tags
+type ListOptions struct {
+	// Columns lists the columns to print, in order. Empty means the default ones
+	Columns []string
+	// SortBy is the column rows are sorted by. Empty keeps the order received
+	SortBy string
+	// Wide adds the columns tagged as nolist to the default ones. CSVFormatter always prints them
+	Wide bool
+}
+
+var listOptions ListOptions
+
+// SetListOptions sets the options applied when printing lists as tables
+func SetListOptions(options ListOptions) {
+	listOptions = options
+}
+
+// Formatters lists the supported formatter types
+const Formatters = "[ text | json | yaml | csv | ndjson | template | jsonpath ]"
+
+// NewFormatter creates the Formatter described by spec: a formatter type, optionally followed by "=" and the template
+// used by the template and jsonpath formatters (e.g. jsonpath={.id})
+func NewFormatter(spec string, out io.Writer) (Formatter, error) {
+	formatterType, text, _ := strings.Cut(spec, "=")
+	switch formatterType {
+	case "", "text":
+		return NewTextFormatter(out), nil
+	case "json":
+		return NewJSONFormatter(out), nil
+	case "yaml":
+		return NewYAMLFormatter(out), nil
+	case "csv":
+		return NewCSVFormatter(out), nil
+	case "ndjson":
+		return NewNDJSONFormatter(out), nil
+	case "template":
+		return NewTemplateFormatter(out, text)
+	case "jsonpath":
+		return NewJSONPathFormatter(out, text)
+	}
+	return nil, fmt.Errorf("unrecognized formatter %s. Please, use one of %s", formatterType, Formatters)
+}
+
+// InitializeFormatter creates a singleton Formatter. When spec cannot be honored the text formatter is used instead,
+// and the reason is returned
+func InitializeFormatter(spec string, out io.Writer) error {
+	f, err := NewFormatter(spec, out)
+	if err != nil {
 		formatter = NewTextFormatter(out)
+		return err
 	}
+	formatter = f
+	return nil
 }
 
 // GetFormatter creates a new JSONFormatter
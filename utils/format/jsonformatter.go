@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/ingrammicro/cio/utils"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -15,11 +16,29 @@ type JSONFormatter struct {
 	output io.Writer
 }
 
-// JSONMessage hosts generic messages
+// JSONMessage hosts generic messages. Errors of API requests also describe the failure, as given by the platform
 type JSONMessage struct {
-	Type    string `json:"type"`
-	Context string `json:"context,omitempty"`
-	Message string `json:"message"`
+	Type       string              `json:"type"`
+	Context    string              `json:"context,omitempty"`
+	Message    string              `json:"message"`
+	StatusCode int                 `json:"status_code,omitempty"`
+	Errors     map[string][]string `json:"errors,omitempty"`
+	RequestID  string              `json:"request_id,omitempty"`
+}
+
+// newErrorMessage returns the message of an error, structured as given by the platform if it is an API error
+func newErrorMessage(context string, err error) JSONMessage {
+	msg := JSONMessage{
+		Type:    "Error",
+		Context: context,
+		Message: err.Error(),
+	}
+	if apiError, ok := utils.AsAPIError(err); ok {
+		msg.StatusCode = apiError.StatusCode
+		msg.Errors = apiError.Errors
+		msg.RequestID = apiError.RequestID
+	}
+	return msg
 }
 
 // NewJSONFormatter creates a new JSONFormatter
@@ -63,11 +82,7 @@ func (f *JSONFormatter) PrintList(items interface{}) error {
 func (f *JSONFormatter) PrintError(context string, err error) {
 	log.Debug("PrintError")
 
-	msg := JSONMessage{
-		Type:    "Error",
-		Context: context,
-		Message: err.Error(),
-	}
+	msg := newErrorMessage(context, err)
 
 	msgJSON, err := json.Marshal(msg)
 	if err != nil {
@@ -12,6 +12,7 @@ import (
 
 	"github.com/ingrammicro/cio/api/blueprint"
 	"github.com/ingrammicro/cio/testdata"
+	"github.com/ingrammicro/cio/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -122,6 +123,28 @@ func TestPrintErrorJSON(t *testing.T) {
 	)
 }
 
+func TestPrintAPIErrorJSON(t *testing.T) {
+
+	assert := assert.New(t)
+
+	var b bytes.Buffer
+	mockOut := bufio.NewWriter(&b)
+
+	InitializeFormatter("json", mockOut)
+	f := GetFormatter()
+
+	apiError := utils.NewAPIError(422, []byte(`{"errors":{"name":["taken"]},"request_id":"r1"}`))
+	f.PrintError("Couldn't create server", apiError)
+	mockOut.Flush()
+
+	assert.JSONEq(
+		`{"type":"Error","context":"Couldn't create server","message":"HTTP request failed: (422) [#name:taken]",`+
+			`"status_code":422,"errors":{"name":["taken"]},"request_id":"r1"}`,
+		b.String(),
+		"API errors should be printed structured",
+	)
+}
+
 func TestPrintItemWrongBytesJSON(t *testing.T) {
 
 	assert := assert.New(t)
@@ -0,0 +1,559 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package format
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonPath is a parsed JSONPath template, in the flavour made popular by kubectl: literal text mixed with {expression}
+// actions, {range expression}...{end} loops and {"quoted"} strings. Supported expressions are made of .name, ..name,
+// .*, [*], [index], [start:end], ['name'], [index1,index2] and [?(@.name op literal)] steps
+type jsonPath struct {
+	nodes []jsonPathNode
+}
+
+// jsonPathNode is either literal text, an expression to print or a range over the results of an expression
+type jsonPathNode struct {
+	text string
+	expr *jsonPathExpr
+	body []jsonPathNode
+	loop bool
+}
+
+// jsonPathExpr is a sequence of steps evaluated from the root ($) or from the current node (@)
+type jsonPathExpr struct {
+	root  bool
+	steps []jsonPathStep
+}
+
+type jsonPathStepKind int
+
+const (
+	jsonPathChild jsonPathStepKind = iota
+	jsonPathWildcard
+	jsonPathDescendants
+	jsonPathIndexes
+	jsonPathSlice
+	jsonPathFilter
+)
+
+type jsonPathStep struct {
+	kind    jsonPathStepKind
+	names   []string
+	indexes []int
+	start   *int
+	end     *int
+	filter  *jsonPathFilterExpr
+}
+
+// jsonPathFilterExpr selects the items for which path exists, or compares to value when op is given
+type jsonPathFilterExpr struct {
+	path  *jsonPathExpr
+	op    string
+	value interface{}
+}
+
+// parseJSONPath parses a JSONPath template
+func parseJSONPath(text string) (*jsonPath, error) {
+	root := []jsonPathNode{}
+	stack := []*[]jsonPathNode{&root}
+	loops := []jsonPathNode{}
+
+	for len(text) > 0 {
+		open := strings.IndexByte(text, '{')
+		if open < 0 {
+			*stack[len(stack)-1] = append(*stack[len(stack)-1], jsonPathNode{text: text})
+			break
+		}
+		if open > 0 {
+			*stack[len(stack)-1] = append(*stack[len(stack)-1], jsonPathNode{text: text[:open]})
+		}
+		end, err := jsonPathClosing(text, open, '{', '}')
+		if err != nil {
+			return nil, err
+		}
+		action := strings.TrimSpace(text[open+1 : end])
+		text = text[end+1:]
+
+		switch {
+		case action == "end":
+			if len(loops) == 0 {
+				return nil, fmt.Errorf("jsonpath: {end} without {range}")
+			}
+			loop := loops[len(loops)-1]
+			loop.body = *stack[len(stack)-1]
+			loops = loops[:len(loops)-1]
+			stack = stack[:len(stack)-1]
+			*stack[len(stack)-1] = append(*stack[len(stack)-1], loop)
+		case strings.HasPrefix(action, "range "):
+			expr, err := parseJSONPathExpr(strings.TrimSpace(strings.TrimPrefix(action, "range ")))
+			if err != nil {
+				return nil, err
+			}
+			loops = append(loops, jsonPathNode{expr: expr, loop: true})
+			body := []jsonPathNode{}
+			stack = append(stack, &body)
+		case strings.HasPrefix(action, `"`):
+			literal, err := strconv.Unquote(action)
+			if err != nil {
+				return nil, fmt.Errorf("jsonpath: invalid string %s: %v", action, err)
+			}
+			*stack[len(stack)-1] = append(*stack[len(stack)-1], jsonPathNode{text: literal})
+		default:
+			expr, err := parseJSONPathExpr(action)
+			if err != nil {
+				return nil, err
+			}
+			*stack[len(stack)-1] = append(*stack[len(stack)-1], jsonPathNode{expr: expr})
+		}
+	}
+	if len(loops) > 0 {
+		return nil, fmt.Errorf("jsonpath: {range} without {end}")
+	}
+
+	return &jsonPath{nodes: root}, nil
+}
+
+// jsonPathClosing returns the position of the close character matching the open one found at start, skipping quoted
+// strings and nested pairs
+func jsonPathClosing(text string, start int, open byte, close byte) (int, error) {
+	depth := 0
+	var quote byte
+	for i := start; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == open:
+			depth++
+		case c == close:
+			depth--
+			if depth == 0 {
+				return i, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("jsonpath: unclosed %c in %s", open, text[start:])
+}
+
+// isJSONPathNameChar returns whether c may be part of an unquoted field name
+func isJSONPathNameChar(c byte) bool {
+	return c == '_' || c == '-' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// parseJSONPathExpr parses an expression such as .items[*].id or $.name
+func parseJSONPathExpr(text string) (*jsonPathExpr, error) {
+	expr := &jsonPathExpr{}
+	p := 0
+	if strings.HasPrefix(text, "$") {
+		expr.root = true
+		p++
+	} else if strings.HasPrefix(text, "@") {
+		p++
+	}
+
+	for p < len(text) {
+		switch c := text[p]; {
+		case c == '.':
+			p++
+			if p < len(text) && text[p] == '.' {
+				expr.steps = append(expr.steps, jsonPathStep{kind: jsonPathDescendants})
+				p++
+			}
+			if p == len(text) || text[p] == '[' {
+				continue
+			}
+			if text[p] == '*' {
+				expr.steps = append(expr.steps, jsonPathStep{kind: jsonPathWildcard})
+				p++
+				continue
+			}
+			fallthrough
+		case isJSONPathNameChar(c):
+			start := p
+			for p < len(text) && isJSONPathNameChar(text[p]) {
+				p++
+			}
+			if start == p {
+				return nil, fmt.Errorf("jsonpath: unexpected %q in %s", text[p], text)
+			}
+			expr.steps = append(expr.steps, jsonPathStep{kind: jsonPathChild, names: []string{text[start:p]}})
+		case c == '[':
+			end, err := jsonPathClosing(text, p, '[', ']')
+			if err != nil {
+				return nil, err
+			}
+			step, err := parseJSONPathBracket(strings.TrimSpace(text[p+1 : end]))
+			if err != nil {
+				return nil, err
+			}
+			expr.steps = append(expr.steps, step)
+			p = end + 1
+		default:
+			return nil, fmt.Errorf("jsonpath: unexpected %q in %s", c, text)
+		}
+	}
+
+	return expr, nil
+}
+
+// parseJSONPathBracket parses the content of a [...] step
+func parseJSONPathBracket(text string) (jsonPathStep, error) {
+	switch {
+	case text == "*":
+		return jsonPathStep{kind: jsonPathWildcard}, nil
+	case strings.HasPrefix(text, "?(") && strings.HasSuffix(text, ")"):
+		filter, err := parseJSONPathFilter(strings.TrimSpace(text[2 : len(text)-1]))
+		if err != nil {
+			return jsonPathStep{}, err
+		}
+		return jsonPathStep{kind: jsonPathFilter, filter: filter}, nil
+	case strings.HasPrefix(text, "'") || strings.HasPrefix(text, `"`):
+		step := jsonPathStep{kind: jsonPathChild}
+		for _, name := range strings.Split(text, ",") {
+			name = strings.TrimSpace(name)
+			if len(name) < 2 || name[0] != name[len(name)-1] {
+				return jsonPathStep{}, fmt.Errorf("jsonpath: invalid name %s", name)
+			}
+			step.names = append(step.names, name[1:len(name)-1])
+		}
+		return step, nil
+	case strings.Contains(text, ":"):
+		bounds := strings.SplitN(text, ":", 3)
+		step := jsonPathStep{kind: jsonPathSlice}
+		for i, bound := range bounds[:2] {
+			bound = strings.TrimSpace(bound)
+			if bound == "" {
+				continue
+			}
+			n, err := strconv.Atoi(bound)
+			if err != nil {
+				return jsonPathStep{}, fmt.Errorf("jsonpath: invalid slice [%s]", text)
+			}
+			if i == 0 {
+				step.start = &n
+			} else {
+				step.end = &n
+			}
+		}
+		return step, nil
+	default:
+		step := jsonPathStep{kind: jsonPathIndexes}
+		for _, index := range strings.Split(text, ",") {
+			n, err := strconv.Atoi(strings.TrimSpace(index))
+			if err != nil {
+				return jsonPathStep{}, fmt.Errorf("jsonpath: invalid index [%s]", text)
+			}
+			step.indexes = append(step.indexes, n)
+		}
+		return step, nil
+	}
+}
+
+// parseJSONPathFilter parses the content of a ?(...) filter
+func parseJSONPathFilter(text string) (*jsonPathFilterExpr, error) {
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		if quote != 0 {
+			if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		if c == '"' || c == '\'' {
+			quote = c
+			continue
+		}
+		for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+			if !strings.HasPrefix(text[i:], op) {
+				continue
+			}
+			path, err := parseJSONPathExpr(strings.TrimSpace(text[:i]))
+			if err != nil {
+				return nil, err
+			}
+			value, err := parseJSONPathLiteral(strings.TrimSpace(text[i+len(op):]))
+			if err != nil {
+				return nil, err
+			}
+			return &jsonPathFilterExpr{path: path, op: op, value: value}, nil
+		}
+	}
+
+	path, err := parseJSONPathExpr(text)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonPathFilterExpr{path: path}, nil
+}
+
+// parseJSONPathLiteral parses the right hand side of a filter comparison
+func parseJSONPathLiteral(text string) (interface{}, error) {
+	switch {
+	case text == "true":
+		return true, nil
+	case text == "false":
+		return false, nil
+	case text == "null":
+		return nil, nil
+	case len(text) >= 2 && (text[0] == '\'' || text[0] == '"') && text[len(text)-1] == text[0]:
+		return text[1 : len(text)-1], nil
+	}
+	n, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return nil, fmt.Errorf("jsonpath: invalid literal %s", text)
+	}
+	return n, nil
+}
+
+// execute writes the template evaluated over data, a value decoded from JSON
+func (jp *jsonPath) execute(out io.Writer, data interface{}) error {
+	return executeJSONPathNodes(out, jp.nodes, data, data)
+}
+
+func executeJSONPathNodes(out io.Writer, nodes []jsonPathNode, root interface{}, current interface{}) error {
+	for _, node := range nodes {
+		switch {
+		case node.expr == nil:
+			io.WriteString(out, node.text)
+		case node.loop:
+			for _, value := range node.expr.evaluate(root, current) {
+				if err := executeJSONPathNodes(out, node.body, root, value); err != nil {
+					return err
+				}
+			}
+		default:
+			values := node.expr.evaluate(root, current)
+			for i, value := range values {
+				if i > 0 {
+					io.WriteString(out, " ")
+				}
+				if err := writeJSONPathValue(out, value); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// writeJSONPathValue writes scalars as plain text, and objects and arrays as JSON
+func writeJSONPathValue(out io.Writer, value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+	case string:
+		io.WriteString(out, v)
+	case json.Number, bool:
+		fmt.Fprint(out, v)
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		out.Write(b)
+	}
+	return nil
+}
+
+// evaluate returns the values selected by the expression
+func (expr *jsonPathExpr) evaluate(root interface{}, current interface{}) []interface{} {
+	values := []interface{}{current}
+	if expr.root {
+		values = []interface{}{root}
+	}
+	for _, step := range expr.steps {
+		next := []interface{}{}
+		for _, value := range values {
+			next = append(next, step.apply(root, value)...)
+		}
+		values = next
+	}
+	return values
+}
+
+func (step *jsonPathStep) apply(root interface{}, value interface{}) []interface{} {
+	values := []interface{}{}
+	switch step.kind {
+	case jsonPathChild:
+		if object, ok := value.(map[string]interface{}); ok {
+			for _, name := range step.names {
+				if child, ok := object[name]; ok {
+					values = append(values, child)
+				}
+			}
+		}
+	case jsonPathWildcard:
+		values = jsonPathChildren(value)
+	case jsonPathDescendants:
+		values = jsonPathDescendantsOf(value)
+	case jsonPathIndexes:
+		if array, ok := value.([]interface{}); ok {
+			for _, index := range step.indexes {
+				if index < 0 {
+					index += len(array)
+				}
+				if index >= 0 && index < len(array) {
+					values = append(values, array[index])
+				}
+			}
+		}
+	case jsonPathSlice:
+		if array, ok := value.([]interface{}); ok {
+			start, end := 0, len(array)
+			if step.start != nil {
+				start = jsonPathBound(*step.start, len(array))
+			}
+			if step.end != nil {
+				end = jsonPathBound(*step.end, len(array))
+			}
+			if start < end {
+				values = append(values, array[start:end]...)
+			}
+		}
+	case jsonPathFilter:
+		for _, child := range jsonPathChildren(value) {
+			if step.filter.matches(root, child) {
+				values = append(values, child)
+			}
+		}
+	}
+	return values
+}
+
+// jsonPathBound normalizes a slice bound, counting negative ones from the end
+func jsonPathBound(n int, length int) int {
+	if n < 0 {
+		n += length
+	}
+	if n < 0 {
+		return 0
+	}
+	if n > length {
+		return length
+	}
+	return n
+}
+
+// jsonPathChildren returns the items of an array, or the values of an object sorted by key
+func jsonPathChildren(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		children := make([]interface{}, 0, len(v))
+		for _, key := range keys {
+			children = append(children, v[key])
+		}
+		return children
+	}
+	return nil
+}
+
+// jsonPathDescendantsOf returns value followed by all of its descendants, depth first
+func jsonPathDescendantsOf(value interface{}) []interface{} {
+	values := []interface{}{value}
+	for _, child := range jsonPathChildren(value) {
+		values = append(values, jsonPathDescendantsOf(child)...)
+	}
+	return values
+}
+
+func (filter *jsonPathFilterExpr) matches(root interface{}, value interface{}) bool {
+	values := filter.path.evaluate(root, value)
+	if len(values) == 0 {
+		return false
+	}
+	if filter.op == "" {
+		return true
+	}
+
+	left := values[0]
+	if number, ok := left.(json.Number); ok {
+		f, err := number.Float64()
+		if err != nil {
+			return false
+		}
+		left = f
+	}
+
+	switch right := filter.value.(type) {
+	case float64:
+		l, ok := left.(float64)
+		return ok && jsonPathCompare(filter.op, compareFloats(l, right))
+	case string:
+		l, ok := left.(string)
+		return ok && jsonPathCompare(filter.op, strings.Compare(l, right))
+	default:
+		switch filter.op {
+		case "==":
+			return left == right
+		case "!=":
+			return left != right
+		}
+	}
+	return false
+}
+
+func compareFloats(a float64, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// jsonPathCompare returns whether the comparison result cmp satisfies op
+func jsonPathCompare(op string, cmp int) bool {
+	switch op {
+	case "==":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
+
+// toJSONPathData converts v into the generic representation JSONPath expressions are evaluated over, keeping the json
+// field names of api/types structs
+func toJSONPathData(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	var data interface{}
+	if err = decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
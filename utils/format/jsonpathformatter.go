@@ -0,0 +1,80 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package format
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// JSONPathFormatter prints the values selected by a JSONPath template, evaluated over the JSON representation of the
+// api/types structs, so fields are referred by their json names (e.g. {.id}). Lists are wrapped into an object holding
+// them as items (e.g. {.items[*].id})
+type JSONPathFormatter struct {
+	output   io.Writer
+	template *jsonPath
+}
+
+// NewJSONPathFormatter creates a new JSONPathFormatter for the given template
+func NewJSONPathFormatter(out io.Writer, text string) (*JSONPathFormatter, error) {
+	log.Debug("Creating JSONPath formatter")
+
+	if text == "" {
+		return nil, fmt.Errorf("jsonpath formatter requires a template. Please, use --formatter jsonpath='{...}'")
+	}
+	template, err := parseJSONPath(text)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JSONPathFormatter{
+		output:   out,
+		template: template,
+	}, nil
+}
+
+// PrintItem prints an item
+func (f *JSONPathFormatter) PrintItem(item interface{}) error {
+	log.Debug("PrintItem")
+
+	return f.execute(item)
+}
+
+// PrintList prints item list
+func (f *JSONPathFormatter) PrintList(items interface{}) error {
+	log.Debug("PrintList")
+
+	return f.execute(map[string]interface{}{"items": items})
+}
+
+// PrintError prints an error
+func (f *JSONPathFormatter) PrintError(context string, err error) {
+	log.Debug("PrintError")
+
+	f.output.Write([]byte(fmt.Sprintf("ERROR: %s\n -> %s\n", context, err)))
+}
+
+// PrintFatal prints an error and exists
+func (f *JSONPathFormatter) PrintFatal(context string, err error) {
+	log.Debug("PrintFatal")
+
+	f.PrintError(context, err)
+	osExit(1)
+}
+
+// execute renders the template for v, ending the output with a new line when the template does not
+func (f *JSONPathFormatter) execute(v interface{}) error {
+	data, err := toJSONPathData(v)
+	if err != nil {
+		return err
+	}
+	var b bytes.Buffer
+	if err = f.template.execute(&b, data); err != nil {
+		return err
+	}
+	writeLine(f.output, b.Bytes())
+	return nil
+}
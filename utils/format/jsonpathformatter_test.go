@@ -0,0 +1,194 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package format
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/ingrammicro/cio/api/blueprint"
+	"github.com/ingrammicro/cio/api/cloud"
+	"github.com/ingrammicro/cio/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintItemJSONPath(t *testing.T) {
+
+	assert := assert.New(t)
+	serversIn := testdata.GetServerData()
+	for _, serverIn := range serversIn {
+
+		serverOut := cloud.GetServerMocked(t, serverIn)
+
+		var b bytes.Buffer
+		mockOut := bufio.NewWriter(&b)
+		assert.Nil(InitializeFormatter("jsonpath={.id} {.public_ip}", mockOut), "JSONPath formatter error")
+		f := GetFormatter()
+		assert.NotNil(f, "Formatter")
+
+		err := f.PrintItem(*serverOut)
+		assert.Nil(err, "JSONPath formatter PrintItem error")
+		mockOut.Flush()
+
+		assert.Equal(fmt.Sprintf("%s %s\n", serverOut.ID, serverOut.PublicIP), b.String(), "JSONPath output didn't match")
+	}
+}
+
+func TestPrintListJSONPath(t *testing.T) {
+
+	assert := assert.New(t)
+	serversIn := testdata.GetServerData()
+	serversOut := cloud.ListServersMocked(t, serversIn)
+
+	var b bytes.Buffer
+	mockOut := bufio.NewWriter(&b)
+	assert.Nil(InitializeFormatter("jsonpath={.items[*].id}", mockOut), "JSONPath formatter error")
+	f := GetFormatter()
+	assert.NotNil(f, "Formatter")
+
+	err := f.PrintList(serversOut)
+	assert.Nil(err, "JSONPath formatter PrintList error")
+	mockOut.Flush()
+
+	assert.Equal("fakeID0 fakeID1\n", b.String(), "JSONPath output didn't match")
+}
+
+func TestPrintListRangeJSONPath(t *testing.T) {
+
+	assert := assert.New(t)
+	serversIn := testdata.GetServerData()
+	serversOut := cloud.ListServersMocked(t, serversIn)
+
+	var b bytes.Buffer
+	f, err := NewJSONPathFormatter(&b, `{range .items[*]}{.name}{"\t"}{.state}{"\n"}{end}`)
+	assert.Nil(err, "JSONPath formatter error")
+
+	err = f.PrintList(serversOut)
+	assert.Nil(err, "JSONPath formatter PrintList error")
+	assert.Equal("fakeName0\tfakeState0\nfakeName1\tfakeState1\n", b.String(), "JSONPath output didn't match")
+}
+
+func TestPrintItemNestedJSONPath(t *testing.T) {
+
+	assert := assert.New(t)
+	templatesIn := testdata.GetTemplateData()
+	templateOut := blueprint.GetTemplateMocked(t, templatesIn[0])
+
+	var b bytes.Buffer
+	f, err := NewJSONPathFormatter(&b, "{.configuration_attributes}")
+	assert.Nil(err, "JSONPath formatter error")
+
+	err = f.PrintItem(*templateOut)
+	assert.Nil(err, "JSONPath formatter PrintItem error")
+	assert.Regexp("^\\{.*\\}\n$", b.String(), "Objects should be printed as JSON")
+}
+
+func TestJSONPathExpressions(t *testing.T) {
+
+	assert := assert.New(t)
+	data, err := toJSONPathData(map[string]interface{}{
+		"items": []map[string]interface{}{
+			{"id": "a", "size": 10, "ok": true, "tags": []string{"x", "y"}},
+			{"id": "b", "size": 20, "ok": false, "tags": []string{"z"}},
+			{"id": "c", "size": 30, "ok": true},
+		},
+		"name": "root",
+	})
+	assert.Nil(err, "Test data corrupted")
+
+	cases := map[string]string{
+		"{.name}":                           "root",
+		"{$.name}":                          "root",
+		"{.items[0].id}":                    "a",
+		"{.items[-1].id}":                   "c",
+		"{.items[0,2].id}":                  "a c",
+		"{.items[1:].id}":                   "b c",
+		"{.items[:2].id}":                   "a b",
+		"{.items[*].size}":                  "10 20 30",
+		"{.items[?(@.size > 15)].id}":       "b c",
+		"{.items[?(@.id == 'b')].size}":     "20",
+		"{.items[?(@.ok == true)].id}":      "a c",
+		"{.items[?(@.tags)].id}":            "a b",
+		"{..tags[*]}":                       "x y z",
+		"{.items[0]['id','size']}":          "a 10",
+		"{.items[0].tags}":                  `["x","y"]`,
+		"{.missing}":                        "",
+		"{.items[5].id}":                    "",
+		"ids: {.items[*].id}!":              "ids: a b c!",
+		`{range .items[*]}[{.id}]{end}`:     "[a][b][c]",
+		`{range .items[*]}{.tags[*]};{end}`: "x y;z;;",
+		`{range .items[?(@.ok)]}{.id}{end}`: "abc",
+		`{range .items[*]}{$.name}{end}`:    "rootrootroot",
+		`{.items[?(@.id != "a")].id}{"\n"}`: "b c\n",
+	}
+	for text, expected := range cases {
+		jp, err := parseJSONPath(text)
+		assert.Nil(err, "Couldn't parse %s", text)
+		var b bytes.Buffer
+		assert.Nil(jp.execute(&b, data), "Couldn't execute %s", text)
+		assert.Equal(expected, b.String(), "Unexpected output for %s", text)
+	}
+}
+
+func TestJSONPathParseErrors(t *testing.T) {
+
+	assert := assert.New(t)
+	for _, text := range []string{
+		"{.id",
+		"{end}",
+		"{range .items[*]}",
+		"{.items[x]}",
+		"{.items[?(@.id == x)]}",
+		`{"unterminated}`,
+		"{.id!}",
+	} {
+		_, err := parseJSONPath(text)
+		assert.Error(err, "Should have gotten an error parsing %s", text)
+	}
+}
+
+func TestNewJSONPathFormatterErrors(t *testing.T) {
+
+	assert := assert.New(t)
+
+	var b bytes.Buffer
+	_, err := NewJSONPathFormatter(&b, "")
+	assert.Error(err, "Should have gotten an error without template")
+
+	err = InitializeFormatter("jsonpath={.id", &b)
+	assert.Error(err, "Should have gotten an error parsing the template")
+	assert.IsType(&TextFormatter{}, GetFormatter(), "Should have fallen back to the text formatter")
+}
+
+func TestPrintItemWrongBytesJSONPath(t *testing.T) {
+
+	assert := assert.New(t)
+
+	var b bytes.Buffer
+	f, err := NewJSONPathFormatter(&b, "{.id}")
+	assert.Nil(err, "JSONPath formatter error")
+
+	err = f.PrintItem(make(chan int))
+	assert.Error(err, "Should have gotten an error marshaling a JSON")
+}
+
+func TestPrintFatalJSONPath(t *testing.T) {
+
+	// Save current function and restore at the end:
+	oldOsExit := osExit
+	defer func() { osExit = oldOsExit }()
+
+	var got int
+	osExit = func(code int) {
+		got = code
+	}
+	var b bytes.Buffer
+	f, _ := NewJSONPathFormatter(&b, "{.id}")
+	f.PrintFatal("testing fatal", fmt.Errorf("this is a test error %s", "TEST"))
+	if exp := 1; got != exp {
+		t.Errorf("Expected exit code: %d, got: %d", exp, got)
+	}
+	assert.Equal(t, "ERROR: testing fatal\n -> this is a test error TEST\n", b.String(), "Error output didn't match")
+}
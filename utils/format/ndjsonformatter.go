@@ -0,0 +1,88 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package format
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// NDJSONFormatter prints items and lists as newline delimited JSON: one JSON object per line, which suits log
+// pipelines better than a single JSON array
+type NDJSONFormatter struct {
+	output io.Writer
+}
+
+// NewNDJSONFormatter creates a new NDJSONFormatter
+func NewNDJSONFormatter(out io.Writer) *NDJSONFormatter {
+	log.Debug("Creating NDJSON formatter")
+
+	return &NDJSONFormatter{
+		output: out,
+	}
+}
+
+// PrintItem prints an item
+func (f *NDJSONFormatter) PrintItem(item interface{}) error {
+	log.Debug("PrintItem")
+
+	b, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+	f.output.Write(b)
+	fmt.Fprintf(f.output, "\n")
+
+	return nil
+}
+
+// PrintList prints item list, one item per line
+func (f *NDJSONFormatter) PrintList(items interface{}) error {
+	log.Debug("PrintList")
+
+	its := reflect.ValueOf(items)
+	if its.Kind() != reflect.Slice {
+		return fmt.Errorf("couldn't print list. Expected slice, but received %s", its.Kind().String())
+	}
+	for i := 0; i < its.Len(); i++ {
+		if its.Index(i).Kind() == reflect.Ptr && its.Index(i).IsNil() {
+			continue
+		}
+		b, err := json.Marshal(its.Index(i).Interface())
+		if err != nil {
+			return err
+		}
+		f.output.Write(b)
+		fmt.Fprintf(f.output, "\n")
+	}
+
+	return nil
+}
+
+// PrintError prints an error
+func (f *NDJSONFormatter) PrintError(context string, err error) {
+	log.Debug("PrintError")
+
+	msg := newErrorMessage(context, err)
+
+	msgJSON, err := json.Marshal(msg)
+	if err != nil {
+		// fallback to hand made message
+		msgJSON = []byte(fmt.Sprintf("(Formatting error, cannot show JSON)\n %s -> %s \n", context, err))
+	}
+
+	f.output.Write(msgJSON)
+	fmt.Fprintf(f.output, "\n")
+}
+
+// PrintFatal prints an error and exists
+func (f *NDJSONFormatter) PrintFatal(context string, err error) {
+	log.Debug("PrintFatal")
+
+	f.PrintError(context, err)
+	osExit(1)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package format
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ingrammicro/cio/api/cloud"
+	"github.com/ingrammicro/cio/api/types"
+	"github.com/ingrammicro/cio/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintItemNDJSON(t *testing.T) {
+
+	assert := assert.New(t)
+	serversIn := testdata.GetServerData()
+	for _, serverIn := range serversIn {
+
+		serverOut := cloud.GetServerMocked(t, serverIn)
+
+		var b bytes.Buffer
+		mockOut := bufio.NewWriter(&b)
+		assert.Nil(InitializeFormatter("ndjson", mockOut), "NDJSON formatter error")
+		f := GetFormatter()
+		assert.NotNil(f, "Formatter")
+
+		err := f.PrintItem(*serverOut)
+		assert.Nil(err, "NDJSON formatter PrintItem error")
+		mockOut.Flush()
+
+		assert.True(strings.HasSuffix(b.String(), "\n"), "NDJSON output should end with a new line")
+		assert.Equal(1, strings.Count(b.String(), "\n"), "NDJSON item should be printed in a single line")
+
+		var serverTmp types.Server
+		err = json.Unmarshal(b.Bytes(), &serverTmp)
+		assert.Nil(err, "NDJSON output corrupted")
+		assert.Equal(*serverOut, serverTmp, "NDJSON output didn't match")
+	}
+}
+
+func TestPrintListNDJSON(t *testing.T) {
+
+	assert := assert.New(t)
+	serversIn := testdata.GetServerData()
+	serversOut := cloud.ListServersMocked(t, serversIn)
+
+	var b bytes.Buffer
+	mockOut := bufio.NewWriter(&b)
+	assert.Nil(InitializeFormatter("ndjson", mockOut), "NDJSON formatter error")
+	f := GetFormatter()
+	assert.NotNil(f, "Formatter")
+
+	err := f.PrintList(append(serversOut, nil))
+	assert.Nil(err, "NDJSON formatter PrintList error")
+	mockOut.Flush()
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	assert.Len(lines, len(serversOut), "NDJSON output should have a line per item")
+	for i, line := range lines {
+		var serverTmp types.Server
+		err = json.Unmarshal([]byte(line), &serverTmp)
+		assert.Nil(err, "NDJSON output corrupted")
+		assert.Equal(*serversOut[i], serverTmp, "NDJSON output didn't match")
+	}
+}
+
+func TestPrintListNotSliceNDJSON(t *testing.T) {
+
+	assert := assert.New(t)
+
+	var b bytes.Buffer
+	f := NewNDJSONFormatter(&b)
+
+	err := f.PrintList("not a list")
+	assert.Error(err, "Should have gotten an error printing a non list")
+}
+
+func TestPrintErrorNDJSON(t *testing.T) {
+
+	assert := assert.New(t)
+
+	var b bytes.Buffer
+	f := NewNDJSONFormatter(&b)
+
+	f.PrintError("testing errors", fmt.Errorf("this is a test error %s", "TEST"))
+	assert.Equal(
+		`{"type":"Error","context":"testing errors","message":"this is a test error TEST"}`+"\n",
+		b.String(),
+		"Error output didn't match",
+	)
+}
+
+func TestPrintFatalNDJSON(t *testing.T) {
+
+	// Save current function and restore at the end:
+	oldOsExit := osExit
+	defer func() { osExit = oldOsExit }()
+
+	var got int
+	osExit = func(code int) {
+		got = code
+	}
+	var b bytes.Buffer
+	f := NewNDJSONFormatter(&b)
+	f.PrintFatal("testing fatal", fmt.Errorf("this is a test error %s", "TEST"))
+	if exp := 1; got != exp {
+		t.Errorf("Expected exit code: %d, got: %d", exp, got)
+	}
+}
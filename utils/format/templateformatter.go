@@ -0,0 +1,110 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package format
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// templateFuncs are the functions available to templates, on top of the text/template builtins
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"join":  strings.Join,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// TemplateFormatter prints items through a Go template, evaluated over the api/types structs, so fields are referred
+// by their Go names (e.g. {{.ID}} {{.PublicIP}})
+type TemplateFormatter struct {
+	output   io.Writer
+	template *template.Template
+}
+
+// NewTemplateFormatter creates a new TemplateFormatter for the given template text
+func NewTemplateFormatter(out io.Writer, text string) (*TemplateFormatter, error) {
+	log.Debug("Creating Template formatter")
+
+	if text == "" {
+		return nil, fmt.Errorf("template formatter requires a template. Please, use --template")
+	}
+	tmpl, err := template.New("cio").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplateFormatter{
+		output:   out,
+		template: tmpl,
+	}, nil
+}
+
+// PrintItem prints an item
+func (f *TemplateFormatter) PrintItem(item interface{}) error {
+	log.Debug("PrintItem")
+
+	return f.execute(item)
+}
+
+// PrintList prints item list, executing the template once per item
+func (f *TemplateFormatter) PrintList(items interface{}) error {
+	log.Debug("PrintList")
+
+	its := reflect.ValueOf(items)
+	if its.Kind() != reflect.Slice {
+		return fmt.Errorf("couldn't print list. Expected slice, but received %s", its.Kind().String())
+	}
+	for i := 0; i < its.Len(); i++ {
+		if its.Index(i).Kind() == reflect.Ptr && its.Index(i).IsNil() {
+			continue
+		}
+		if err := f.execute(its.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PrintError prints an error
+func (f *TemplateFormatter) PrintError(context string, err error) {
+	log.Debug("PrintError")
+
+	f.output.Write([]byte(fmt.Sprintf("ERROR: %s\n -> %s\n", context, err)))
+}
+
+// PrintFatal prints an error and exists
+func (f *TemplateFormatter) PrintFatal(context string, err error) {
+	log.Debug("PrintFatal")
+
+	f.PrintError(context, err)
+	osExit(1)
+}
+
+// execute renders the template for data, ending the output with a new line when the template does not
+func (f *TemplateFormatter) execute(data interface{}) error {
+	var b bytes.Buffer
+	if err := f.template.Execute(&b, data); err != nil {
+		return err
+	}
+	writeLine(f.output, b.Bytes())
+	return nil
+}
+
+// writeLine writes b, followed by a new line unless it is empty or already ends with one
+func writeLine(out io.Writer, b []byte) {
+	out.Write(b)
+	if len(b) > 0 && b[len(b)-1] != '\n' {
+		fmt.Fprintf(out, "\n")
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package format
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/ingrammicro/cio/api/cloud"
+	"github.com/ingrammicro/cio/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintItemTemplate(t *testing.T) {
+
+	assert := assert.New(t)
+	serversIn := testdata.GetServerData()
+	for _, serverIn := range serversIn {
+
+		serverOut := cloud.GetServerMocked(t, serverIn)
+
+		var b bytes.Buffer
+		mockOut := bufio.NewWriter(&b)
+		assert.Nil(InitializeFormatter("template={{.ID}} {{.PublicIP}}", mockOut), "Template formatter error")
+		f := GetFormatter()
+		assert.NotNil(f, "Formatter")
+
+		err := f.PrintItem(*serverOut)
+		assert.Nil(err, "Template formatter PrintItem error")
+		mockOut.Flush()
+
+		assert.Equal(fmt.Sprintf("%s %s\n", serverOut.ID, serverOut.PublicIP), b.String(), "Template output didn't match")
+	}
+}
+
+func TestPrintListTemplate(t *testing.T) {
+
+	assert := assert.New(t)
+	serversIn := testdata.GetServerData()
+	serversOut := cloud.ListServersMocked(t, serversIn)
+
+	var b bytes.Buffer
+	mockOut := bufio.NewWriter(&b)
+	assert.Nil(InitializeFormatter("template={{.Name}}\t{{upper .State}}\n", mockOut), "Template formatter error")
+	f := GetFormatter()
+	assert.NotNil(f, "Formatter")
+
+	err := f.PrintList(serversOut)
+	assert.Nil(err, "Template formatter PrintList error")
+	mockOut.Flush()
+
+	assert.Equal("fakeName0\tFAKESTATE0\nfakeName1\tFAKESTATE1\n", b.String(), "Template output didn't match")
+}
+
+func TestPrintListNotSliceTemplate(t *testing.T) {
+
+	assert := assert.New(t)
+
+	var b bytes.Buffer
+	f, err := NewTemplateFormatter(&b, "{{.}}")
+	assert.Nil(err, "Template formatter error")
+
+	err = f.PrintList("not a list")
+	assert.Error(err, "Should have gotten an error printing a non list")
+}
+
+func TestPrintItemWrongFieldTemplate(t *testing.T) {
+
+	assert := assert.New(t)
+	serversIn := testdata.GetServerData()
+
+	var b bytes.Buffer
+	f, err := NewTemplateFormatter(&b, "{{.Unknown}}")
+	assert.Nil(err, "Template formatter error")
+
+	err = f.PrintItem(*serversIn[0])
+	assert.Error(err, "Should have gotten an error executing the template")
+}
+
+func TestNewTemplateFormatterErrors(t *testing.T) {
+
+	assert := assert.New(t)
+
+	var b bytes.Buffer
+	_, err := NewTemplateFormatter(&b, "")
+	assert.Error(err, "Should have gotten an error without template")
+
+	_, err = NewTemplateFormatter(&b, "{{.ID")
+	assert.Error(err, "Should have gotten an error parsing the template")
+
+	err = InitializeFormatter("template", &b)
+	assert.Error(err, "Should have gotten an error without template")
+	assert.IsType(&TextFormatter{}, GetFormatter(), "Should have fallen back to the text formatter")
+}
+
+func TestPrintErrorTemplate(t *testing.T) {
+
+	assert := assert.New(t)
+
+	var b bytes.Buffer
+	f, err := NewTemplateFormatter(&b, "{{.ID}}")
+	assert.Nil(err, "Template formatter error")
+
+	f.PrintError("testing errors", fmt.Errorf("this is a test error %s", "TEST"))
+	assert.Equal("ERROR: testing errors\n -> this is a test error TEST\n", b.String(), "Error output didn't match")
+}
+
+func TestPrintFatalTemplate(t *testing.T) {
+
+	// Save current function and restore at the end:
+	oldOsExit := osExit
+	defer func() { osExit = oldOsExit }()
+
+	var got int
+	osExit = func(code int) {
+		got = code
+	}
+	var b bytes.Buffer
+	f, _ := NewTemplateFormatter(&b, "{{.ID}}")
+	f.PrintFatal("testing fatal", fmt.Errorf("this is a test error %s", "TEST"))
+	if exp := 1; got != exp {
+		t.Errorf("Expected exit code: %d, got: %d", exp, got)
+	}
+}
@@ -7,14 +7,18 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sort"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/ingrammicro/cio/api/types"
 	"github.com/ingrammicro/cio/utils"
 	log "github.com/sirupsen/logrus"
 )
 
+var timeType = reflect.TypeOf(time.Time{})
+
 // TextFormatter prints items and lists
 type TextFormatter struct {
 	output io.Writer
@@ -140,71 +144,142 @@ func (f *TextFormatter) PrintItem(item interface{}) error {
 	return nil
 }
 
-func (f *TextFormatter) printListHeadersAux(w *tabwriter.Writer, t reflect.Type) {
-	n := 0
+// listColumn is a column of the tables printed by PrintList, bound to a field found through the index path
+type listColumn struct {
+	header string
+	index  []int
+	nolist bool
+	noshow bool
+}
+
+// listColumns returns the columns of the struct type t, as given by the header tags of its fields. Nested structs are
+// flattened into their own columns
+func listColumns(t reflect.Type, index []int) []listColumn {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
 	if t.Kind() != reflect.Struct {
-		n = t.Elem().NumField()
-	} else {
-		n = t.NumField()
+		return nil
 	}
-	var field reflect.StructField
-	for i := 0; i < n; i++ {
-		if t.Kind() != reflect.Struct {
-			field = t.Elem().Field(i)
-		} else {
-			field = t.Field(i)
+
+	columns := make([]listColumn, 0)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		path := append(append([]int{}, index...), i)
+		if field.Type.Kind() == reflect.Struct && field.Type != timeType {
+			columns = append(columns, listColumns(field.Type, path)...)
+			continue
 		}
-		if field.Type.Kind() == reflect.Struct {
-			f.printListHeadersAux(w, field.Type)
+		if field.Tag.Get("header") == "" {
+			continue
 		}
 		showTags := strings.Split(field.Tag.Get("show"), ",")
-		if !utils.Contains(showTags, "nolist") && field.Tag.Get("header") != "" {
-			fmt.Fprint(w, fmt.Sprintf("%+v\t", field.Tag.Get("header")))
+		columns = append(columns, listColumn{
+			header: field.Tag.Get("header"),
+			index:  path,
+			nolist: utils.Contains(showTags, "nolist"),
+			noshow: utils.Contains(showTags, "noshow"),
+		})
+	}
+	return columns
+}
+
+// findListColumn returns the column with the given header, regardless of case
+func findListColumn(columns []listColumn, header string) (listColumn, error) {
+	for _, column := range columns {
+		if strings.EqualFold(column.header, strings.TrimSpace(header)) {
+			return column, nil
 		}
 	}
+	headers := make([]string, 0, len(columns))
+	for _, column := range columns {
+		headers = append(headers, column.header)
+	}
+	return listColumn{}, fmt.Errorf("unknown column %s. Available columns: %s", header, strings.Join(headers, ","))
 }
 
-func (f *TextFormatter) printListBodyAux(w *tabwriter.Writer, rv reflect.Value, depth int) {
-	switch rv.Kind() {
-	//case reflect.Array, reflect.Chan, reflect.Map, reflect.Ptr, reflect.Slice:
-	case reflect.Slice:
-		for i := 0; i < rv.Len(); i++ {
-			if rv.Index(i).Kind() == reflect.Ptr {
-				if !rv.Index(i).IsNil() {
-					f.printListBodyAux(w, rv.Index(i).Elem(), depth+1)
-					fmt.Fprintln(w)
-				}
-			} else {
-				///usr/local/go/bin/go run main.go firewall list
-				f.printListBodyAux(w, rv.Index(i), depth+1)
-				fmt.Fprintln(w)
+// selectListColumns returns the columns to print according to the list options
+func selectListColumns(columns []listColumn, options ListOptions) ([]listColumn, error) {
+	selected := make([]listColumn, 0)
+	if len(options.Columns) > 0 {
+		for _, header := range options.Columns {
+			column, err := findListColumn(columns, header)
+			if err != nil {
+				return nil, err
 			}
+			selected = append(selected, column)
+		}
+		return selected, nil
+	}
+
+	for _, column := range columns {
+		if !column.nolist || (options.Wide && !column.noshow) {
+			selected = append(selected, column)
 		}
-	case reflect.Struct:
-		f.printListBodyAuxStruct(w, rv, depth)
-	default:
 	}
+	return selected, nil
 }
 
-func (f *TextFormatter) printListBodyAuxStruct(w *tabwriter.Writer, rv reflect.Value, depth int) {
-	for i := 0; i < rv.NumField(); i++ {
-		showTags := strings.Split(rv.Type().Field(i).Tag.Get("show"), ",")
-		if !utils.Contains(showTags, "nolist") {
-			field := rv.Field(i)
-			switch field.Type().String() {
-			case "time.Time":
-				fmt.Fprint(w, fmt.Sprintf("%+v\t", field.Interface()))
-			default:
-				if field.Kind() == reflect.Struct {
-					f.printListBodyAux(w, field, depth+1)
-				} else if field.Kind() == reflect.Map {
-					fmt.Fprint(w, strings.Replace(fmt.Sprintf("%+v\t", field), "map[", "[", -1))
-				} else {
-					fmt.Fprint(w, fmt.Sprintf("%+v\t", field))
-				}
+// listRows returns the items of the slice, skipping nil pointers, sorted by the given column if any
+func listRows(items reflect.Value, sortBy *listColumn) []reflect.Value {
+	rows := make([]reflect.Value, 0, items.Len())
+	for i := 0; i < items.Len(); i++ {
+		row := items.Index(i)
+		if row.Kind() == reflect.Ptr {
+			if row.IsNil() {
+				continue
 			}
+			row = row.Elem()
+		}
+		rows = append(rows, row)
+	}
+
+	if sortBy != nil {
+		sort.SliceStable(rows, func(i, j int) bool {
+			return compareListCells(rows[i].FieldByIndex(sortBy.index), rows[j].FieldByIndex(sortBy.index)) < 0
+		})
+	}
+	return rows
+}
+
+// compareListCells compares two values of a column: numbers and times by their value, anything else as printed
+func compareListCells(a reflect.Value, b reflect.Value) int {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareFloats(float64(a.Int()), float64(b.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return compareFloats(float64(a.Uint()), float64(b.Uint()))
+	case reflect.Float32, reflect.Float64:
+		return compareFloats(a.Float(), b.Float())
+	case reflect.Bool:
+		return compareFloats(boolToFloat(a.Bool()), boolToFloat(b.Bool()))
+	}
+	if a.Type() == timeType {
+		at, bt := a.Interface().(time.Time), b.Interface().(time.Time)
+		if at.Before(bt) {
+			return -1
+		}
+		if at.After(bt) {
+			return 1
 		}
+		return 0
+	}
+	return strings.Compare(formatListCell(a), formatListCell(b))
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// formatListCell returns the text printed for a value in a table
+func formatListCell(field reflect.Value) string {
+	if field.Kind() == reflect.Map {
+		return strings.Replace(fmt.Sprintf("%+v", field), "map[", "[", -1)
 	}
+	return fmt.Sprintf("%+v", field.Interface())
 }
 
 // PrintList prints item list
@@ -218,11 +293,32 @@ func (f *TextFormatter) PrintList(items interface{}) error {
 		return fmt.Errorf("couldn't print list. Expected slice, but received %s", t.String())
 	}
 
+	columns := listColumns(its.Type().Elem(), nil)
+	selected, err := selectListColumns(columns, listOptions)
+	if err != nil {
+		return err
+	}
+	var sortBy *listColumn
+	if listOptions.SortBy != "" {
+		column, err := findListColumn(columns, listOptions.SortBy)
+		if err != nil {
+			return err
+		}
+		sortBy = &column
+	}
+
 	w := tabwriter.NewWriter(f.output, 15, 1, 3, ' ', 0)
-	f.printListHeadersAux(w, reflect.TypeOf(items).Elem())
+	for _, column := range selected {
+		fmt.Fprintf(w, "%s\t", column.header)
+	}
 	fmt.Fprintln(w)
 
-	f.printListBodyAux(w, reflect.ValueOf(items), 0)
+	for _, row := range listRows(its, sortBy) {
+		for _, column := range selected {
+			fmt.Fprintf(w, "%s\t", formatListCell(row.FieldByIndex(column.index)))
+		}
+		fmt.Fprintln(w)
+	}
 	fmt.Fprintln(w)
 
 	w.Flush()
@@ -235,6 +331,9 @@ func (f *TextFormatter) PrintError(context string, err error) {
 	log.Debug("PrintError")
 
 	f.output.Write([]byte(fmt.Sprintf("ERROR: %s\n -> %s\n", context, err)))
+	if apiError, ok := utils.AsAPIError(err); ok && apiError.RequestID != "" {
+		f.output.Write([]byte(fmt.Sprintf(" -> Request ID: %s\n", apiError.RequestID)))
+	}
 }
 
 // PrintFatal prints an error and exists
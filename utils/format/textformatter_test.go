@@ -11,6 +11,7 @@ import (
 	"github.com/ingrammicro/cio/api/blueprint"
 	"github.com/ingrammicro/cio/api/cloud"
 	"github.com/ingrammicro/cio/testdata"
+	"github.com/ingrammicro/cio/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -171,6 +172,26 @@ func TestPrintError(t *testing.T) {
 	assert.Regexp("^ERROR:.*\n -> .*\n", b.String(), "Text output didn't match regular expression")
 }
 
+func TestPrintAPIError(t *testing.T) {
+
+	assert := assert.New(t)
+
+	var b bytes.Buffer
+	mockOut := bufio.NewWriter(&b)
+
+	InitializeFormatter("text", mockOut)
+	f := GetFormatter()
+
+	f.PrintError("Couldn't get server", utils.NewAPIError(404, []byte(`{"error":"Not found","request_id":"r1"}`)))
+	mockOut.Flush()
+
+	assert.Equal(
+		"ERROR: Couldn't get server\n -> HTTP request failed: (404) [Not found]\n -> Request ID: r1\n",
+		b.String(),
+		"API errors should include the request ID",
+	)
+}
+
 func TestPrintListMinifySeconds(t *testing.T) {
 
 	assert := assert.New(t)
@@ -256,3 +277,76 @@ func TestPrintFatalTXT(t *testing.T) {
 		t.Errorf("Expected exit code: %d, got: %d", exp, got)
 	}
 }
+
+func TestPrintListColumnsTXT(t *testing.T) {
+
+	assert := assert.New(t)
+	defer SetListOptions(ListOptions{})
+
+	var b bytes.Buffer
+	mockOut := bufio.NewWriter(&b)
+	InitializeFormatter("text", mockOut)
+	f := GetFormatter()
+
+	SetListOptions(ListOptions{Columns: []string{"name", "VPC_ID", "ID"}})
+	err := f.PrintList(testdata.GetServerData())
+	assert.Nil(err, "Text formatter PrintList error")
+	mockOut.Flush()
+
+	assert.Regexp("^NAME +VPC_ID +ID +\nfakeName0 +fakeID0 +\nfakeName1 +fakeID1 +\n", b.String(), "Unexpected columns")
+}
+
+func TestPrintListUnknownColumnTXT(t *testing.T) {
+
+	assert := assert.New(t)
+	defer SetListOptions(ListOptions{})
+
+	var b bytes.Buffer
+	f := NewTextFormatter(&b)
+
+	SetListOptions(ListOptions{Columns: []string{"ID", "UNKNOWN"}})
+	err := f.PrintList(testdata.GetServerData())
+	assert.Error(err, "Should have gotten an error with an unknown column")
+	assert.Contains(err.Error(), "Available columns: ID,NAME", "Error should list the available columns")
+
+	SetListOptions(ListOptions{SortBy: "UNKNOWN"})
+	err = f.PrintList(testdata.GetServerData())
+	assert.Error(err, "Should have gotten an error sorting by an unknown column")
+}
+
+func TestPrintListSortByTXT(t *testing.T) {
+
+	assert := assert.New(t)
+	defer SetListOptions(ListOptions{})
+
+	var b bytes.Buffer
+	f := NewTextFormatter(&b)
+
+	events := testdata.GetEventData()
+	events[0], events[1] = events[1], events[0]
+
+	SetListOptions(ListOptions{Columns: []string{"ID"}, SortBy: "timestamp"})
+	err := f.PrintList(events)
+	assert.Nil(err, "Text formatter PrintList error")
+	assert.Regexp("^ID +\nfakeID0 +\nfakeID1 +\n", b.String(), "Rows should be sorted by timestamp")
+}
+
+func TestPrintListWideTXT(t *testing.T) {
+
+	assert := assert.New(t)
+	defer SetListOptions(ListOptions{})
+
+	var b bytes.Buffer
+	f := NewTextFormatter(&b)
+
+	err := f.PrintList(testdata.GetServerData())
+	assert.Nil(err, "Text formatter PrintList error")
+	assert.NotContains(b.String(), "VPC_ID", "nolist columns should be hidden by default")
+
+	b.Reset()
+	SetListOptions(ListOptions{Wide: true})
+	err = f.PrintList(testdata.GetServerData())
+	assert.Nil(err, "Text formatter PrintList error")
+	assert.Contains(b.String(), "VPC_ID", "nolist columns should be shown in wide mode")
+	assert.NotContains(b.String(), "LABEL_IDS", "noshow columns should be hidden in wide mode")
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package format
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// YAMLFormatter prints items and lists in YAML format. Items are serialized through their JSON representation, so
+// field names match the ones printed by JSONFormatter and accepted by --*-from-file flags
+type YAMLFormatter struct {
+	output io.Writer
+}
+
+// NewYAMLFormatter creates a new YAMLFormatter
+func NewYAMLFormatter(out io.Writer) *YAMLFormatter {
+	log.Debug("Creating YAML formatter")
+
+	return &YAMLFormatter{
+		output: out,
+	}
+}
+
+// PrintItem prints an item
+func (f *YAMLFormatter) PrintItem(item interface{}) error {
+	log.Debug("PrintItem")
+
+	return f.print(item)
+}
+
+// PrintList prints item list
+func (f *YAMLFormatter) PrintList(items interface{}) error {
+	log.Debug("PrintList")
+
+	return f.print(items)
+}
+
+// PrintError prints an error
+func (f *YAMLFormatter) PrintError(context string, err error) {
+	log.Debug("PrintError")
+
+	msg := newErrorMessage(context, err)
+
+	if err := f.print(msg); err != nil {
+		// fallback to hand made message
+		fmt.Fprintf(f.output, "(Formatting error, cannot show YAML)\n %s -> %s \n", context, msg.Message)
+	}
+}
+
+// PrintFatal prints an error and exists
+func (f *YAMLFormatter) PrintFatal(context string, err error) {
+	log.Debug("PrintFatal")
+
+	f.PrintError(context, err)
+	osExit(1)
+}
+
+// print writes v as a YAML document. v is first marshalled into JSON, which keeps json tags and field order, and then
+// decoded into a YAML node tree re-encoded with plain styles
+func (f *YAMLFormatter) print(v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	var node yaml.Node
+	if err = yaml.Unmarshal(b, &node); err != nil {
+		return err
+	}
+	resetYAMLStyle(&node)
+
+	encoder := yaml.NewEncoder(f.output)
+	encoder.SetIndent(2)
+	if err = encoder.Encode(&node); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// resetYAMLStyle drops the JSON flavoured styles (quoted scalars, flow collections) of the node tree, so that it is
+// printed as block YAML. Scalars which would otherwise be read back with another type are still quoted
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
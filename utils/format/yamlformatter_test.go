@@ -0,0 +1,169 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package format
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/ingrammicro/cio/api/blueprint"
+	"github.com/ingrammicro/cio/api/cloud"
+	"github.com/ingrammicro/cio/api/types"
+	"github.com/ingrammicro/cio/testdata"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestPrintItemYAML(t *testing.T) {
+
+	assert := assert.New(t)
+	serversIn := testdata.GetServerData()
+	for _, serverIn := range serversIn {
+
+		serverOut := cloud.GetServerMocked(t, serverIn)
+
+		var b bytes.Buffer
+		mockOut := bufio.NewWriter(&b)
+		InitializeFormatter("yaml", mockOut)
+		f := GetFormatter()
+		assert.NotNil(f, "Formatter")
+
+		err := f.PrintItem(*serverOut)
+		assert.Nil(err, "YAML formatter PrintItem error")
+		mockOut.Flush()
+
+		assert.Regexp("^id: ", b.String(), "YAML output should start with the id field")
+
+		// round-trip through the JSON field names
+		var document interface{}
+		assert.Nil(yaml.Unmarshal(b.Bytes(), &document), "YAML output couldn't be parsed")
+		data, err := json.Marshal(document)
+		assert.Nil(err, "YAML output couldn't be converted to JSON")
+		var serverBack types.Server
+		assert.Nil(json.Unmarshal(data, &serverBack), "YAML output couldn't be read as a server")
+		assert.Equal(*serverOut, serverBack, "YAML output didn't round-trip")
+	}
+}
+
+func TestPrintItemTemplateYAML(t *testing.T) {
+
+	assert := assert.New(t)
+	templatesIn := testdata.GetTemplateData()
+	for _, templateIn := range templatesIn {
+
+		templateOut := blueprint.GetTemplateMocked(t, templateIn)
+
+		var b bytes.Buffer
+		mockOut := bufio.NewWriter(&b)
+		InitializeFormatter("yaml", mockOut)
+		f := GetFormatter()
+		assert.NotNil(f, "Formatter")
+
+		err := f.PrintItem(*templateOut)
+		assert.Nil(err, "YAML formatter PrintItem error")
+		mockOut.Flush()
+
+		assert.Regexp("^id: ", b.String(), "YAML output should start with the id field")
+	}
+}
+
+func TestPrintListYAML(t *testing.T) {
+
+	assert := assert.New(t)
+	serversIn := testdata.GetServerData()
+	serversOut := cloud.ListServersMocked(t, serversIn)
+
+	var b bytes.Buffer
+	mockOut := bufio.NewWriter(&b)
+	InitializeFormatter("yaml", mockOut)
+	f := GetFormatter()
+	assert.NotNil(f, "Formatter")
+
+	err := f.PrintList(serversOut)
+	assert.Nil(err, "YAML formatter PrintList error")
+	mockOut.Flush()
+
+	assert.Regexp("^- id: ", b.String(), "YAML output should be a sequence of items")
+
+	var serversBack []*types.Server
+	var document interface{}
+	assert.Nil(yaml.Unmarshal(b.Bytes(), &document), "YAML output couldn't be parsed")
+	data, err := json.Marshal(document)
+	assert.Nil(err, "YAML output couldn't be converted to JSON")
+	assert.Nil(json.Unmarshal(data, &serversBack), "YAML output couldn't be read as a server list")
+	assert.Equal(serversOut, serversBack, "YAML output didn't round-trip")
+}
+
+func TestPrintErrorYAML(t *testing.T) {
+
+	assert := assert.New(t)
+
+	var b bytes.Buffer
+	mockOut := bufio.NewWriter(&b)
+
+	InitializeFormatter("yaml", mockOut)
+	f := GetFormatter()
+	assert.NotNil(f, "Formatter")
+
+	f.PrintError("testing errors", fmt.Errorf("this is a test error %s", "TEST"))
+	mockOut.Flush()
+
+	assert.Equal(
+		"type: Error\ncontext: testing errors\nmessage: this is a test error TEST\n",
+		b.String(),
+		"YAML output didn't match",
+	)
+}
+
+func TestPrintItemWrongBytesYAML(t *testing.T) {
+
+	assert := assert.New(t)
+
+	var b bytes.Buffer
+	mockOut := bufio.NewWriter(&b)
+	InitializeFormatter("yaml", mockOut)
+	f := GetFormatter()
+	assert.NotNil(f, "Formatter")
+
+	err := f.PrintItem(make(chan int))
+	assert.Error(err, "Should have gotten an error marshaling a YAML")
+	mockOut.Flush()
+}
+
+func TestPrintListWrongBytesYAML(t *testing.T) {
+
+	assert := assert.New(t)
+
+	var b bytes.Buffer
+	mockOut := bufio.NewWriter(&b)
+	InitializeFormatter("yaml", mockOut)
+	f := GetFormatter()
+	assert.NotNil(f, "Formatter")
+
+	err := f.PrintList(make(chan int))
+	assert.Error(err, "Should have gotten an error marshaling a YAML")
+	mockOut.Flush()
+}
+
+func TestPrintFatalYAML(t *testing.T) {
+
+	// Save current function and restore at the end:
+	oldOsExit := osExit
+	defer func() { osExit = oldOsExit }()
+
+	var got int
+	osExit = func(code int) {
+		got = code
+	}
+	var b bytes.Buffer
+	mockOut := bufio.NewWriter(&b)
+	InitializeFormatter("yaml", mockOut)
+	f := GetFormatter()
+	f.PrintFatal("testing fatal", fmt.Errorf("this is a test error %s", "TEST"))
+	if exp := 1; got != exp {
+		t.Errorf("Expected exit code: %d, got: %d", exp, got)
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+//go:build !solaris
+// +build !solaris
+
+package utils
+
+import (
+	"os"
+
+	"github.com/allan-simon/go-singleinstance"
+)
+
+// lockFile creates a file, if missing, and locks it, failing if it is already locked. The lock is released by closing
+// the file
+func lockFile(file string) (*os.File, error) {
+	return singleinstance.CreateLockFile(file)
+}
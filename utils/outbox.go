@@ -0,0 +1,342 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// OutboxFileName is the name of the outbox file, in the directory of the configuration
+const OutboxFileName = "outbox.jsonl"
+
+// outboxLockWait is the time waited between attempts to lock the outbox file
+const outboxLockWait = 50 * time.Millisecond
+
+// outboxLockTimeout is the maximum time waited for the outbox file to be locked
+const outboxLockTimeout = 10 * time.Second
+
+// OutboxEntry is a request to the platform kept in an outbox until it is accepted
+type OutboxEntry struct {
+	ID        string                 `json:"id"`
+	Method    string                 `json:"method"`
+	Path      string                 `json:"path"`
+	Payload   map[string]interface{} `json:"payload"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+// outboxRecord is a line of the outbox file: either a queued entry, or the ID of one already sent
+type outboxRecord struct {
+	Entry *OutboxEntry `json:"entry,omitempty"`
+	Done  string       `json:"done,omitempty"`
+}
+
+// Outbox is a durable queue of the reports of the agent to the platform, such as script conclusions and command
+// results, so that none is lost while the platform is unreachable. Requests are appended to a file before being sent,
+// and are sent in the order they were queued, the pending ones being kept across restarts until sent. A request may be
+// sent twice if the agent stops right after sending it. The file is shared by all the processes of the agent, any of
+// them sending the requests queued by the others
+type Outbox struct {
+	*outboxLocks
+	file string
+	cs   ConcertoService
+}
+
+// outboxLocks serialize the access of the routines of the process to an outbox file, as file locks may not exclude
+// each other within a process, such as fcntl ones
+type outboxLocks struct {
+	mu       sync.Mutex
+	flushing sync.Mutex
+}
+
+// outboxLocksByFile maps the absolute paths of outbox files to their outboxLocks
+var outboxLocksByFile sync.Map
+
+// NewOutbox returns an outbox kept in file, whose requests are sent through cs. Outboxes kept in the same file share
+// their locks
+func NewOutbox(file string, cs ConcertoService) *Outbox {
+	key := file
+	if abs, err := filepath.Abs(file); err == nil {
+		key = abs
+	}
+	locks, _ := outboxLocksByFile.LoadOrStore(key, &outboxLocks{})
+	return &Outbox{
+		outboxLocks: locks.(*outboxLocks),
+		file:        file,
+		cs:          cs,
+	}
+}
+
+// OutboxFile returns the path of the outbox file of the configuration
+func OutboxFile(config *Config) string {
+	return filepath.Join(config.ConfLocation, OutboxFileName)
+}
+
+// Post queues a POST request, and sends it along with any other pending. It only fails when the request can neither
+// be queued nor sent, as requests failing to be sent are kept to be sent by a later Flush
+func (o *Outbox) Post(ctx context.Context, path string, payload *map[string]interface{}) error {
+	return o.send(ctx, http.MethodPost, path, payload)
+}
+
+// Put is like Post, but for a PUT request
+func (o *Outbox) Put(ctx context.Context, path string, payload *map[string]interface{}) error {
+	return o.send(ctx, http.MethodPut, path, payload)
+}
+
+func (o *Outbox) send(ctx context.Context, method string, path string, payload *map[string]interface{}) error {
+	entry, err := o.Enqueue(ctx, method, path, payload)
+	if err != nil {
+		log.Warnf("Couldn't queue %s %s to the outbox, sending it right away: %v", method, path, err)
+		return o.deliver(ctx, &OutboxEntry{Method: method, Path: path, Payload: *payload})
+	}
+	if err = o.Flush(ctx); err != nil {
+		log.Warnf("Couldn't send %s %s yet, it is kept in the outbox (%s): %v", method, path, entry.ID, err)
+	}
+	return nil
+}
+
+// Enqueue appends a request to the outbox file, without sending it
+func (o *Outbox) Enqueue(
+	ctx context.Context,
+	method string,
+	path string,
+	payload *map[string]interface{},
+) (*OutboxEntry, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	entry := &OutboxEntry{
+		ID:        hex.EncodeToString(id),
+		Method:    method,
+		Path:      path,
+		Payload:   *payload,
+		CreatedAt: time.Now().UTC(),
+	}
+	err := o.locked(ctx, func() error {
+		return o.append(outboxRecord{Entry: entry})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// Pending returns the requests of the outbox not sent yet, in the order they were queued
+func (o *Outbox) Pending(ctx context.Context) ([]*OutboxEntry, error) {
+	var entries []*OutboxEntry
+	err := o.locked(ctx, func() error {
+		var err error
+		entries, err = o.read()
+		return err
+	})
+	return entries, err
+}
+
+// Flush sends the pending requests, in order, stopping at the first one failing for a reason that may be transient,
+// such as the platform being unreachable. Requests rejected by the platform, which would never be accepted, are
+// discarded. Nothing is done while another routine or process is flushing the outbox, as it sends every request
+func (o *Outbox) Flush(ctx context.Context) error {
+	if err := os.MkdirAll(filepath.Dir(o.file), 0700); err != nil {
+		return err
+	}
+	if !o.flushing.TryLock() {
+		log.Debug("The outbox is already being flushed")
+		return nil
+	}
+	defer o.flushing.Unlock()
+	flushLock, err := lockFile(o.file + ".flush.lock")
+	if err != nil {
+		log.Debug("The outbox is already being flushed: ", err)
+		return nil
+	}
+	defer flushLock.Close()
+
+	for {
+		entries, err := o.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return o.locked(ctx, o.compact)
+		}
+
+		entry := entries[0]
+		if err = o.deliver(ctx, entry); err != nil {
+			if !isRejected(err) {
+				return err
+			}
+			log.Errorf("Discarding %s %s (%s) from the outbox, rejected by the platform: %v",
+				entry.Method, entry.Path, entry.ID, err)
+		}
+		err = o.locked(ctx, func() error {
+			return o.append(outboxRecord{Done: entry.ID})
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// deliver sends the request of an entry
+func (o *Outbox) deliver(ctx context.Context, entry *OutboxEntry) error {
+	log.Debugf("Sending %s %s from the outbox", entry.Method, entry.Path)
+
+	payload := entry.Payload
+	var data []byte
+	var status int
+	var err error
+	switch entry.Method {
+	case http.MethodPost:
+		data, status, err = o.cs.PostIdempotentContext(ctx, entry.Path, &payload)
+	case http.MethodPut:
+		data, status, err = o.cs.PutContext(ctx, entry.Path, &payload)
+	default:
+		return &APIError{StatusCode: http.StatusMethodNotAllowed, Message: "unsupported method " + entry.Method}
+	}
+	if err != nil {
+		return err
+	}
+	return CheckStandardStatus(status, data)
+}
+
+// isRejected tells whether err is an API error which retrying the request would not solve
+func isRejected(err error) bool {
+	apiError, ok := AsAPIError(err)
+	if !ok {
+		return false
+	}
+	return apiError.StatusCode >= 400 && apiError.StatusCode < 500 &&
+		apiError.StatusCode != http.StatusRequestTimeout && apiError.StatusCode != http.StatusTooManyRequests
+}
+
+// locked runs fn holding the lock of the outbox file, which is waited for until ctx is done or outboxLockTimeout
+func (o *Outbox) locked(ctx context.Context, fn func() error) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(o.file), 0700); err != nil {
+		return err
+	}
+	timeout := time.NewTimer(outboxLockTimeout)
+	defer timeout.Stop()
+	for {
+		lock, err := lockFile(o.file + ".lock")
+		if err == nil {
+			defer lock.Close()
+			return fn()
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeout.C:
+			return fmt.Errorf("cannot lock the outbox: %v", err)
+		case <-time.After(outboxLockWait):
+		}
+	}
+}
+
+// append writes a record at the end of the outbox file, synced to disk before returning
+func (o *Outbox) append(record outboxRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(o.file, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return err
+	}
+	// a line left partially written, as when the agent stopped while writing it, is ended first
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	if fi.Size() > 0 {
+		last := make([]byte, 1)
+		if _, err = f.ReadAt(last, fi.Size()-1); err != nil {
+			f.Close()
+			return err
+		}
+		if last[0] != '\n' {
+			line = append([]byte{'\n'}, line...)
+		}
+	}
+	if _, err = f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// read returns the pending entries of the outbox file. Lines which cannot be decoded, as the last one when the
+// agent stopped while writing it, are skipped
+func (o *Outbox) read() ([]*OutboxEntry, error) {
+	data, err := os.ReadFile(o.file)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*OutboxEntry
+	done := make(map[string]bool)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var record outboxRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			log.Warnf("Skipping a malformed line of the outbox %s: %v", o.file, err)
+			continue
+		}
+		if record.Entry != nil {
+			entries = append(entries, record.Entry)
+		}
+		if record.Done != "" {
+			done[record.Done] = true
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	pending := entries[:0]
+	for _, entry := range entries {
+		if !done[entry.ID] {
+			pending = append(pending, entry)
+		}
+	}
+	return pending, nil
+}
+
+// compact empties the outbox file once every request queued to it has been sent
+func (o *Outbox) compact() error {
+	entries, err := o.read()
+	if err != nil || len(entries) > 0 {
+		return err
+	}
+	if err = os.Truncate(o.file, 0); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
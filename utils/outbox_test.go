@@ -0,0 +1,121 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOutbox(t *testing.T) {
+	assert := assert.New(t)
+
+	file := filepath.Join(t.TempDir(), "state", OutboxFileName)
+	ctx := context.Background()
+	cs := new(MockConcertoService)
+	unreachable := errors.New("connection refused")
+	cs.On("PostIdempotent", "/conclusions", mock.Anything).Return([]byte(nil), 0, unreachable).Once()
+	cs.On("PostIdempotent", "/conclusions", mock.Anything).Return([]byte(nil), 0, unreachable).Once()
+
+	outbox := NewOutbox(file, cs)
+	assert.Nil(outbox.Post(ctx, "/conclusions", &map[string]interface{}{"exit_code": 1}),
+		"Requests failing to be sent should be kept")
+	assert.Nil(outbox.Put(ctx, "/commands/1", &map[string]interface{}{"exit_code": 0}))
+	cs.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+
+	// a restarted agent sends the requests kept, in order
+	outbox = NewOutbox(file, cs)
+	pending, err := outbox.Pending(ctx)
+	assert.Nil(err)
+	assert.Len(pending, 2, "Requests not sent should be kept across restarts")
+	assert.Equal("/conclusions", pending[0].Path)
+	assert.Equal(float64(1), pending[0].Payload["exit_code"])
+
+	cs.On("PostIdempotent", "/conclusions", mock.Anything).Return([]byte("{}"), 201, nil).Once()
+	cs.On("Put", "/commands/1", mock.Anything).Return([]byte("{}"), 200, nil).Once()
+	assert.Nil(outbox.Flush(ctx))
+	cs.AssertExpectations(t)
+	last := cs.Calls[len(cs.Calls)-1]
+	assert.Equal("Put", last.Method, "Requests should be sent in the order they were queued")
+
+	pending, err = outbox.Pending(ctx)
+	assert.Nil(err)
+	assert.Empty(pending)
+	fi, err := os.Stat(file)
+	assert.Nil(err)
+	assert.Zero(fi.Size(), "The outbox file should be emptied once every request is sent")
+	assert.Equal(os.FileMode(0600), fi.Mode().Perm(), "The outbox file should only be readable by its owner")
+}
+
+func TestOutboxRejected(t *testing.T) {
+	assert := assert.New(t)
+
+	file := filepath.Join(t.TempDir(), OutboxFileName)
+	ctx := context.Background()
+	cs := new(MockConcertoService)
+	cs.On("PostIdempotent", "/logs", mock.Anything).Return([]byte(nil), 0, errors.New("timeout")).Once()
+	cs.On("PostIdempotent", "/logs", mock.Anything).Return([]byte(`{"error":"invalid"}`), 422, nil).Once()
+	cs.On("PostIdempotent", "/logs", mock.Anything).Return([]byte("{}"), 201, nil).Once()
+
+	outbox := NewOutbox(file, cs)
+	_, err := outbox.Enqueue(ctx, "POST", "/logs", &map[string]interface{}{"stdout": "a"})
+	assert.Nil(err)
+	_, err = outbox.Enqueue(ctx, "POST", "/logs", &map[string]interface{}{"stdout": "b"})
+	assert.Nil(err)
+
+	assert.NotNil(outbox.Flush(ctx), "Flushing should stop at transient failures")
+	pending, _ := outbox.Pending(ctx)
+	assert.Len(pending, 2)
+
+	assert.Nil(outbox.Flush(ctx), "Requests rejected by the platform should be discarded")
+	pending, _ = outbox.Pending(ctx)
+	assert.Empty(pending)
+	cs.AssertExpectations(t)
+}
+
+func TestOutboxMalformed(t *testing.T) {
+	assert := assert.New(t)
+
+	file := filepath.Join(t.TempDir(), OutboxFileName)
+	ctx := context.Background()
+	outbox := NewOutbox(file, new(MockConcertoService))
+	entry, err := outbox.Enqueue(ctx, "PUT", "/commands/1", &map[string]interface{}{"stdout": "done"})
+	assert.Nil(err)
+
+	f, err := os.OpenFile(file, os.O_APPEND|os.O_WRONLY, 0600)
+	assert.Nil(err)
+	_, err = f.WriteString(`{"entry":{"id":"trunc`)
+	assert.Nil(err)
+	f.Close()
+
+	next, err := outbox.Enqueue(ctx, "PUT", "/commands/2", &map[string]interface{}{"stdout": "done"})
+	assert.Nil(err)
+
+	pending, err := outbox.Pending(ctx)
+	assert.Nil(err, "Lines partially written should be skipped")
+	assert.Len(pending, 2, "Requests queued after a line partially written should be kept")
+	assert.Equal(entry.ID, pending[0].ID)
+	assert.Equal(next.ID, pending[1].ID)
+}
+
+func TestOutboxSharesLocks(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, OutboxFileName)
+	cs := new(MockConcertoService)
+	first, second := NewOutbox(file, cs), NewOutbox(filepath.Join(dir, ".", OutboxFileName), cs)
+	assert.Same(first.outboxLocks, second.outboxLocks, "Outboxes of the same file should share their locks")
+	assert.NotSame(first.outboxLocks, NewOutbox(filepath.Join(dir, "other"), cs).outboxLocks)
+
+	first.flushing.Lock()
+	defer first.flushing.Unlock()
+	assert.Nil(second.Flush(context.Background()), "Flushing should be skipped while another routine flushes")
+	cs.AssertNotCalled(t, "PostIdempotent", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const truncatedMsg = "\n[output truncated after %d bytes]\n"
+const truncatedSummaryMsg = "\n[output truncated after %d bytes, %d more dropped]\n"
+
+// chunker gathers output into chunks, which are handed to report once they hold thresholdLines lines, or once
+// thresholdTime has passed since the previous one. Chunks failing to be reported are kept, and sent along with the next
+type chunker struct {
+	report         func(chunk string) error
+	thresholdTime  time.Duration
+	thresholdLines int
+	chunk          string
+	nLines         int
+	timeStart      time.Time
+}
+
+// newChunker returns a chunker reporting to report, with no threshold when not positive
+func newChunker(report func(chunk string) error, thresholdTime time.Duration, thresholdLines int) *chunker {
+	return &chunker{
+		report:         report,
+		thresholdTime:  thresholdTime,
+		thresholdLines: thresholdLines,
+		timeStart:      time.Now(),
+	}
+}
+
+// add appends output to the chunk, and reports it if any threshold is reached
+func (c *chunker) add(output string) {
+	c.chunk = strings.Join([]string{c.chunk, output}, "")
+	c.nLines += strings.Count(output, "\n")
+	if c.due() {
+		c.send()
+	}
+}
+
+// due tells whether any threshold is reached
+func (c *chunker) due() bool {
+	return (c.thresholdTime > 0 && time.Since(c.timeStart) >= c.thresholdTime) ||
+		(c.thresholdLines > 0 && c.nLines >= c.thresholdLines)
+}
+
+// send reports the chunk, unless empty, and restarts counting towards the thresholds
+func (c *chunker) send() error {
+	var err error
+	if len(c.chunk) > 0 {
+		if err = c.report(c.chunk); err == nil {
+			c.chunk = ""
+		}
+	}
+	c.nLines = 0
+	c.timeStart = time.Now()
+	return err
+}
+
+// outputStream captures an output stream of a command, which is also copied to echo and, if report is given, held
+// until shipped. Writes never wait for output to be reported: output written while a chunk is being shipped, or while
+// reporting fails, is coalesced into the next one. Once maxSize bytes are captured, if positive, the rest of the output
+// is dropped, and a truncation marker is reported in its place
+type outputStream struct {
+	mu      sync.Mutex
+	echo    io.Writer
+	report  func(chunk string) error
+	pending string
+	maxSize int
+	buffer  bytes.Buffer
+	size    int
+	dropped int
+}
+
+// Write captures the output, never failing, so that the command is not disturbed
+func (s *outputStream) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.echo.Write(p)
+	kept := p
+	marker := ""
+	if s.maxSize > 0 && s.size+len(p) > s.maxSize {
+		kept = p[:s.maxSize-s.size]
+		if s.dropped == 0 {
+			marker = fmt.Sprintf(truncatedMsg, s.maxSize)
+		}
+		s.dropped += len(p) - len(kept)
+	}
+	s.buffer.Write(kept)
+	s.size += len(kept)
+	if s.report != nil {
+		s.pending = strings.Join([]string{s.pending, string(kept), marker}, "")
+	}
+	return len(p), nil
+}
+
+// note adds a note to the output, regardless of its size
+func (s *outputStream) note(text string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.buffer.WriteString(text)
+	if s.report != nil {
+		s.pending = strings.Join([]string{s.pending, text}, "")
+	}
+}
+
+// ship reports the output held so far, if any. The stream is not locked while reporting, so that the command keeps
+// writing meanwhile. Output failing to be reported is held again, ahead of the one written since. Calls are expected
+// to be serialized
+func (s *outputStream) ship() error {
+	s.mu.Lock()
+	chunk := s.pending
+	s.pending = ""
+	s.mu.Unlock()
+
+	if s.report == nil || chunk == "" {
+		return nil
+	}
+	err := s.report(chunk)
+	if err != nil {
+		s.mu.Lock()
+		s.pending = strings.Join([]string{chunk, s.pending}, "")
+		s.mu.Unlock()
+	}
+	return err
+}
+
+// shipOutput ships the output of the streams every interval, from its own goroutine, until the returned function is
+// called, which waits for the chunks being shipped, if any. Ticks are dropped while shipping takes longer than the
+// interval, so that output is coalesced into fewer chunks
+func shipOutput(interval time.Duration, streams ...*outputStream) (stop func()) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				for _, s := range streams {
+					if err := s.ship(); err != nil {
+						log.Warn("Cannot report the output of the command yet: ", err)
+					}
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// String returns the captured output, ending with the truncation marker if any output was dropped
+func (s *outputStream) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.dropped > 0 {
+		return s.buffer.String() + fmt.Sprintf(truncatedSummaryMsg, s.maxSize, s.dropped)
+	}
+	return s.buffer.String()
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunker(t *testing.T) {
+	assert := assert.New(t)
+
+	var chunks []string
+	failing := false
+	c := newChunker(func(chunk string) error {
+		if failing {
+			return fmt.Errorf("cannot report")
+		}
+		chunks = append(chunks, chunk)
+		return nil
+	}, 0, 2)
+
+	c.add("one\n")
+	assert.Empty(chunks, "Chunks should be held until reaching the threshold")
+	c.add("two\n")
+	assert.Equal([]string{"one\ntwo\n"}, chunks)
+
+	failing = true
+	c.add("three\nfour\n")
+	failing = false
+	c.add("five\n")
+	assert.Len(chunks, 1, "Chunks failing to be reported should restart counting")
+	assert.Nil(c.send())
+	assert.Equal([]string{"one\ntwo\n", "three\nfour\nfive\n"}, chunks, "Failed chunks should be sent with the next")
+	assert.Nil(c.send(), "Empty chunks should not be reported")
+	assert.Len(chunks, 2)
+}
+
+func TestOutputStreamTruncation(t *testing.T) {
+	assert := assert.New(t)
+
+	var chunks []string
+	s := &outputStream{
+		echo:    ioutil.Discard,
+		maxSize: 10,
+		report: func(chunk string) error {
+			chunks = append(chunks, chunk)
+			return nil
+		},
+	}
+	s.Write([]byte("0123456\n"))
+	s.ship()
+	s.Write([]byte("789abc\n"))
+	s.Write([]byte("def\n"))
+	s.ship()
+	s.note("[note]\n")
+	s.ship()
+
+	assert.Equal(
+		"0123456\n78[note]\n\n[output truncated after 10 bytes, 9 more dropped]\n",
+		s.String(),
+	)
+	assert.Equal([]string{"0123456\n", "78\n[output truncated after 10 bytes]\n", "[note]\n"}, chunks,
+		"The truncation marker should be reported once, in place of the dropped output")
+}
+
+func TestOutputStreamShipping(t *testing.T) {
+	assert := assert.New(t)
+
+	var chunks []string
+	failing := true
+	s := &outputStream{
+		echo: ioutil.Discard,
+		report: func(chunk string) error {
+			if failing {
+				return fmt.Errorf("cannot report")
+			}
+			chunks = append(chunks, chunk)
+			return nil
+		},
+	}
+	s.Write([]byte("one\n"))
+	assert.NotNil(s.ship(), "Failures to report should be told")
+	s.Write([]byte("two\n"))
+	failing = false
+	assert.Nil(s.ship())
+	assert.Equal([]string{"one\ntwo\n"}, chunks, "Output failing to be reported should be coalesced with the next")
+	assert.Nil(s.ship(), "Empty chunks should not be reported")
+	assert.Len(chunks, 1)
+}
+
+func TestRunStreamedCmdContextSlowReports(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Commands are shell scripts")
+	}
+	assert := assert.New(t)
+
+	var mu sync.Mutex
+	var reported string
+	streaming := &CmdStreaming{
+		Report: func(stream string, chunk string) error {
+			time.Sleep(500 * time.Millisecond)
+			mu.Lock()
+			defer mu.Unlock()
+			reported += chunk
+			return nil
+		},
+		ThresholdTime: 50 * time.Millisecond,
+	}
+	exitCode, stdOut, _, startedAt, finishedAt := RunStreamedCmdContext(
+		context.Background(),
+		"for i in 1 2 3 4 5 6 7 8 9 10; do echo $i; sleep 0.1; done",
+		time.Minute,
+		streaming,
+	)
+
+	assert.Equal(0, exitCode)
+	assert.Equal("1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n", stdOut)
+	assert.Equal(stdOut, reported, "Output reported late should be coalesced, not lost")
+	assert.Less(int64(finishedAt.Sub(startedAt)), int64(2500*time.Millisecond),
+		"Slow reports should not slow down the command")
+}
+
+func TestRunStreamedCmdContext(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Commands are shell scripts")
+	}
+	assert := assert.New(t)
+
+	var mu sync.Mutex
+	reported := map[string]string{}
+	var firstAt time.Time
+	streaming := &CmdStreaming{
+		Report: func(stream string, chunk string) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if firstAt.IsZero() {
+				firstAt = time.Now()
+			}
+			reported[stream] += chunk
+			return nil
+		},
+		ThresholdTime: 100 * time.Millisecond,
+		MaxOutput:     1000,
+	}
+	exitCode, stdOut, stdErr, _, finishedAt := RunStreamedCmdContext(
+		context.Background(),
+		"echo started; echo warning >&2; sleep 1; head -c 2000 /dev/zero | tr '\\0' x",
+		time.Minute,
+		streaming,
+	)
+
+	assert.Equal(0, exitCode)
+	assert.True(finishedAt.Sub(firstAt) > 500*time.Millisecond, "Output should be reported while the command runs")
+	assert.Equal("warning\n", reported["stderr"])
+	assert.Equal("warning\n", stdErr)
+	assert.True(strings.HasPrefix(stdOut, "started\nxxx"))
+	assert.Contains(stdOut, "[output truncated after 1000 bytes, 1008 more dropped]")
+	assert.Contains(reported["stdout"], "[output truncated after 1000 bytes]")
+}
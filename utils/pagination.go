@@ -0,0 +1,232 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultPageSize is the number of items requested per page when listing collections
+const DefaultPageSize = 100
+
+// linkNextRegexp matches the target of the rel="next" entry of a Link header
+var linkNextRegexp = regexp.MustCompile(`<([^>]*)>[^,]*;\s*rel="?next"?`)
+
+// PageOptions tunes how collections are listed. Zero values mean defaults
+type PageOptions struct {
+	// Size is the number of items requested per page
+	Size int
+	// Limit is the maximum number of items listed overall. Zero means no limit
+	Limit int
+}
+
+type pageOptionsKey struct{}
+
+// WithPageOptions returns a copy of ctx carrying the page options applied by the List calls made with it
+func WithPageOptions(ctx context.Context, options PageOptions) context.Context {
+	return context.WithValue(ctx, pageOptionsKey{}, options)
+}
+
+// PageOptionsFromContext returns the page options carried by ctx, if any
+func PageOptionsFromContext(ctx context.Context) PageOptions {
+	options, _ := ctx.Value(pageOptionsKey{}).(PageOptions)
+	return options
+}
+
+// PagePath returns the path requesting the given page (starting at 1) of size items
+func PagePath(path string, page int, size int) string {
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+	return fmt.Sprintf("%s%spage=%d&per_page=%d", path, separator, page, size)
+}
+
+// Pager walks a paginated collection one page at a time. The next page is the one linked by the Link header of the
+// response when present, or else the following page number as long as full pages are received. Servers ignoring the
+// page parameters are detected by pages larger than requested or repeating the previous one, which end the walk
+type Pager struct {
+	ctx      context.Context
+	service  ConcertoService
+	path     string
+	nextPath string
+	page     int
+	size     int
+	limit    int
+	listed   int
+	linked   bool
+	bounds   [2]string
+	items    []json.RawMessage
+	err      error
+}
+
+// NewPager returns a pager over the collection at path, applying the page options carried by ctx
+func NewPager(ctx context.Context, service ConcertoService, path string) *Pager {
+	options := PageOptionsFromContext(ctx)
+	size := options.Size
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+	return &Pager{
+		ctx:      ctx,
+		service:  service,
+		path:     path,
+		nextPath: PagePath(path, 1, size),
+		page:     1,
+		size:     size,
+		limit:    options.Limit,
+	}
+}
+
+// Next fetches the next page. It returns false once the collection, or the limit, is exhausted or when an error
+// occurs, which is then available through Err
+func (p *Pager) Next() bool {
+	p.items = nil
+	if p.err != nil || p.nextPath == "" {
+		return false
+	}
+
+	data, next, status, err := p.service.GetPageContext(p.ctx, p.nextPath)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	if err = CheckStandardStatus(status, data); err != nil {
+		p.err = err
+		return false
+	}
+	var items []json.RawMessage
+	if err = json.Unmarshal(data, &items); err != nil {
+		p.err = err
+		return false
+	}
+
+	// once the server links pages, the last one is the one without link
+	bounds := pageBounds(items)
+	switch {
+	case next != "":
+		p.nextPath = next
+		p.linked = true
+	case p.linked:
+		p.nextPath = ""
+	case p.page > 1 && repeatsPage(bounds, p.bounds):
+		log.Debugf("Page %d repeats the previous one, the server seems to ignore pagination", p.page)
+		p.nextPath = ""
+		return false
+	case len(items) == p.size:
+		p.page++
+		p.nextPath = PagePath(p.path, p.page, p.size)
+	default:
+		p.nextPath = ""
+	}
+	p.bounds = bounds
+
+	if p.limit > 0 && p.listed+len(items) >= p.limit {
+		items = items[:p.limit-p.listed]
+		p.nextPath = ""
+	}
+	p.listed += len(items)
+	p.items = items
+	return len(items) > 0
+}
+
+// pageBounds returns the IDs of the first and last items of a page, empty when they have none
+func pageBounds(items []json.RawMessage) [2]string {
+	var bounds [2]string
+	if len(items) == 0 {
+		return bounds
+	}
+	var item struct {
+		ID string `json:"id"`
+	}
+	if json.Unmarshal(items[0], &item) == nil {
+		bounds[0] = item.ID
+	}
+	item.ID = ""
+	if json.Unmarshal(items[len(items)-1], &item) == nil {
+		bounds[1] = item.ID
+	}
+	return bounds
+}
+
+// repeatsPage tells whether a page, given its first and last IDs, starts or ends like the previous one
+func repeatsPage(bounds, previous [2]string) bool {
+	for _, id := range bounds {
+		if id != "" && (id == previous[0] || id == previous[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Decode unmarshals the items of the current page into v, which is expected to point to a slice
+func (p *Pager) Decode(v interface{}) error {
+	data, err := json.Marshal(p.items)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Err returns the error that stopped the pager, if any
+func (p *Pager) Err() error {
+	return p.err
+}
+
+// GetPage sends GET request to Concerto API for a page of a collection
+func (hcs *HTTPConcertoservice) GetPage(path string) ([]byte, string, int, error) {
+	return hcs.GetPageContext(context.Background(), path)
+}
+
+// GetPageContext is like GetContext, but it also returns the path of the next page, as linked by the Link header of
+// the response, or "" when there is none
+func (hcs *HTTPConcertoservice) GetPageContext(ctx context.Context, path string) ([]byte, string, int, error) {
+
+	url, _, err := hcs.prepareCall(path, nil)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	log.Debugf("Sending GET request to %s", url)
+	response, err := hcs.doRequest(ctx, hcs.client, func() (*http.Request, error) {
+		return http.NewRequest("GET", url, nil)
+	}, true)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	next := hcs.nextPagePath(response)
+	body, status, err := hcs.receiveResponse(response)
+	return body, next, status, err
+}
+
+// nextPagePath returns the path, relative to the API endpoint, of the rel="next" Link of the response. Links outside
+// the API endpoint are not followed
+func (hcs *HTTPConcertoservice) nextPagePath(response *http.Response) string {
+	for _, link := range response.Header.Values("Link") {
+		match := linkNextRegexp.FindStringSubmatch(link)
+		if match == nil {
+			continue
+		}
+		target, err := url.Parse(match[1])
+		if err != nil {
+			log.Debugf("Ignoring malformed Link header %q: %v", link, err)
+			return ""
+		}
+		next := response.Request.URL.ResolveReference(target).String()
+		if !strings.HasPrefix(next, hcs.config.APIEndpoint) {
+			log.Debugf("Ignoring Link to %s, outside of %s", next, hcs.config.APIEndpoint)
+			return ""
+		}
+		return strings.TrimPrefix(next, hcs.config.APIEndpoint)
+	}
+	return ""
+}
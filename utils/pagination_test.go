@@ -0,0 +1,182 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPagePath(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("/cloud/servers?page=2&per_page=10", PagePath("/cloud/servers", 2, 10))
+	assert.Equal("/blueprint/scripts?type=boot&page=1&per_page=5", PagePath("/blueprint/scripts?type=boot", 1, 5))
+}
+
+func TestPagerFollowsPageNumbers(t *testing.T) {
+	assert := assert.New(t)
+
+	cs := &MockConcertoService{}
+	cs.On("Get", "/items?page=1&per_page=2").Return([]byte(`[{"id":"1"},{"id":"2"}]`), 200, nil)
+	cs.On("Get", "/items?page=2&per_page=2").Return([]byte(`[{"id":"3"}]`), 200, nil)
+
+	var ids []string
+	pager := NewPager(WithPageOptions(context.Background(), PageOptions{Size: 2}), cs, "/items")
+	for pager.Next() {
+		var items []struct{ ID string }
+		assert.Nil(pager.Decode(&items), "Page should be decoded")
+		for _, item := range items {
+			ids = append(ids, item.ID)
+		}
+	}
+	assert.Nil(pager.Err(), "Pager should not fail")
+	assert.Equal([]string{"1", "2", "3"}, ids, "Pager should list the items of every page")
+	cs.AssertNumberOfCalls(t, "Get", 2)
+}
+
+func TestPagerStopsAtLimit(t *testing.T) {
+	assert := assert.New(t)
+
+	cs := &MockConcertoService{}
+	cs.On("Get", "/items?page=1&per_page=2").Return([]byte(`[{"id":"1"},{"id":"2"}]`), 200, nil)
+	cs.On("Get", "/items?page=2&per_page=2").Return([]byte(`[{"id":"3"},{"id":"4"}]`), 200, nil)
+
+	listed := 0
+	pager := NewPager(WithPageOptions(context.Background(), PageOptions{Size: 2, Limit: 3}), cs, "/items")
+	for pager.Next() {
+		var items []struct{ ID string }
+		assert.Nil(pager.Decode(&items), "Page should be decoded")
+		listed += len(items)
+	}
+	assert.Nil(pager.Err(), "Pager should not fail")
+	assert.Equal(3, listed, "Pager should list no more items than the limit")
+	cs.AssertNumberOfCalls(t, "Get", 2)
+}
+
+func TestPagerStopsOnRepeatedPages(t *testing.T) {
+	assert := assert.New(t)
+
+	cs := &MockConcertoService{}
+	cs.On("Get", "/items?page=1&per_page=2").Return([]byte(`[{"id":"1"},{"id":"2"}]`), 200, nil)
+	cs.On("Get", "/items?page=2&per_page=2").Return([]byte(`[{"id":"1"},{"id":"2"}]`), 200, nil)
+
+	var ids []string
+	pager := NewPager(WithPageOptions(context.Background(), PageOptions{Size: 2}), cs, "/items")
+	for pager.Next() {
+		var items []struct{ ID string }
+		assert.Nil(pager.Decode(&items), "Page should be decoded")
+		for _, item := range items {
+			ids = append(ids, item.ID)
+		}
+	}
+	assert.Nil(pager.Err(), "Pager should not fail")
+	assert.Equal([]string{"1", "2"}, ids, "Pager should not list the items of a repeated page")
+	cs.AssertNumberOfCalls(t, "Get", 2)
+}
+
+func TestPagerStopsOnOversizedPages(t *testing.T) {
+	assert := assert.New(t)
+
+	cs := &MockConcertoService{}
+	cs.On("Get", "/items?page=1&per_page=2").Return([]byte(`[{"id":"1"},{"id":"2"},{"id":"3"}]`), 200, nil)
+
+	listed := 0
+	pager := NewPager(WithPageOptions(context.Background(), PageOptions{Size: 2}), cs, "/items")
+	for pager.Next() {
+		var items []struct{ ID string }
+		assert.Nil(pager.Decode(&items), "Page should be decoded")
+		listed += len(items)
+	}
+	assert.Nil(pager.Err(), "Pager should not fail")
+	assert.Equal(3, listed, "Pager should list the whole oversized page")
+	cs.AssertNumberOfCalls(t, "Get", 1)
+}
+
+func TestPagerReportsStatusErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	cs := &MockConcertoService{}
+	cs.On("Get", PagePath("/items", 1, DefaultPageSize)).Return([]byte(`{"error":"forbidden"}`), 403, nil)
+
+	pager := NewPager(context.Background(), cs, "/items")
+	assert.False(pager.Next(), "Pager should stop on errors")
+	assert.NotNil(pager.Err(), "Pager should report the error")
+	assert.Contains(pager.Err().Error(), "403", "Error should contain http code 403")
+}
+
+func TestGetPageFollowsLinkHeader(t *testing.T) {
+	assert := assert.New(t)
+
+	hcs, server := newRetryTestService(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Header().Set("Link", `</items?cursor=abc>; rel="next", </items>; rel="first"`)
+			w.Write([]byte(`[{"id":"1"}]`))
+		case "abc":
+			w.Write([]byte(`[{"id":"2"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	_, next, status, err := hcs.GetPage("/items?page=1&per_page=100")
+	assert.Nil(err, "GetPage should succeed")
+	assert.Equal(200, status, "GetPage should return the response status")
+	assert.Equal("/items?cursor=abc", next, "GetPage should return the path of the next page")
+
+	var ids []string
+	pager := NewPager(context.Background(), hcs, "/items")
+	for pager.Next() {
+		var items []struct{ ID string }
+		assert.Nil(pager.Decode(&items), "Page should be decoded")
+		for _, item := range items {
+			ids = append(ids, item.ID)
+		}
+	}
+	assert.Nil(pager.Err(), "Pager should not fail")
+	assert.Equal([]string{"1", "2"}, ids, "Pager should follow the Link header")
+}
+
+func TestPagerStopsAtLastLinkedPage(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := 0
+	hcs, server := newRetryTestService(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Query().Get("page") == "1" {
+			w.Header().Set("Link", `</items?page=2&per_page=1>; rel="next"`)
+			w.Write([]byte(`[{"id":"1"}]`))
+			return
+		}
+		w.Write([]byte(`[{"id":"2"}]`))
+	})
+	defer server.Close()
+
+	listed := 0
+	pager := NewPager(WithPageOptions(context.Background(), PageOptions{Size: 1}), hcs, "/items")
+	for pager.Next() && calls < 10 {
+		listed++
+	}
+	assert.Nil(pager.Err(), "Pager should not fail")
+	assert.Equal(2, listed, "Pager should stop at the full page without link")
+	assert.Equal(2, calls)
+}
+
+func TestGetPageIgnoresForeignLinks(t *testing.T) {
+	assert := assert.New(t)
+
+	hcs, server := newRetryTestService(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", `<https://elsewhere.example.com/items?page=2>; rel="next"`)
+		w.Write([]byte(`[]`))
+	})
+	defer server.Close()
+
+	_, next, _, err := hcs.GetPage("/items")
+	assert.Nil(err, "GetPage should succeed")
+	assert.Equal("", next, "GetPage should not follow links outside of the API endpoint")
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultPollInterval is the default wait before polling again a resource
+	DefaultPollInterval = 2 * time.Second
+	// DefaultPollMaxInterval is the default maximum wait between polls
+	DefaultPollMaxInterval = 30 * time.Second
+)
+
+// ErrPollTimeout is returned by Poll when the condition is not met within its timeout
+var ErrPollTimeout = errors.New("timed out")
+
+// PollFunc checks the polled resource, returning whether polling is done. Any error stops polling
+type PollFunc func(ctx context.Context) (done bool, err error)
+
+// Poller calls a PollFunc until it is done, doubling the wait between calls from Interval up to MaxInterval. Zero
+// values mean defaults, but for Timeout, which is unbounded then
+type Poller struct {
+	Interval    time.Duration
+	MaxInterval time.Duration
+	Timeout     time.Duration
+}
+
+// FixedPoller returns a poller which waits the given interval between calls, without timeout
+func FixedPoller(interval time.Duration) Poller {
+	return Poller{Interval: interval, MaxInterval: interval}
+}
+
+// Wait returns the wait before the given poll (starting at 1)
+func (p Poller) Wait(poll int) time.Duration {
+	interval := p.Interval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	maxInterval := p.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultPollMaxInterval
+	}
+	if maxInterval < interval {
+		maxInterval = interval
+	}
+
+	if poll < 1 {
+		poll = 1
+	}
+	if poll < 32 {
+		if w := interval << uint(poll-1); w > 0 && w < maxInterval {
+			return w
+		}
+	}
+	return maxInterval
+}
+
+// Poll calls fn until it is done or fails. It returns ErrPollTimeout if the timeout expires, or the context error if
+// ctx is done earlier
+func (p Poller) Poll(ctx context.Context, fn PollFunc) error {
+	pollCtx := ctx
+	if p.Timeout > 0 {
+		var cancel context.CancelFunc
+		pollCtx, cancel = context.WithTimeout(ctx, p.Timeout)
+		defer cancel()
+	}
+
+	for poll := 1; ; poll++ {
+		done, err := fn(pollCtx)
+		if err == nil && done {
+			return nil
+		}
+		if err != nil && pollCtx.Err() == nil {
+			return err
+		}
+
+		wait := p.Wait(poll)
+		log.Debugf("Polling again in %s", wait)
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-pollCtx.Done():
+			timer.Stop()
+			if ctx.Err() == nil {
+				return fmt.Errorf("%w after %s", ErrPollTimeout, p.Timeout)
+			}
+			return ctx.Err()
+		}
+	}
+}
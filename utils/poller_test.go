@@ -0,0 +1,72 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollerWaitBacksOff(t *testing.T) {
+	assert := assert.New(t)
+
+	p := Poller{Interval: time.Second, MaxInterval: 5 * time.Second}
+	assert.Equal(time.Second, p.Wait(1), "First wait should be the interval")
+	assert.Equal(2*time.Second, p.Wait(2), "Waits should double")
+	assert.Equal(4*time.Second, p.Wait(3), "Waits should double")
+	assert.Equal(5*time.Second, p.Wait(4), "Waits should be capped")
+	assert.Equal(5*time.Second, p.Wait(100), "Waits should be capped")
+
+	assert.Equal(DefaultPollInterval, Poller{}.Wait(1), "Zero interval should mean default")
+	assert.Equal(3*time.Second, FixedPoller(3*time.Second).Wait(10), "Fixed pollers should not back off")
+}
+
+func TestPollUntilDone(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := 0
+	err := FixedPoller(time.Millisecond).Poll(context.Background(), func(ctx context.Context) (bool, error) {
+		calls++
+		return calls == 3, nil
+	})
+	assert.Nil(err, "Poll should succeed once done")
+	assert.Equal(3, calls, "Poll should stop once done")
+}
+
+func TestPollStopsOnError(t *testing.T) {
+	assert := assert.New(t)
+
+	failure := errors.New("failed")
+	calls := 0
+	err := FixedPoller(time.Millisecond).Poll(context.Background(), func(ctx context.Context) (bool, error) {
+		calls++
+		return false, failure
+	})
+	assert.Equal(failure, err, "Poll should return the error")
+	assert.Equal(1, calls, "Poll should stop on errors")
+}
+
+func TestPollTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	p := Poller{Interval: time.Millisecond, MaxInterval: time.Millisecond, Timeout: 20 * time.Millisecond}
+	err := p.Poll(context.Background(), func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	assert.True(errors.Is(err, ErrPollTimeout), "Poll should time out")
+}
+
+func TestPollCancelled(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := FixedPoller(time.Hour).Poll(ctx, func(ctx context.Context) (bool, error) {
+		cancel()
+		return false, nil
+	})
+	assert.Equal(context.Canceled, err, "Poll should return the context error when cancelled")
+}
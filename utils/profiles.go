@@ -0,0 +1,156 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+const profilesFileName = "profiles.xml"
+
+// Profiles stores the named contexts of the profiles file, placed next to the configuration file
+type Profiles struct {
+	XMLName  xml.Name  `xml:"profiles"`
+	Current  string    `xml:"current,attr,omitempty"`
+	Profiles []Profile `xml:"profile"`
+}
+
+// Profile stores a named context: the IMCO environment to talk to and how to authenticate against it
+type Profile struct {
+	Name        string `xml:"name,attr"                json:"name"                header:"NAME"`
+	Current     bool   `xml:"-"                        json:"current"             header:"CURRENT"`
+	APIEndpoint string `xml:"server,attr,omitempty"    json:"server,omitempty"    header:"ENDPOINT"`
+	Formatter   string `xml:"formatter,attr,omitempty" json:"formatter,omitempty" header:"FORMATTER"`
+	Certificate Cert   `xml:"ssl"                      json:"ssl"`
+}
+
+// LoadProfiles reads the profiles file. A missing file is taken as an empty one
+func LoadProfiles(file string) (*Profiles, error) {
+	log.Debug("LoadProfiles")
+
+	profiles := &Profiles{}
+	if !FileExists(file) {
+		log.Debugf("Profiles file %s does not exist", file)
+		return profiles, nil
+	}
+
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("profiles file %s couldn't be read", file)
+	}
+	if err = xml.Unmarshal(b, profiles); err != nil {
+		return nil, fmt.Errorf("profiles file %s does not have valid XML format", file)
+	}
+	return profiles, nil
+}
+
+// Save writes the profiles file, only readable by the current user as it points to credentials
+func (profiles *Profiles) Save(file string) error {
+	log.Debug("Save")
+
+	b, err := xml.MarshalIndent(profiles, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(file, append(b, '\n'), 0600); err != nil {
+		return err
+	}
+	return os.Chmod(file, 0600)
+}
+
+// Find returns the profile with the given name, or nil if there is none
+func (profiles *Profiles) Find(name string) *Profile {
+	for i := range profiles.Profiles {
+		if profiles.Profiles[i].Name == name {
+			return &profiles.Profiles[i]
+		}
+	}
+	return nil
+}
+
+// Set adds the given profile, or updates the one with the same name with its non-empty fields, returning the stored
+// profile
+func (profiles *Profiles) Set(update Profile) *Profile {
+	profile := profiles.Find(update.Name)
+	if profile == nil {
+		profiles.Profiles = append(profiles.Profiles, Profile{Name: update.Name})
+		profile = &profiles.Profiles[len(profiles.Profiles)-1]
+	}
+	if update.APIEndpoint != "" {
+		profile.APIEndpoint = update.APIEndpoint
+	}
+	if update.Formatter != "" {
+		profile.Formatter = update.Formatter
+	}
+	if update.Certificate.Cert != "" {
+		profile.Certificate.Cert = update.Certificate.Cert
+	}
+	if update.Certificate.Key != "" {
+		profile.Certificate.Key = update.Certificate.Key
+	}
+	if update.Certificate.Ca != "" {
+		profile.Certificate.Ca = update.Certificate.Ca
+	}
+	return profile
+}
+
+// Use sets the profile with the given name as the current one
+func (profiles *Profiles) Use(name string) error {
+	if profiles.Find(name) == nil {
+		return fmt.Errorf("profile %s not found", name)
+	}
+	profiles.Current = name
+	return nil
+}
+
+// applyProfile overwrites the configuration file contents with the profile selected by env/args, or else with the
+// current one of the profiles file. Profile fields left empty keep the configuration file values
+func (config *Config) applyProfile(c *cli.Context) error {
+	log.Debug("applyProfile")
+
+	profiles, err := LoadProfiles(config.ProfilesFile)
+	if err != nil {
+		return err
+	}
+
+	name := profiles.Current
+	if overwProfile := c.String("profile"); overwProfile != "" {
+		log.Debug("Profile taken from env/args")
+		name = overwProfile
+	}
+	if name == "" {
+		return nil
+	}
+
+	profile := profiles.Find(name)
+	if profile == nil {
+		return fmt.Errorf("profile %s not found in %s", name, config.ProfilesFile)
+	}
+	log.Debugf("Using profile %s", name)
+	config.Profile = name
+
+	if profile.APIEndpoint != "" {
+		config.APIEndpoint = profile.APIEndpoint
+	}
+	if profile.Certificate.Cert != "" {
+		config.Certificate.Cert = profile.Certificate.Cert
+	}
+	if profile.Certificate.Key != "" {
+		config.Certificate.Key = profile.Certificate.Key
+	}
+	if profile.Certificate.Ca != "" {
+		config.Certificate.Ca = profile.Certificate.Ca
+	}
+	config.Formatter = profile.Formatter
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func newProfilesTestContext(t *testing.T, args ...string) *cli.Context {
+	set := flag.NewFlagSet("test", flag.ContinueOnError)
+	set.String("profile", "", "")
+	set.String("concerto-endpoint", "", "")
+	set.String("client-cert", "", "")
+	set.String("client-key", "", "")
+	set.String("ca-cert", "", "")
+	set.Int("retry-max-attempts", 0, "")
+	set.Int("retry-wait-min", 0, "")
+	set.Int("retry-wait-max", 0, "")
+	assert.Nil(t, set.Parse(args), "Couldn't parse test flags")
+	return cli.NewContext(nil, set, nil)
+}
+
+func newProfilesTestFile(t *testing.T) string {
+	file := filepath.Join(t.TempDir(), profilesFileName)
+	profiles := &Profiles{
+		Current: "staging",
+		Profiles: []Profile{
+			{
+				Name:        "staging",
+				APIEndpoint: "https://clients.staging.example.com/v3",
+				Formatter:   "json",
+				Certificate: Cert{Cert: "/s/cert.crt", Key: "/s/key", Ca: "/s/ca.pem"},
+			},
+			{
+				Name:        "production",
+				APIEndpoint: "https://clients.example.com/v3",
+			},
+		},
+	}
+	assert.Nil(t, profiles.Save(file), "Couldn't save profiles")
+	return file
+}
+
+func TestLoadProfilesMissingFile(t *testing.T) {
+	assert := assert.New(t)
+
+	profiles, err := LoadProfiles(filepath.Join(t.TempDir(), profilesFileName))
+	assert.Nil(err, "A missing profiles file shouldn't be an error")
+	assert.Empty(profiles.Profiles, "A missing profiles file should have no profiles")
+}
+
+func TestLoadProfilesInvalidFile(t *testing.T) {
+	assert := assert.New(t)
+
+	file := filepath.Join(t.TempDir(), profilesFileName)
+	assert.Nil(os.WriteFile(file, []byte("<profiles"), 0600))
+
+	_, err := LoadProfiles(file)
+	assert.Error(err, "Should have gotten an error reading an invalid file")
+}
+
+func TestSaveProfiles(t *testing.T) {
+	assert := assert.New(t)
+
+	file := newProfilesTestFile(t)
+	fi, err := os.Stat(file)
+	assert.Nil(err, "Profiles file should exist")
+	assert.Equal(os.FileMode(0600), fi.Mode().Perm(), "Profiles file should only be readable by its owner")
+
+	profiles, err := LoadProfiles(file)
+	assert.Nil(err, "Couldn't load profiles")
+	assert.Equal("staging", profiles.Current)
+	assert.Len(profiles.Profiles, 2)
+	assert.Equal("/s/ca.pem", profiles.Find("staging").Certificate.Ca)
+	assert.Nil(profiles.Find("missing"))
+}
+
+func TestUseProfile(t *testing.T) {
+	assert := assert.New(t)
+
+	profiles, err := LoadProfiles(newProfilesTestFile(t))
+	assert.Nil(err, "Couldn't load profiles")
+
+	assert.Nil(profiles.Use("production"))
+	assert.Equal("production", profiles.Current)
+
+	assert.Error(profiles.Use("missing"), "Should have gotten an error using an unknown profile")
+	assert.Equal("production", profiles.Current, "Current profile shouldn't change on errors")
+}
+
+func TestSetProfile(t *testing.T) {
+	assert := assert.New(t)
+
+	profiles, err := LoadProfiles(newProfilesTestFile(t))
+	assert.Nil(err, "Couldn't load profiles")
+
+	profile := profiles.Set(Profile{Name: "staging", Certificate: Cert{Ca: "/n/ca.pem"}})
+	assert.Len(profiles.Profiles, 2, "Setting an existing profile shouldn't add one")
+	assert.Equal("https://clients.staging.example.com/v3", profile.APIEndpoint, "Empty fields should be kept")
+	assert.Equal(Cert{Cert: "/s/cert.crt", Key: "/s/key", Ca: "/n/ca.pem"}, profile.Certificate)
+
+	profile = profiles.Set(Profile{Name: "dev", APIEndpoint: "https://clients.dev.example.com/v3"})
+	assert.Len(profiles.Profiles, 3, "Setting an unknown profile should add it")
+	assert.Equal("https://clients.dev.example.com/v3", profiles.Find("dev").APIEndpoint)
+	assert.Equal("staging", profiles.Current, "Setting a profile shouldn't change the current one")
+}
+
+func TestApplyProfile(t *testing.T) {
+	assert := assert.New(t)
+
+	config := &Config{
+		ProfilesFile: newProfilesTestFile(t),
+		APIEndpoint:  "https://clients.concerto.io/v3",
+		Certificate:  Cert{Cert: "/c/cert.crt", Key: "/c/key", Ca: "/c/ca.pem"},
+	}
+	assert.Nil(config.applyProfile(newProfilesTestContext(t)))
+	assert.Equal("staging", config.Profile)
+	assert.Equal("https://clients.staging.example.com/v3", config.APIEndpoint)
+	assert.Equal(Cert{Cert: "/s/cert.crt", Key: "/s/key", Ca: "/s/ca.pem"}, config.Certificate)
+	assert.Equal("json", config.Formatter)
+
+	config.Certificate = Cert{Cert: "/c/cert.crt", Key: "/c/key", Ca: "/c/ca.pem"}
+	assert.Nil(config.applyProfile(newProfilesTestContext(t, "--profile", "production")))
+	assert.Equal("production", config.Profile)
+	assert.Equal("https://clients.example.com/v3", config.APIEndpoint)
+	assert.Equal(
+		Cert{Cert: "/c/cert.crt", Key: "/c/key", Ca: "/c/ca.pem"},
+		config.Certificate,
+		"Fields not set by the profile should keep their values",
+	)
+	assert.Equal("", config.Formatter)
+
+	assert.Error(
+		config.applyProfile(newProfilesTestContext(t, "--profile", "missing")),
+		"Should have gotten an error applying an unknown profile",
+	)
+}
+
+func TestReadConcertoConfigProfileOverwritten(t *testing.T) {
+	assert := assert.New(t)
+
+	config := &Config{
+		ConfFile:     filepath.Join(t.TempDir(), "client.xml"),
+		ProfilesFile: newProfilesTestFile(t),
+	}
+	assert.Nil(config.readConcertoConfig(newProfilesTestContext(t, "--ca-cert", "/e/ca.pem")))
+	assert.Equal("staging", config.Profile)
+	assert.Equal("/s/cert.crt", config.Certificate.Cert)
+	assert.Equal("/e/ca.pem", config.Certificate.Ca, "Env/args should take precedence over profiles")
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"context"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultThrottlingPause is the pause of the requests after a throttled (429) response without Retry-After
+	DefaultThrottlingPause = time.Second
+	// rateLimitFloor is the fraction of the configured rate below which throttling doesn't lower the current one
+	rateLimitFloor = 1.0 / 16
+	// rateLimitRecovery is the fraction of the configured rate the current one recovers on every successful request
+	rateLimitRecovery = 1.0 / 10
+)
+
+// RateLimitConfig stores the shaping of the API requests: at most RequestsPerSecond, in bursts up to Burst, and
+// MaxConcurrent in flight at once. Zero values mean no limit, and a burst as large as the rate
+type RateLimitConfig struct {
+	RequestsPerSecond float64 `xml:"requests_per_second,attr" json:"requests_per_second" header:"RATE_LIMIT"`
+	Burst             int     `xml:"burst,attr"               json:"burst"               header:"RATE_LIMIT_BURST"`
+	MaxConcurrent     int     `xml:"max_concurrent,attr"      json:"max_concurrent"      header:"MAX_CONCURRENT_REQUESTS"`
+}
+
+// RateLimiter shapes requests with a token bucket and bounds how many are in flight. It is safe for concurrent use,
+// so that the requests of every goroutine sharing it are shaped together. Throttled responses pause every request
+// and lower the rate, which recovers as requests succeed again
+type RateLimiter struct {
+	mu sync.Mutex
+
+	// limit is the configured rate, in requests per second, and rate the current one, lowered when throttled
+	limit  float64
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	pausedUntil time.Time
+
+	// slots holds a token for every request in flight, or is nil if they are unbounded
+	slots chan struct{}
+}
+
+// rateLimiters are the limiters shared by the services created with the same limits
+var rateLimiters = struct {
+	sync.Mutex
+	byConfig map[RateLimitConfig]*RateLimiter
+}{byConfig: make(map[RateLimitConfig]*RateLimiter)}
+
+// NewRateLimiter returns a rate limiter for the given limits
+func NewRateLimiter(rlc RateLimitConfig) *RateLimiter {
+	rl := &RateLimiter{last: time.Now()}
+	if rlc.RequestsPerSecond > 0 {
+		rl.limit = rlc.RequestsPerSecond
+		rl.rate = rl.limit
+		rl.burst = float64(rlc.Burst)
+		if rlc.Burst <= 0 {
+			rl.burst = math.Max(1, math.Ceil(rl.limit))
+		}
+		rl.tokens = rl.burst
+	}
+	if rlc.MaxConcurrent > 0 {
+		rl.slots = make(chan struct{}, rlc.MaxConcurrent)
+	}
+	return rl
+}
+
+// sharedRateLimiter returns the rate limiter of the services created with the given limits, creating it on first use
+func sharedRateLimiter(rlc RateLimitConfig) *RateLimiter {
+	rateLimiters.Lock()
+	defer rateLimiters.Unlock()
+	rl, ok := rateLimiters.byConfig[rlc]
+	if !ok {
+		rl = NewRateLimiter(rlc)
+		rateLimiters.byConfig[rlc] = rl
+	}
+	return rl
+}
+
+// Acquire waits until a request can be sent: when there is room for one more in flight, the bucket has a token and
+// requests are not paused. The returned function must be called once the request finishes, to make room for the next
+func (rl *RateLimiter) Acquire(ctx context.Context) (func(), error) {
+	if rl.slots != nil {
+		select {
+		case rl.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			if rl.slots != nil {
+				<-rl.slots
+			}
+		})
+	}
+
+	for {
+		wait := rl.reserve()
+		if wait <= 0 {
+			return release, nil
+		}
+		log.Debugf("Request delayed %v by rate limit", wait)
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			release()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// reserve takes a token from the bucket, returning zero, or returns how long to wait before trying again
+func (rl *RateLimiter) reserve() time.Duration {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(rl.pausedUntil) {
+		return rl.pausedUntil.Sub(now)
+	}
+	if rl.rate <= 0 {
+		return 0
+	}
+	rl.tokens = math.Min(rl.burst, rl.tokens+now.Sub(rl.last).Seconds()*rl.rate)
+	rl.last = now
+	if rl.tokens >= 1 {
+		rl.tokens--
+		return 0
+	}
+	return time.Duration((1 - rl.tokens) / rl.rate * float64(time.Second))
+}
+
+// Throttled pauses every request for the given wait, as requested by a throttled (429) response, and halves the rate
+func (rl *RateLimiter) Throttled(wait time.Duration) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if until := time.Now().Add(wait); until.After(rl.pausedUntil) {
+		rl.pausedUntil = until
+	}
+	if rl.limit > 0 {
+		rl.rate = math.Max(rl.rate/2, rl.limit*rateLimitFloor)
+		rl.tokens = 0
+	}
+	log.Infof("API requests throttled, pausing them for %v at %.2f requests per second", wait, rl.rate)
+}
+
+// Succeeded raises the rate lowered by throttling back towards the configured one
+func (rl *RateLimiter) Succeeded() {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if rl.rate < rl.limit {
+		rl.rate = math.Min(rl.limit, rl.rate+rl.limit*rateLimitRecovery)
+	}
+}
+
+// Rate returns the current rate, in requests per second, or zero if it is not limited
+func (rl *RateLimiter) Rate() float64 {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	return rl.rate
+}
+
+// releasingBody is a response body which makes room for the next request in flight once closed
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
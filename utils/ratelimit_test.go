@@ -0,0 +1,153 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterBurstThenRate(t *testing.T) {
+	assert := assert.New(t)
+
+	rl := NewRateLimiter(RateLimitConfig{RequestsPerSecond: 20, Burst: 2})
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		release, err := rl.Acquire(context.Background())
+		assert.Nil(err, "Acquire should succeed")
+		release()
+	}
+	elapsed := time.Since(start)
+	assert.True(elapsed >= 90*time.Millisecond, "Requests beyond the burst should wait for tokens, took %v", elapsed)
+	assert.True(elapsed < time.Second, "Requests should not wait longer than the rate requires, took %v", elapsed)
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	assert := assert.New(t)
+
+	rl := NewRateLimiter(RateLimitConfig{})
+	start := time.Now()
+	for i := 0; i < 100; i++ {
+		release, err := rl.Acquire(context.Background())
+		assert.Nil(err)
+		release()
+	}
+	assert.True(time.Since(start) < 100*time.Millisecond, "Requests should not wait without limits")
+	assert.Zero(rl.Rate())
+}
+
+func TestRateLimiterMaxConcurrent(t *testing.T) {
+	assert := assert.New(t)
+
+	rl := NewRateLimiter(RateLimitConfig{MaxConcurrent: 1})
+	release, err := rl.Acquire(context.Background())
+	assert.Nil(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = rl.Acquire(ctx)
+	assert.Equal(context.DeadlineExceeded, err, "Requests beyond the maximum in flight should wait")
+
+	release()
+	release()
+	next, err := rl.Acquire(context.Background())
+	assert.Nil(err, "Released requests should make room for the next")
+	next()
+}
+
+func TestRateLimiterSharedAcrossGoroutines(t *testing.T) {
+	assert := assert.New(t)
+
+	rl := NewRateLimiter(RateLimitConfig{MaxConcurrent: 2})
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := rl.Acquire(context.Background())
+			assert.Nil(err)
+			mu.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+			time.Sleep(5 * time.Millisecond)
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			release()
+		}()
+	}
+	wg.Wait()
+	assert.Equal(2, maxInFlight, "No more requests than the maximum should be in flight")
+}
+
+func TestRateLimiterThrottled(t *testing.T) {
+	assert := assert.New(t)
+
+	rl := NewRateLimiter(RateLimitConfig{RequestsPerSecond: 100, Burst: 100})
+	rl.Throttled(50 * time.Millisecond)
+	assert.Equal(50.0, rl.Rate(), "Throttling should halve the rate")
+
+	start := time.Now()
+	release, err := rl.Acquire(context.Background())
+	assert.Nil(err)
+	release()
+	assert.True(time.Since(start) >= 40*time.Millisecond, "Throttling should pause requests")
+
+	for i := 0; i < 10; i++ {
+		rl.Throttled(0)
+	}
+	assert.Equal(100.0/16, rl.Rate(), "Throttling should not lower the rate below the floor")
+
+	for i := 0; i < 20; i++ {
+		rl.Succeeded()
+	}
+	assert.Equal(100.0, rl.Rate(), "The rate should recover up to the configured one")
+}
+
+func TestSharedRateLimiter(t *testing.T) {
+	assert := assert.New(t)
+
+	rlc := RateLimitConfig{RequestsPerSecond: 7, MaxConcurrent: 3}
+	assert.Same(sharedRateLimiter(rlc), sharedRateLimiter(rlc), "Services with the same limits should share them")
+	assert.NotSame(sharedRateLimiter(rlc), sharedRateLimiter(RateLimitConfig{RequestsPerSecond: 8}))
+}
+
+func TestGetFeedsRateLimiter(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := 0
+	hcs, server := newRetryTestService(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"id":"1"}`))
+	})
+	defer server.Close()
+	hcs.limiter = NewRateLimiter(RateLimitConfig{RequestsPerSecond: 1000, MaxConcurrent: 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, status, err := hcs.GetContext(ctx, "/cloud/servers/1")
+	assert.Nil(err, "Get should succeed after being throttled")
+	assert.Equal(200, status)
+	assert.Equal(2, calls)
+	assert.Equal(500.0+100.0, hcs.limiter.Rate(), "Throttling should lower the rate, and success raise it")
+
+	for i := 0; i < 3; i++ {
+		_, _, err = hcs.GetContext(ctx, "/cloud/servers/1")
+		assert.Nil(err, "Finished requests should make room for the next")
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultRetryMaxAttempts is the default maximum number of attempts performed for a retryable API request
+	DefaultRetryMaxAttempts = 5
+	// DefaultRetryWaitMin is the default wait -milliseconds- before the first retry
+	DefaultRetryWaitMin = 1000
+	// DefaultRetryWaitMax is the default maximum wait -milliseconds- between retries
+	DefaultRetryWaitMax = 30000
+)
+
+// jitter is the random source used to spread retries, shared by all services
+var jitter = struct {
+	sync.Mutex
+	*rand.Rand
+}{Rand: rand.New(rand.NewSource(time.Now().UnixNano()))}
+
+// RetryConfig stores the retry policy applied to API requests. Zero values mean defaults
+type RetryConfig struct {
+	MaxAttempts int `xml:"max_attempts,attr" json:"max_attempts" header:"RETRY_MAX_ATTEMPTS"`
+	WaitMin     int `xml:"wait_min,attr"     json:"wait_min"     header:"RETRY_WAIT_MIN"`
+	WaitMax     int `xml:"wait_max,attr"     json:"wait_max"     header:"RETRY_WAIT_MAX"`
+}
+
+// Attempts returns the maximum number of attempts for a retryable request
+func (rc RetryConfig) Attempts() int {
+	if rc.MaxAttempts <= 0 {
+		return DefaultRetryMaxAttempts
+	}
+	return rc.MaxAttempts
+}
+
+// Backoff returns the wait before the given retry (starting at 1). It grows exponentially from WaitMin up to WaitMax,
+// randomized over the upper half of the interval so that concurrent clients do not retry in lockstep
+func (rc RetryConfig) Backoff(retry int) time.Duration {
+	waitMin := time.Duration(rc.WaitMin) * time.Millisecond
+	if rc.WaitMin <= 0 {
+		waitMin = DefaultRetryWaitMin * time.Millisecond
+	}
+	waitMax := time.Duration(rc.WaitMax) * time.Millisecond
+	if rc.WaitMax <= 0 {
+		waitMax = DefaultRetryWaitMax * time.Millisecond
+	}
+	if waitMax < waitMin {
+		waitMax = waitMin
+	}
+
+	wait := waitMax
+	if retry < 1 {
+		retry = 1
+	}
+	if retry < 32 {
+		if w := waitMin << uint(retry-1); w > 0 && w < waitMax {
+			wait = w
+		}
+	}
+
+	half := wait / 2
+	jitter.Lock()
+	defer jitter.Unlock()
+	return half + time.Duration(jitter.Int63n(int64(wait-half)+1))
+}
+
+// isRetryableStatus returns whether the response status code reports a transient condition
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || (status >= 500 && status != http.StatusNotImplemented)
+}
+
+// isRetryableError returns whether the transport error may disappear by repeating the request. Certificate errors
+// will not, so they are reported straight away
+func isRetryableError(err error) bool {
+	var unknownAuthority x509.UnknownAuthorityError
+	var invalidCertificate x509.CertificateInvalidError
+	var hostname x509.HostnameError
+	return !errors.As(err, &unknownAuthority) && !errors.As(err, &invalidCertificate) && !errors.As(err, &hostname)
+}
+
+// retryAfter returns the wait requested by the server through the Retry-After header, given either in seconds or as
+// an HTTP date
+func retryAfter(response *http.Response) (time.Duration, bool) {
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// rateFeedback tells the rate limiter about throttled (429) responses, which pause requests as long as Retry-After
+// asks, and about successful ones
+func (hcs *HTTPConcertoservice) rateFeedback(response *http.Response) {
+	if hcs.limiter == nil {
+		return
+	}
+	if response.StatusCode == http.StatusTooManyRequests {
+		wait, ok := retryAfter(response)
+		if !ok {
+			wait = DefaultThrottlingPause
+		}
+		hcs.limiter.Throttled(wait)
+	} else if response.StatusCode < 500 {
+		hcs.limiter.Succeeded()
+	}
+}
+
+// doRequest sends the request built by newRequest through the client, once the rate limiter, if any, allows it. When
+// retryable, failed attempts due to network errors, throttling (429) or server errors (5xx) are repeated with
+// exponential backoff, honoring Retry-After when present. The response of the last attempt is returned in any case.
+// Both requests and waits are aborted as soon as ctx is done
+func (hcs *HTTPConcertoservice) doRequest(
+	ctx context.Context,
+	client *http.Client,
+	newRequest func() (*http.Request, error),
+	retryable bool,
+) (*http.Response, error) {
+	attempts := 1
+	if retryable {
+		attempts = hcs.config.Retry.Attempts()
+	}
+
+	for attempt := 1; ; attempt++ {
+		release := func() {}
+		if hcs.limiter != nil {
+			var err error
+			if release, err = hcs.limiter.Acquire(ctx); err != nil {
+				return nil, err
+			}
+		}
+		request, err := newRequest()
+		if err != nil {
+			release()
+			return nil, err
+		}
+
+		response, err := client.Do(request.WithContext(ctx))
+		if err != nil {
+			release()
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+		} else {
+			response.Body = &releasingBody{ReadCloser: response.Body, release: release}
+			hcs.rateFeedback(response)
+		}
+		if attempt >= attempts {
+			return response, err
+		}
+
+		wait := hcs.config.Retry.Backoff(attempt)
+		if err != nil {
+			if !isRetryableError(err) {
+				return nil, err
+			}
+			log.Debugf("%s request to %s failed: %v", request.Method, request.URL, err)
+		} else {
+			if !isRetryableStatus(response.StatusCode) {
+				return response, nil
+			}
+			if serverWait, ok := retryAfter(response); ok {
+				wait = serverWait
+			}
+			log.Debugf("%s request to %s failed with status %s", request.Method, request.URL, response.Status)
+			io.Copy(ioutil.Discard, response.Body)
+			response.Body.Close()
+		}
+
+		log.Infof(
+			"Retrying %s request to %s in %v (attempt %d of %d)",
+			request.Method, request.URL, wait, attempt+1, attempts,
+		)
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRetryTestService(handler http.HandlerFunc) (*HTTPConcertoservice, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	hcs := &HTTPConcertoservice{
+		config: &Config{
+			APIEndpoint: server.URL,
+			Retry:       RetryConfig{MaxAttempts: 3, WaitMin: 1, WaitMax: 2},
+		},
+		client: server.Client(),
+	}
+	return hcs, server
+}
+
+func TestGetRetriesServerErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := 0
+	hcs, server := newRetryTestService(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"id":"1"}`))
+	})
+	defer server.Close()
+
+	body, status, err := hcs.Get("/cloud/servers/1")
+	assert.Nil(err, "Get should succeed after retrying")
+	assert.Equal(200, status, "Get should return the status of the last attempt")
+	assert.Equal(`{"id":"1"}`, string(body), "Get should return the body of the last attempt")
+	assert.Equal(3, calls, "Get should have been attempted 3 times")
+}
+
+func TestGetGivesUpAfterMaxAttempts(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := 0
+	hcs, server := newRetryTestService(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	defer server.Close()
+
+	_, status, err := hcs.Get("/cloud/servers")
+	assert.True(hasStatus(err, http.StatusBadGateway), "Get should fail with the API error of the last attempt")
+	assert.Equal(http.StatusBadGateway, status, "Get should return the status of the last attempt")
+	assert.Equal(3, calls, "Get should have been attempted 3 times")
+}
+
+func TestGetDoesNotRetryClientErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := 0
+	hcs, server := newRetryTestService(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer server.Close()
+
+	_, status, err := hcs.Get("/cloud/servers/1")
+	assert.True(IsNotFound(err), "Get should fail with the API error of the response")
+	assert.Equal(http.StatusNotFound, status, "Get should return 404")
+	assert.Equal(1, calls, "Get should not be retried on client errors")
+}
+
+func TestPostRetriesOnlyWhenIdempotent(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := 0
+	hcs, server := newRetryTestService(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	defer server.Close()
+
+	payload := map[string]interface{}{"name": "test"}
+	_, status, err := hcs.Post("/cloud/servers", &payload)
+	assert.True(hasStatus(err, http.StatusTooManyRequests), "Post should fail with the API error of the response")
+	assert.Equal(http.StatusTooManyRequests, status, "Post should return 429")
+	assert.Equal(1, calls, "Post should not be retried")
+
+	calls = 0
+	_, _, err = hcs.PostIdempotent("/cloud/servers", &payload)
+	assert.True(hasStatus(err, http.StatusTooManyRequests), "PostIdempotent should fail after its last attempt")
+	assert.Equal(3, calls, "PostIdempotent should have been attempted 3 times")
+}
+
+func TestRetryAfter(t *testing.T) {
+	assert := assert.New(t)
+
+	response := &http.Response{Header: http.Header{}}
+	_, ok := retryAfter(response)
+	assert.False(ok, "Missing header should not be honored")
+
+	response.Header.Set("Retry-After", "7")
+	wait, ok := retryAfter(response)
+	assert.True(ok, "Seconds should be honored")
+	assert.Equal(7*time.Second, wait, "Wait should match header seconds")
+
+	response.Header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	wait, ok = retryAfter(response)
+	assert.True(ok, "Dates should be honored")
+	assert.Equal(time.Duration(0), wait, "Past dates should not wait")
+}
+
+func TestBackoff(t *testing.T) {
+	assert := assert.New(t)
+
+	rc := RetryConfig{WaitMin: 100, WaitMax: 1000}
+	for retry := 1; retry <= 40; retry++ {
+		wait := rc.Backoff(retry)
+		assert.True(wait >= 50*time.Millisecond, "Backoff should never be below half the minimum wait")
+		assert.True(wait <= time.Second, "Backoff should never exceed the maximum wait")
+	}
+	assert.True(rc.Backoff(4) >= 400*time.Millisecond, "Backoff should grow exponentially")
+}
+
+func TestGetContextAbortsInFlightRequest(t *testing.T) {
+	assert := assert.New(t)
+
+	release := make(chan struct{})
+	hcs, server := newRetryTestService(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	})
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	startedAt := time.Now()
+	_, _, err := hcs.GetContext(ctx, "/cloud/servers")
+	assert.Equal(context.DeadlineExceeded, err, "GetContext should return the context error")
+	assert.True(time.Since(startedAt) < 5*time.Second, "GetContext should not wait for the response")
+}
+
+func TestGetContextAbortsRetryWait(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := 0
+	hcs, server := newRetryTestService(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, _, err := hcs.GetContext(ctx, "/cloud/servers")
+	assert.Equal(context.DeadlineExceeded, err, "GetContext should return the context error")
+	assert.Equal(1, calls, "GetContext should not retry once the context is done")
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+//go:build solaris
+// +build solaris
+
+package utils
+
+import (
+	"io"
+	"os"
+	"syscall"
+)
+
+// lockFile creates a file, if missing, and locks it, failing if it is already locked. The lock is released by closing
+// the file. Being a fcntl lock, it does not exclude other routines of the same process
+func lockFile(file string) (*os.File, error) {
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	lock := &syscall.Flock_t{Type: syscall.F_WRLCK, Whence: io.SeekStart}
+	if err = syscall.FcntlFlock(f.Fd(), syscall.F_SETLK, lock); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
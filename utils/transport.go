@@ -0,0 +1,306 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultFileTransferTimeout is the default timeout -seconds- of file downloads and uploads
+	DefaultFileTransferTimeout = 3600
+	// DefaultKeepAlive is the default period -seconds- of the keep-alive probes of API connections
+	DefaultKeepAlive = 30
+	// DefaultIdleConnTimeout is the default time -seconds- idle API connections are kept open for reuse
+	DefaultIdleConnTimeout = 90
+	// DefaultMaxIdleConns is the default maximum number of idle API connections kept open for reuse
+	DefaultMaxIdleConns = 100
+
+	dialTimeout         = 30 * time.Second
+	tlsHandshakeTimeout = 10 * time.Second
+)
+
+// TransportConfig stores the connection settings of API requests: the proxy they go through, unless given by the
+// HTTPS_PROXY and NO_PROXY environment variables, their timeouts by operation class and the reuse of connections. Zero
+// values mean defaults, and a negative keep alive disables connection reuse
+type TransportConfig struct {
+	Proxy               string `xml:"proxy,attr"             json:"proxy"             header:"PROXY"`
+	NoProxy             string `xml:"no_proxy,attr"          json:"no_proxy"          header:"NO_PROXY"`
+	APITimeout          int    `xml:"api_timeout,attr"       json:"api_timeout"       header:"API_TIMEOUT"`
+	FileTimeout         int    `xml:"file_timeout,attr"      json:"file_timeout"      header:"FILE_TIMEOUT"`
+	KeepAlive           int    `xml:"keep_alive,attr"        json:"keep_alive"        header:"KEEP_ALIVE"`
+	IdleConnTimeout     int    `xml:"idle_timeout,attr"      json:"idle_timeout"      header:"IDLE_TIMEOUT"`
+	MaxIdleConns        int    `xml:"max_idle,attr"          json:"max_idle"          header:"MAX_IDLE"`
+	MaxIdleConnsPerHost int    `xml:"max_idle_per_host,attr" json:"max_idle_per_host" header:"MAX_IDLE_PER_HOST"`
+}
+
+// seconds returns the duration of the given seconds, or of the default ones if not positive
+func seconds(value int, defaultValue int) time.Duration {
+	if value <= 0 {
+		value = defaultValue
+	}
+	return time.Duration(value) * time.Second
+}
+
+// APIRequestTimeout returns the timeout of API requests
+func (tc TransportConfig) APIRequestTimeout() time.Duration {
+	return seconds(tc.APITimeout, HttpTimeOut)
+}
+
+// FileTransferTimeout returns the timeout of file downloads and uploads
+func (tc TransportConfig) FileTransferTimeout() time.Duration {
+	return seconds(tc.FileTimeout, DefaultFileTransferTimeout)
+}
+
+// ProxyFunc returns the function choosing the proxy of every request: the configured one or else the one given by the
+// environment, but for the hosts in NoProxy
+func (tc TransportConfig) ProxyFunc() (func(*http.Request) (*url.URL, error), error) {
+	if tc.Proxy == "" && tc.NoProxy == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+	if tc.Proxy == "" {
+		return func(request *http.Request) (*url.URL, error) {
+			if matchesNoProxy(request.URL, tc.NoProxy) {
+				return nil, nil
+			}
+			return http.ProxyFromEnvironment(request)
+		}, nil
+	}
+	proxyURL, err := url.Parse(tc.Proxy)
+	if err != nil || proxyURL.Host == "" {
+		return nil, fmt.Errorf("invalid proxy %q: it should be an URL such as http://proxy.example.com:3128", tc.Proxy)
+	}
+	noProxy := tc.NoProxy
+	if noProxy == "" {
+		noProxy = os.Getenv("NO_PROXY")
+	}
+	if noProxy == "" {
+		noProxy = os.Getenv("no_proxy")
+	}
+	return func(request *http.Request) (*url.URL, error) {
+		if matchesNoProxy(request.URL, noProxy) {
+			return nil, nil
+		}
+		return proxyURL, nil
+	}, nil
+}
+
+// matchesNoProxy tells whether the URL is excluded from going through the proxy by the comma separated list of
+// hosts, domains -matching their subdomains as well-, IP addresses and CIDR ranges, optionally with port, or *
+func matchesNoProxy(u *url.URL, noProxy string) bool {
+	host, port := u.Hostname(), u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	ip := net.ParseIP(host)
+	for _, entry := range strings.Split(noProxy, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if entry == "*" {
+			return true
+		}
+		if _, cidr, err := net.ParseCIDR(entry); err == nil {
+			if ip != nil && cidr.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		if entryHost, entryPort, err := net.SplitHostPort(entry); err == nil {
+			if entryPort != port {
+				continue
+			}
+			entry = entryHost
+		}
+		if entryIP := net.ParseIP(entry); entryIP != nil {
+			if ip != nil && entryIP.Equal(ip) {
+				return true
+			}
+			continue
+		}
+		entry = strings.TrimPrefix(entry, ".")
+		host = strings.ToLower(host)
+		if host == entry || strings.HasSuffix(host, "."+entry) {
+			return true
+		}
+	}
+	return false
+}
+
+// newTransport returns the transport of API requests, with the given TLS configuration
+func newTransport(tc TransportConfig, tlsConfig *tls.Config) (*http.Transport, error) {
+	proxy, err := tc.ProxyFunc()
+	if err != nil {
+		return nil, err
+	}
+	dialer := &net.Dialer{Timeout: dialTimeout, KeepAlive: seconds(tc.KeepAlive, DefaultKeepAlive)}
+	maxIdleConns := tc.MaxIdleConns
+	if maxIdleConns <= 0 {
+		maxIdleConns = DefaultMaxIdleConns
+	}
+	return &http.Transport{
+		Proxy:               proxy,
+		DialContext:         dialer.DialContext,
+		TLSClientConfig:     tlsConfig,
+		TLSHandshakeTimeout: tlsHandshakeTimeout,
+		DisableKeepAlives:   tc.KeepAlive < 0,
+		MaxIdleConns:        maxIdleConns,
+		MaxIdleConnsPerHost: tc.MaxIdleConnsPerHost,
+		IdleConnTimeout:     seconds(tc.IdleConnTimeout, DefaultIdleConnTimeout),
+	}, nil
+}
+
+// setClients sets the clients of API requests and file transfers of the service, which share their connections
+func (hcs *HTTPConcertoservice) setClients(tlsConfig *tls.Config) error {
+	transport, err := newTransport(hcs.config.Transport, tlsConfig)
+	if err != nil {
+		return err
+	}
+	hcs.useTransport(transport)
+	return nil
+}
+
+// setPinnedClients is like setClients for the modes authenticated by token instead of client certificate. Requests
+// to the API host are verified as told by pinnedTLSConfig. When pinned, those to other hosts, such as downloads of
+// attachments, are verified as usual instead
+func (hcs *HTTPConcertoservice) setPinnedClients() error {
+	tlsConfig, err := pinnedTLSConfig(hcs.config.Certificate)
+	if err != nil {
+		return err
+	}
+	pinned, err := newTransport(hcs.config.Transport, tlsConfig)
+	if err != nil {
+		return err
+	}
+	if tlsConfig.InsecureSkipVerify && tlsConfig.VerifyConnection == nil {
+		hcs.useTransport(pinned)
+		return nil
+	}
+
+	apiURL, err := url.Parse(hcs.config.APIEndpoint)
+	if err != nil {
+		return err
+	}
+	other, err := newTransport(hcs.config.Transport, &tls.Config{})
+	if err != nil {
+		return err
+	}
+	hcs.useTransport(&apiHostTransport{apiAddr: canonicalAddr(apiURL), api: pinned, other: other})
+	return nil
+}
+
+// apiHostTransport sends requests to the API host, and those to any other host, through different transports
+type apiHostTransport struct {
+	apiAddr string
+	api     http.RoundTripper
+	other   http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *apiHostTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if canonicalAddr(request.URL) == t.apiAddr {
+		return t.api.RoundTrip(request)
+	}
+	return t.other.RoundTrip(request)
+}
+
+// canonicalAddr returns the lower case host and port of the URL, the default one of its scheme if not given
+func canonicalAddr(u *url.URL) string {
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	return net.JoinHostPort(strings.ToLower(u.Hostname()), port)
+}
+
+// useTransport sets the clients of API requests and file transfers of the service on the transport. Requests are
+// recorded as fixtures if requested
+func (hcs *HTTPConcertoservice) useTransport(transport http.RoundTripper) {
+	if hcs.config.RecordFixtures != "" {
+		transport = NewFixtureRecorder(hcs.config.RecordFixtures, hcs.config.APIEndpoint, transport)
+	}
+	hcs.client = &http.Client{Transport: transport, Timeout: hcs.config.Transport.APIRequestTimeout()}
+	hcs.fileClient = &http.Client{Transport: transport, Timeout: hcs.config.Transport.FileTransferTimeout()}
+}
+
+// fileTransferClient returns the client of file transfers
+func (hcs *HTTPConcertoservice) fileTransferClient() *http.Client {
+	if hcs.fileClient == nil {
+		return hcs.client
+	}
+	return hcs.fileClient
+}
+
+// pinnedTLSConfig returns the TLS configuration of the modes authenticated by token instead of client certificate.
+// The platform certificate is verified against the pinned CA and fingerprint, if any. Otherwise, as these modes
+// are used before the host has the platform CA, it is not verified
+func pinnedTLSConfig(cert Cert) (*tls.Config, error) {
+	if cert.PinnedCa == "" && cert.Fingerprint == "" {
+		return &tls.Config{InsecureSkipVerify: true}, nil
+	}
+
+	tlsConfig := &tls.Config{}
+	if cert.PinnedCa != "" {
+		caCert, err := ioutil.ReadFile(cert.PinnedCa)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read pinned CA cert: %v", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("cannot read pinned CA cert: no certificate found in %s", cert.PinnedCa)
+		}
+	}
+	if cert.Fingerprint != "" {
+		fingerprint, err := ParseFingerprint(cert.Fingerprint)
+		if err != nil {
+			return nil, err
+		}
+		// the certificate is verified against the CA, if pinned, and its fingerprint, which suffices otherwise
+		tlsConfig.InsecureSkipVerify = cert.PinnedCa == ""
+		tlsConfig.VerifyConnection = func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return fmt.Errorf("server didn't present any certificate")
+			}
+			if actual := sha256.Sum256(cs.PeerCertificates[0].Raw); !bytes.Equal(actual[:], fingerprint) {
+				return fmt.Errorf(
+					"server certificate fingerprint %s doesn't match the pinned one %s",
+					hex.EncodeToString(actual[:]),
+					hex.EncodeToString(fingerprint),
+				)
+			}
+			return nil
+		}
+	}
+	return tlsConfig, nil
+}
+
+// ParseFingerprint parses a SHA-256 certificate fingerprint, given in hexadecimal, optionally separated by colons
+func ParseFingerprint(fingerprint string) ([]byte, error) {
+	decoded, err := hex.DecodeString(strings.ReplaceAll(strings.TrimSpace(fingerprint), ":", ""))
+	if err != nil || len(decoded) != sha256.Size {
+		return nil, fmt.Errorf(
+			"invalid fingerprint %q: it should be the SHA-256 of the certificate, in hexadecimal",
+			fingerprint,
+		)
+	}
+	return decoded, nil
+}
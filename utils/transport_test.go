@@ -0,0 +1,207 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+package utils
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchesNoProxy(t *testing.T) {
+	assert := assert.New(t)
+
+	noProxy := "localhost, .internal.example.com,10.0.0.0/8,192.168.1.1,api.example.com:8443"
+	cases := map[string]bool{
+		"https://localhost/v2":                true,
+		"https://internal.example.com/v2":     true,
+		"https://api.internal.example.com/v2": true,
+		"https://10.1.2.3:8443/v2":            true,
+		"https://192.168.1.1/v2":              true,
+		"https://192.168.1.2/v2":              false,
+		"https://api.example.com:8443/v2":     true,
+		"https://api.example.com/v2":          false,
+		"https://notinternal.example.com/v2":  false,
+		"https://clients.example.com/v2":      false,
+	}
+	for rawURL, expected := range cases {
+		u, err := url.Parse(rawURL)
+		assert.Nil(err)
+		assert.Equal(expected, matchesNoProxy(u, noProxy), "Unexpected proxy exclusion of %s", rawURL)
+	}
+
+	u, _ := url.Parse("https://anything.example.com")
+	assert.True(matchesNoProxy(u, "*"), "Every host should be excluded by *")
+	assert.False(matchesNoProxy(u, ""), "No host should be excluded by an empty list")
+}
+
+func TestProxyFunc(t *testing.T) {
+	assert := assert.New(t)
+
+	os.Setenv("NO_PROXY", "")
+	os.Setenv("no_proxy", "")
+	proxy, err := TransportConfig{Proxy: "http://proxy.example.com:3128", NoProxy: "internal.example.com"}.ProxyFunc()
+	assert.Nil(err)
+
+	request, _ := http.NewRequest("GET", "https://clients.example.com/v2/cloud/servers", nil)
+	proxyURL, err := proxy(request)
+	assert.Nil(err)
+	assert.Equal("http://proxy.example.com:3128", proxyURL.String(), "Requests should go through the configured proxy")
+
+	request, _ = http.NewRequest("GET", "https://api.internal.example.com/v2/cloud/servers", nil)
+	proxyURL, err = proxy(request)
+	assert.Nil(err)
+	assert.Nil(proxyURL, "Requests to excluded hosts should not go through the proxy")
+
+	os.Setenv("HTTPS_PROXY", "http://env-proxy.example.com:3128")
+	defer os.Unsetenv("HTTPS_PROXY")
+	proxy, err = TransportConfig{NoProxy: "internal.example.com"}.ProxyFunc()
+	assert.Nil(err)
+	proxyURL, err = proxy(request)
+	assert.Nil(err)
+	assert.Nil(proxyURL, "Excluded hosts should not go through the proxy of the environment")
+
+	_, err = TransportConfig{Proxy: "proxy.example.com:3128"}.ProxyFunc()
+	assert.NotNil(err, "Proxies should be given as URLs")
+}
+
+func TestTransportTimeouts(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(HttpTimeOut*time.Second, TransportConfig{}.APIRequestTimeout())
+	assert.Equal(DefaultFileTransferTimeout*time.Second, TransportConfig{}.FileTransferTimeout())
+
+	hcs := &HTTPConcertoservice{config: &Config{Transport: TransportConfig{APITimeout: 5, FileTimeout: 600}}}
+	assert.Nil(hcs.setClients(nil))
+	assert.Equal(5*time.Second, hcs.client.Timeout, "API requests should have their own timeout")
+	assert.Equal(600*time.Second, hcs.fileTransferClient().Timeout, "File transfers should have their own timeout")
+	assert.Same(hcs.client.Transport, hcs.fileClient.Transport, "Clients should share their connections")
+}
+
+func TestFileTransfersTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		w.Write([]byte("content"))
+	}))
+	defer server.Close()
+
+	hcs := &HTTPConcertoservice{
+		config: &Config{
+			APIEndpoint: server.URL,
+			Retry:       RetryConfig{MaxAttempts: 1},
+			Transport:   TransportConfig{APITimeout: 1},
+		},
+	}
+	assert.Nil(hcs.setClients(nil))
+	hcs.client.Timeout = 10 * time.Millisecond
+
+	_, _, err := hcs.Get("/cloud/servers")
+	assert.NotNil(err, "API requests should time out")
+
+	filePath := filepath.Join(t.TempDir(), "download")
+	_, status, err := hcs.GetFile(server.URL, filePath, false)
+	assert.Nil(err, "File transfers should not time out as API requests")
+	assert.Equal(200, status)
+}
+
+func TestPinnedTLSConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"1"}`))
+	}))
+	defer server.Close()
+
+	sum := sha256.Sum256(server.Certificate().Raw)
+	fingerprint := strings.ToUpper(hex.EncodeToString(sum[:]))
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	err := ioutil.WriteFile(
+		caFile,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+		0600,
+	)
+	assert.Nil(err)
+
+	get := func(cert Cert) error {
+		hcs := &HTTPConcertoservice{
+			config: &Config{APIEndpoint: server.URL, Certificate: cert, Retry: RetryConfig{MaxAttempts: 1}},
+		}
+		if err := hcs.setPinnedClients(); err != nil {
+			return err
+		}
+		_, _, err := hcs.Get("/cloud/servers/1")
+		return err
+	}
+
+	assert.Nil(get(Cert{}), "Certificates should not be verified if not pinned")
+	assert.Nil(get(Cert{Fingerprint: fingerprint}), "Certificates matching the pinned fingerprint should be accepted")
+	assert.Nil(get(Cert{PinnedCa: caFile}), "Certificates issued by the pinned CA should be accepted")
+	assert.Nil(get(Cert{PinnedCa: caFile, Fingerprint: fingerprint}))
+
+	err = get(Cert{Fingerprint: strings.Repeat("ab", sha256.Size)})
+	assert.NotNil(err, "Certificates not matching the pinned fingerprint should be rejected")
+	assert.Contains(err.Error(), "doesn't match the pinned one")
+
+	// every test server has the same certificate, so another CA is generated
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Other CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	otherCa, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(err)
+	otherCaFile := filepath.Join(t.TempDir(), "other.pem")
+	err = ioutil.WriteFile(otherCaFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: otherCa}), 0600)
+	assert.Nil(err)
+	assert.NotNil(get(Cert{PinnedCa: otherCaFile}), "Certificates not issued by the pinned CA should be rejected")
+
+	// hosts other than the API one, such as those of attachments, are verified as usual
+	hcs := &HTTPConcertoservice{config: &Config{
+		APIEndpoint: "https://clients.example.com/v3",
+		Certificate: Cert{Fingerprint: strings.Repeat("ab", sha256.Size)},
+	}}
+	assert.Nil(hcs.setPinnedClients())
+	_, err = hcs.fileTransferClient().Get(server.URL)
+	assert.NotNil(err, "Certificates of other hosts should be verified")
+	assert.NotContains(err.Error(), "pinned", "Certificates of other hosts should not be pinned")
+
+	assert.NotNil(get(Cert{Fingerprint: "not-a-fingerprint"}), "Invalid fingerprints should be reported")
+	assert.NotNil(get(Cert{PinnedCa: filepath.Join(t.TempDir(), "missing.pem")}), "Missing CAs should be reported")
+}
+
+func TestParseFingerprint(t *testing.T) {
+	assert := assert.New(t)
+
+	colons := strings.TrimSuffix(strings.Repeat("AB:", sha256.Size), ":")
+	fingerprint, err := ParseFingerprint(colons)
+	assert.Nil(err, "Fingerprints separated by colons should be accepted")
+	assert.Len(fingerprint, sha256.Size)
+
+	_, err = ParseFingerprint(strings.Repeat("ab", 20))
+	assert.NotNil(err, "Fingerprints other than SHA-256 should be rejected")
+}
@@ -4,13 +4,10 @@ package utils
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"math/rand"
 	"os"
 	"os/exec"
 	"runtime"
-	"strings"
 	"time"
 )
 
@@ -42,36 +39,13 @@ func Untar(ctx context.Context, source, target string) error {
 	return nil
 }
 
-// CheckStandardStatus return error if status is not OK
+// CheckStandardStatus return error if status is not OK: an APIError describing the failure
 func CheckStandardStatus(status int, response []byte) error {
 
 	if status < 300 {
 		return nil
 	}
-
-	// default, raw, not parsing
-	message := string(response[:])
-
-	var responseContent map[string]interface{}
-	err := json.Unmarshal(response, &responseContent)
-	if err == nil {
-		if responseContent["errors"] != nil {
-			message = ""
-			for key, value := range responseContent["errors"].(map[string]interface{}) {
-				subMessages := make([]string, len(value.([]interface{})))
-				for i, v := range value.([]interface{}) {
-					subMessages[i] = fmt.Sprint(v)
-				}
-				composedMsg := strings.Join(subMessages, ",")
-				message = fmt.Sprintf("%s#%s:%s", message, key, composedMsg)
-			}
-		}
-		if responseContent["errors"] == nil && responseContent["error"] != nil {
-			message = responseContent["error"].(string)
-		}
-	}
-
-	return fmt.Errorf("HTTP request failed: (%d) [%s]", status, message)
+	return NewAPIError(status, response)
 }
 
 // FileExists checks file existence
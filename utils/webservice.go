@@ -3,6 +3,8 @@
 package utils
 
 import (
+	"bytes"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
@@ -12,8 +14,6 @@ import (
 	"net/http"
 	"os"
 	"regexp"
-	"strings"
-	"time"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -23,20 +23,34 @@ const ContentTypeApplicationJson = "application/json"
 const ConfigurationIsIncomplete = "configuration is incomplete"
 const HttpTimeOut = 30
 
-// ConcertoService defines actions to be performed by web service manager
+// ConcertoService defines actions to be performed by web service manager. Every action has a Context variant which
+// aborts the in-flight request as soon as the given context is done. Requests the platform fails are returned along
+// with the APIError describing them, besides the body and status code of the response
 type ConcertoService interface {
 	Post(path string, payload *map[string]interface{}) ([]byte, int, error)
+	PostIdempotent(path string, payload *map[string]interface{}) ([]byte, int, error)
 	Put(path string, payload *map[string]interface{}) ([]byte, int, error)
 	Delete(path string) ([]byte, int, error)
 	Get(path string) ([]byte, int, error)
+	GetPage(path string) ([]byte, string, int, error)
 	GetFile(url string, filePath string, discoveryFileName bool) (string, int, error)
 	PutFile(sourceFilePath string, targetURL string) ([]byte, int, error)
+	PostContext(ctx context.Context, path string, payload *map[string]interface{}) ([]byte, int, error)
+	PostIdempotentContext(ctx context.Context, path string, payload *map[string]interface{}) ([]byte, int, error)
+	PutContext(ctx context.Context, path string, payload *map[string]interface{}) ([]byte, int, error)
+	DeleteContext(ctx context.Context, path string) ([]byte, int, error)
+	GetContext(ctx context.Context, path string) ([]byte, int, error)
+	GetPageContext(ctx context.Context, path string) ([]byte, string, int, error)
+	GetFileContext(ctx context.Context, url string, filePath string, discoveryFileName bool) (string, int, error)
+	PutFileContext(ctx context.Context, sourceFilePath string, targetURL string) ([]byte, int, error)
 }
 
 // HTTPConcertoservice web service manager.
 type HTTPConcertoservice struct {
-	config *Config
-	client *http.Client
+	config     *Config
+	client     *http.Client
+	fileClient *http.Client
+	limiter    *RateLimiter
 }
 
 // NewHTTPConcertoService creates new http Concerto client based on config
@@ -46,13 +60,19 @@ func NewHTTPConcertoService(config *Config) (hcs *HTTPConcertoservice, err error
 		return nil, fmt.Errorf(WebServiceConfigurationFailed)
 	}
 
+	// requests are answered by the recorded fixtures, if any, instead of sent
+	if config.ReplayFixtures != "" {
+		return NewReplayConcertoService(config.ReplayFixtures)
+	}
+
 	if !config.IsConfigReady() {
 		return nil, fmt.Errorf(ConfigurationIsIncomplete)
 	}
 
-	// creates HTTP Concerto service with config
+	// creates HTTP Concerto service with config, shaping requests along with the rest of services with its limits
 	hcs = &HTTPConcertoservice{
-		config: config,
+		config:  config,
+		limiter: sharedRateLimiter(config.RateLimit),
 	}
 
 	// Loads CA Certificate
@@ -74,15 +94,13 @@ func NewHTTPConcertoService(config *Config) (hcs *HTTPConcertoservice, err error
 		)
 	}
 
-	// Creates a client with specific transport configurations
-	hcs.client = &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				RootCAs:      caCertPool,
-				Certificates: []tls.Certificate{cert},
-			},
-		},
-		Timeout: time.Second * time.Duration(HttpTimeOut),
+	// Creates the clients with specific transport configurations
+	err = hcs.setClients(&tls.Config{
+		RootCAs:      caCertPool,
+		Certificates: []tls.Certificate{cert},
+	})
+	if err != nil {
+		return nil, err
 	}
 	return hcs, nil
 }
@@ -98,18 +116,14 @@ func NewHTTPConcertoServiceWithBrownfieldToken(config *Config) (hcs *HTTPConcert
 		return nil, fmt.Errorf(ConfigurationIsIncomplete)
 	}
 
-	// creates HTTP Concerto service with config
+	// creates HTTP Concerto service with config, shaping requests along with the rest of services with its limits
 	hcs = &HTTPConcertoservice{
-		config: config,
+		config:  config,
+		limiter: sharedRateLimiter(config.RateLimit),
 	}
-	// Creates a client with no certificates and insecure option
-	hcs.client = &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-		Timeout: time.Second * time.Duration(HttpTimeOut),
+	// Creates the clients with no certificates, verifying the platform one only if pinned
+	if err = hcs.setPinnedClients(); err != nil {
+		return nil, err
 	}
 	return hcs, nil
 }
@@ -125,50 +139,83 @@ func NewHTTPConcertoServiceWithCommandPolling(config *Config) (hcs *HTTPConcerto
 		return nil, fmt.Errorf(ConfigurationIsIncomplete)
 	}
 
-	// creates HTTP Concerto service with config
+	// creates HTTP Concerto service with config, shaping requests along with the rest of services with its limits
 	hcs = &HTTPConcertoservice{
-		config: config,
+		config:  config,
+		limiter: sharedRateLimiter(config.RateLimit),
 	}
-	// Creates a client with no certificates and insecure option
-	hcs.client = &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-		Timeout: time.Second * time.Duration(HttpTimeOut),
+	// Creates the clients with no certificates, verifying the platform one only if pinned
+	if err = hcs.setPinnedClients(); err != nil {
+		return nil, err
 	}
 	return hcs, nil
 }
 
 // Post sends POST request to Concerto API
 func (hcs *HTTPConcertoservice) Post(path string, payload *map[string]interface{}) ([]byte, int, error) {
+	return hcs.PostContext(context.Background(), path, payload)
+}
+
+// PostContext sends POST request to Concerto API, cancelled as soon as ctx is done
+func (hcs *HTTPConcertoservice) PostContext(
+	ctx context.Context,
+	path string,
+	payload *map[string]interface{},
+) ([]byte, int, error) {
+	return hcs.post(ctx, path, payload, false)
+}
 
+// PostIdempotent sends POST request to Concerto API. Unlike Post, the request is retried on failure, so it must only
+// be used for requests the caller knows are safe to repeat
+func (hcs *HTTPConcertoservice) PostIdempotent(path string, payload *map[string]interface{}) ([]byte, int, error) {
+	return hcs.PostIdempotentContext(context.Background(), path, payload)
+}
+
+// PostIdempotentContext sends retryable POST request to Concerto API, cancelled as soon as ctx is done
+func (hcs *HTTPConcertoservice) PostIdempotentContext(
+	ctx context.Context,
+	path string,
+	payload *map[string]interface{},
+) ([]byte, int, error) {
+	return hcs.post(ctx, path, payload, true)
+}
+
+func (hcs *HTTPConcertoservice) post(
+	ctx context.Context,
+	path string,
+	payload *map[string]interface{},
+	retryable bool,
+) ([]byte, int, error) {
 	url, jsPayload, err := hcs.prepareCall(path, payload)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	log.Debugf("Sending POST request to %s with payload %v ", url, jsPayload)
-	req, err := http.NewRequest("POST", url, jsPayload)
-	req.Header.Add("Content-Type", ContentTypeApplicationJson)
-	if hcs.config.BrownfieldToken != "" {
-		log.Debugf(
-			"Including brownfield token %s in POST request as X-Concerto-Brownfield-Token header ",
-			hcs.config.BrownfieldToken,
-		)
-		req.Header.Add("X-Concerto-Brownfield-Token", hcs.config.BrownfieldToken)
-	}
-	if hcs.config.CommandPollingToken != "" && hcs.config.ServerID != "" {
-		log.Debugf(
-			"Including command polling token %s in POST request as X-IMCO-Command-Polling-Token header ",
-			hcs.config.CommandPollingToken,
-		)
-		req.Header.Add("X-IMCO-Command-Polling-Token", hcs.config.CommandPollingToken)
-		log.Debugf("Including Server id %s in POST request as X-IMCO-Server-ID header ", hcs.config.ServerID)
-		req.Header.Add("X-IMCO-Server-ID", hcs.config.ServerID)
-	}
-	response, err := hcs.client.Do(req)
+	log.Debugf("Sending POST request to %s with payload %s ", url, jsPayload)
+	response, err := hcs.doRequest(ctx, hcs.client, func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", url, bytes.NewReader(jsPayload))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Add("Content-Type", ContentTypeApplicationJson)
+		if hcs.config.BrownfieldToken != "" {
+			log.Debugf(
+				"Including brownfield token %s in POST request as X-Concerto-Brownfield-Token header ",
+				hcs.config.BrownfieldToken,
+			)
+			req.Header.Add("X-Concerto-Brownfield-Token", hcs.config.BrownfieldToken)
+		}
+		if hcs.config.CommandPollingToken != "" && hcs.config.ServerID != "" {
+			log.Debugf(
+				"Including command polling token %s in POST request as X-IMCO-Command-Polling-Token header ",
+				hcs.config.CommandPollingToken,
+			)
+			req.Header.Add("X-IMCO-Command-Polling-Token", hcs.config.CommandPollingToken)
+			log.Debugf("Including Server id %s in POST request as X-IMCO-Server-ID header ", hcs.config.ServerID)
+			req.Header.Add("X-IMCO-Server-ID", hcs.config.ServerID)
+		}
+		return req, nil
+	}, retryable)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -178,18 +225,29 @@ func (hcs *HTTPConcertoservice) Post(path string, payload *map[string]interface{
 
 // Put sends PUT request to Concerto API
 func (hcs *HTTPConcertoservice) Put(path string, payload *map[string]interface{}) ([]byte, int, error) {
+	return hcs.PutContext(context.Background(), path, payload)
+}
+
+// PutContext sends PUT request to Concerto API, cancelled as soon as ctx is done
+func (hcs *HTTPConcertoservice) PutContext(
+	ctx context.Context,
+	path string,
+	payload *map[string]interface{},
+) ([]byte, int, error) {
 	url, jsPayload, err := hcs.prepareCall(path, payload)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	log.Debugf("Sending PUT request to %s with payload %v ", url, jsPayload)
-	request, err := http.NewRequest("PUT", url, jsPayload)
-	if err != nil {
-		return nil, 0, err
-	}
-	request.Header = map[string][]string{"Content-type": {ContentTypeApplicationJson}}
-	response, err := hcs.client.Do(request)
+	log.Debugf("Sending PUT request to %s with payload %s ", url, jsPayload)
+	response, err := hcs.doRequest(ctx, hcs.client, func() (*http.Request, error) {
+		request, err := http.NewRequest("PUT", url, bytes.NewReader(jsPayload))
+		if err != nil {
+			return nil, err
+		}
+		request.Header = map[string][]string{"Content-type": {ContentTypeApplicationJson}}
+		return request, nil
+	}, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -199,18 +257,25 @@ func (hcs *HTTPConcertoservice) Put(path string, payload *map[string]interface{}
 
 // Delete sends DELETE request to Concerto API
 func (hcs *HTTPConcertoservice) Delete(path string) ([]byte, int, error) {
+	return hcs.DeleteContext(context.Background(), path)
+}
+
+// DeleteContext sends DELETE request to Concerto API, cancelled as soon as ctx is done
+func (hcs *HTTPConcertoservice) DeleteContext(ctx context.Context, path string) ([]byte, int, error) {
 	url, _, err := hcs.prepareCall(path, nil)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	log.Debugf("Sending DELETE request to %s", url)
-	request, err := http.NewRequest("DELETE", url, nil)
-	if err != nil {
-		return nil, 0, err
-	}
-	request.Header = map[string][]string{"Content-type": {ContentTypeApplicationJson}}
-	response, err := hcs.client.Do(request)
+	response, err := hcs.doRequest(ctx, hcs.client, func() (*http.Request, error) {
+		request, err := http.NewRequest("DELETE", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		request.Header = map[string][]string{"Content-type": {ContentTypeApplicationJson}}
+		return request, nil
+	}, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -220,6 +285,11 @@ func (hcs *HTTPConcertoservice) Delete(path string) ([]byte, int, error) {
 
 // Get sends GET request to Concerto API
 func (hcs *HTTPConcertoservice) Get(path string) ([]byte, int, error) {
+	return hcs.GetContext(context.Background(), path)
+}
+
+// GetContext sends GET request to Concerto API, cancelled as soon as ctx is done
+func (hcs *HTTPConcertoservice) GetContext(ctx context.Context, path string) ([]byte, int, error) {
 
 	url, _, err := hcs.prepareCall(path, nil)
 	if err != nil {
@@ -227,7 +297,9 @@ func (hcs *HTTPConcertoservice) Get(path string) ([]byte, int, error) {
 	}
 
 	log.Debugf("Sending GET request to %s", url)
-	response, err := hcs.client.Get(url)
+	response, err := hcs.doRequest(ctx, hcs.client, func() (*http.Request, error) {
+		return http.NewRequest("GET", url, nil)
+	}, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -237,16 +309,28 @@ func (hcs *HTTPConcertoservice) Get(path string) ([]byte, int, error) {
 
 // GetFile sends GET request to Concerto API and receives a file
 func (hcs *HTTPConcertoservice) GetFile(url string, filePath string, discoveryFileName bool) (string, int, error) {
+	return hcs.GetFileContext(context.Background(), url, filePath, discoveryFileName)
+}
+
+// GetFileContext sends GET request to Concerto API and receives a file, cancelled as soon as ctx is done
+func (hcs *HTTPConcertoservice) GetFileContext(
+	ctx context.Context,
+	url string,
+	filePath string,
+	discoveryFileName bool,
+) (string, int, error) {
 
 	log.Debugf("Sending GET request to %s", url)
-	response, err := hcs.client.Get(url)
+	response, err := hcs.doRequest(ctx, hcs.fileTransferClient(), func() (*http.Request, error) {
+		return http.NewRequest("GET", url, nil)
+	}, true)
 	if err != nil {
 		return "", 0, err
 	}
 	defer response.Body.Close()
 	log.Debugf("Status code:%d message:%s", response.StatusCode, response.Status)
 	if response.StatusCode < 200 || response.StatusCode > 299 {
-		return "", response.StatusCode, fmt.Errorf("HTTP request failed with status %s", response.Status)
+		return "", response.StatusCode, NewAPIErrorFromResponse(response, nil)
 	}
 
 	realFileName := filePath
@@ -279,18 +363,29 @@ func (hcs *HTTPConcertoservice) GetFile(url string, filePath string, discoveryFi
 
 // PutFile sends PUT request to send a file
 func (hcs *HTTPConcertoservice) PutFile(sourceFilePath string, targetURL string) ([]byte, int, error) {
+	return hcs.PutFileContext(context.Background(), sourceFilePath, targetURL)
+}
 
-	data, err := os.Open(sourceFilePath)
-	if err != nil {
-		return nil, 0, err
-	}
-
-	req, err := http.NewRequest("PUT", targetURL, data)
-	if err != nil {
-		return nil, 0, err
-	}
+// PutFileContext sends PUT request to send a file, cancelled as soon as ctx is done
+func (hcs *HTTPConcertoservice) PutFileContext(
+	ctx context.Context,
+	sourceFilePath string,
+	targetURL string,
+) ([]byte, int, error) {
 
-	res, err := hcs.client.Do(req)
+	res, err := hcs.doRequest(ctx, hcs.fileTransferClient(), func() (*http.Request, error) {
+		// file is opened on every attempt, as the client closes the request body once sent
+		data, err := os.Open(sourceFilePath)
+		if err != nil {
+			return nil, err
+		}
+		req, err := http.NewRequest("PUT", targetURL, data)
+		if err != nil {
+			data.Close()
+			return nil, err
+		}
+		return req, nil
+	}, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -304,7 +399,7 @@ func (hcs *HTTPConcertoservice) PutFile(sourceFilePath string, targetURL string)
 func (hcs *HTTPConcertoservice) prepareCall(
 	path string,
 	payload *map[string]interface{},
-) (url string, jsPayload *strings.Reader, err error) {
+) (url string, jsPayload []byte, err error) {
 
 	if hcs.config == nil || hcs.client == nil {
 		return "", nil, fmt.Errorf("Can not call web service without loading configuration")
@@ -317,12 +412,12 @@ func (hcs *HTTPConcertoservice) prepareCall(
 	}
 
 	// payload to json
-	json, err := json.Marshal(payload)
+	jsPayload, err = json.Marshal(payload)
 	if err != nil {
 		return "", nil, err
 	}
 
-	return url, strings.NewReader(string(json)), err
+	return url, jsPayload, err
 }
 
 func (hcs *HTTPConcertoservice) receiveResponse(response *http.Response) (body []byte, status int, err error) {
@@ -335,5 +430,8 @@ func (hcs *HTTPConcertoservice) receiveResponse(response *http.Response) (body [
 	log.Debugf("Response : %s", body)
 	log.Debugf("Status code: (%d) %s", response.StatusCode, response.Status)
 
+	if response.StatusCode >= 300 {
+		return body, response.StatusCode, NewAPIErrorFromResponse(response, body)
+	}
 	return body, response.StatusCode, nil
 }
@@ -3,6 +3,8 @@
 package utils
 
 import (
+	"context"
+
 	"github.com/stretchr/testify/mock"
 )
 
@@ -17,6 +19,12 @@ func (m *MockConcertoService) Post(path string, payload *map[string]interface{})
 	return args.Get(0).([]byte), args.Int(1), args.Error(2)
 }
 
+// PostIdempotent mocks retryable POST request to Concerto API
+func (m *MockConcertoService) PostIdempotent(path string, payload *map[string]interface{}) ([]byte, int, error) {
+	args := m.Called(path, payload)
+	return args.Get(0).([]byte), args.Int(1), args.Error(2)
+}
+
 // Put mocks PUT request to Concerto API
 func (m *MockConcertoService) Put(path string, payload *map[string]interface{}) ([]byte, int, error) {
 	args := m.Called(path, payload)
@@ -35,6 +43,13 @@ func (m *MockConcertoService) Get(path string) ([]byte, int, error) {
 	return args.Get(0).([]byte), args.Int(1), args.Error(2)
 }
 
+// GetPage mocks GET request to Concerto API for a page of a collection, recording it as Get. Mocked pages are never
+// linked, so the following page is requested as long as full pages are returned
+func (m *MockConcertoService) GetPage(path string) ([]byte, string, int, error) {
+	data, status, err := m.Get(path)
+	return data, "", status, err
+}
+
 // GetFile sends GET request to Concerto API and receives a file
 func (m *MockConcertoService) GetFile(url string, filePath string, discoveryFileName bool) (string, int, error) {
 	args := m.Called(url, filePath)
@@ -46,3 +61,64 @@ func (m *MockConcertoService) PutFile(sourceFilePath string, targetURL string) (
 	args := m.Called(sourceFilePath, targetURL)
 	return args.Get(0).([]byte), args.Int(1), args.Error(2)
 }
+
+// PostContext mocks POST request to Concerto API, recording it as Post
+func (m *MockConcertoService) PostContext(
+	ctx context.Context,
+	path string,
+	payload *map[string]interface{},
+) ([]byte, int, error) {
+	return m.Post(path, payload)
+}
+
+// PostIdempotentContext mocks retryable POST request to Concerto API, recording it as PostIdempotent
+func (m *MockConcertoService) PostIdempotentContext(
+	ctx context.Context,
+	path string,
+	payload *map[string]interface{},
+) ([]byte, int, error) {
+	return m.PostIdempotent(path, payload)
+}
+
+// PutContext mocks PUT request to Concerto API, recording it as Put
+func (m *MockConcertoService) PutContext(
+	ctx context.Context,
+	path string,
+	payload *map[string]interface{},
+) ([]byte, int, error) {
+	return m.Put(path, payload)
+}
+
+// DeleteContext mocks DELETE request to Concerto API, recording it as Delete
+func (m *MockConcertoService) DeleteContext(ctx context.Context, path string) ([]byte, int, error) {
+	return m.Delete(path)
+}
+
+// GetContext mocks GET request to Concerto API, recording it as Get
+func (m *MockConcertoService) GetContext(ctx context.Context, path string) ([]byte, int, error) {
+	return m.Get(path)
+}
+
+// GetPageContext mocks GET request to Concerto API for a page of a collection, recording it as Get
+func (m *MockConcertoService) GetPageContext(ctx context.Context, path string) ([]byte, string, int, error) {
+	return m.GetPage(path)
+}
+
+// GetFileContext mocks GET request to Concerto API receiving a file, recording it as GetFile
+func (m *MockConcertoService) GetFileContext(
+	ctx context.Context,
+	url string,
+	filePath string,
+	discoveryFileName bool,
+) (string, int, error) {
+	return m.GetFile(url, filePath, discoveryFileName)
+}
+
+// PutFileContext mocks PUT request to send a file, recording it as PutFile
+func (m *MockConcertoService) PutFileContext(
+	ctx context.Context,
+	sourceFilePath string,
+	targetURL string,
+) ([]byte, int, error) {
+	return m.PutFile(sourceFilePath, targetURL)
+}
@@ -0,0 +1,20 @@
+// Copyright (c) 2017-2021 Ingram Micro Inc.
+
+//go:build windows
+// +build windows
+
+package utils
+
+import (
+	"os/exec"
+	"strconv"
+)
+
+// startInProcessGroup does nothing, as taskkill finds the processes started by the command on its own
+func startInProcessGroup(cmd *exec.Cmd) {
+}
+
+// killProcessTree kills the command along with every process it started
+func killProcessTree(cmd *exec.Cmd) error {
+	return exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(cmd.Process.Pid)).Run()
+}
@@ -14,6 +14,7 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists the available Apps.",
 			Action: cmd.AppList,
+			Flags:  cmd.PageFlags(),
 		},
 		{
 			Name:   "deploy",
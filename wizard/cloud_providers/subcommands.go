@@ -14,7 +14,7 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists the available Cloud Providers",
 			Action: cmd.WizCloudProviderList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "app-id",
 					Usage: "Identifier of the App",
@@ -23,7 +23,7 @@ func SubCommands() []cli.Command {
 					Name:  "location-id",
 					Usage: "Identifier of the Location",
 				},
-			},
+			),
 		},
 	}
 }
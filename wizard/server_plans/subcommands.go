@@ -14,7 +14,7 @@ func SubCommands() []cli.Command {
 			Name:   "list",
 			Usage:  "Lists the available Server Plans.",
 			Action: cmd.WizServerPlanList,
-			Flags: []cli.Flag{
+			Flags: cmd.PageFlags(
 				cli.StringFlag{
 					Name:  "app-id",
 					Usage: "Identifier of the App",
@@ -27,7 +27,7 @@ func SubCommands() []cli.Command {
 					Name:  "cloud-provider-id",
 					Usage: "Identifier of the Cloud Provider",
 				},
-			},
+			),
 		},
 	}
 }